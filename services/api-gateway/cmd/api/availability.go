@@ -0,0 +1,165 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Driver Availability Handlers
+// ============================================
+
+type UpdateLocationRoleRequest struct {
+	OldRole string `json:"old_role" validate:"required,oneof=driver passenger"`
+	NewRole string `json:"new_role" validate:"required,oneof=driver passenger"`
+}
+
+// writeAvailabilityError turns location-service availability status codes
+// into HTTP responses.
+func writeAvailabilityError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+func availabilityPayload(a *locationpb.DriverAvailability) map[string]interface{} {
+	return map[string]interface{}{
+		"driver_id":  a.DriverId,
+		"status":     a.Status,
+		"trip_id":    a.TripId,
+		"updated_at": a.UpdatedAt,
+	}
+}
+
+// GoOnline makes the calling driver a candidate for new trips.
+func (app *Config) GoOnline(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GoOnline")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	if claims.Role != "driver" {
+		response.Forbidden(w, "Only drivers can go online")
+		return
+	}
+
+	resp, err := app.GoOnlineViaGRPC(ctx, int(claims.UserID))
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to go online")
+		return
+	}
+	response.Success(w, resp.Message, availabilityPayload(resp.Availability))
+}
+
+// GoOffline stops the calling driver from being offered trips. A driver on a
+// trip gets 409 until the trip is completed or cancelled.
+func (app *Config) GoOffline(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GoOffline")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	if claims.Role != "driver" {
+		response.Forbidden(w, "Only drivers can go offline")
+		return
+	}
+
+	resp, err := app.GoOfflineViaGRPC(ctx, int(claims.UserID))
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to go offline")
+		return
+	}
+	response.Success(w, resp.Message, availabilityPayload(resp.Availability))
+}
+
+func (app *Config) GetMyAvailability(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetMyAvailability")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	if claims.Role != "driver" {
+		response.Forbidden(w, "Only drivers have an availability")
+		return
+	}
+
+	resp, err := app.GetDriverAvailabilityViaGRPC(ctx, int(claims.UserID))
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to get availability")
+		return
+	}
+	response.Success(w, resp.Message, availabilityPayload(resp.Availability))
+}
+
+// RemoveMyLocation deletes the caller's last known location, e.g. when they
+// turn off location sharing.
+func (app *Config) RemoveMyLocation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "RemoveMyLocation")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	resp, err := app.RemoveLocationViaGRPC(ctx, int(claims.UserID), claims.Role)
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to remove location")
+		return
+	}
+	response.Success(w, resp.Message, nil)
+}
+
+// UpdateLocationRole moves a user's location between the driver and passenger
+// indexes after their role changed.
+func (app *Config) UpdateLocationRole(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "UpdateLocationRole")
+	defer span.End()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		response.BadRequest(w, "User ID must be a positive integer")
+		return
+	}
+	var req UpdateLocationRoleRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.UpdateUserRoleViaGRPC(ctx, userID, req.OldRole, req.NewRole)
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to update location role")
+		return
+	}
+	response.Success(w, resp.Message, resp.Location)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Block List Handlers
+// ============================================
+
+type BlockUserRequest struct {
+	UserID int    `json:"user_id" validate:"required,gt=0"`
+	Reason string `json:"reason,omitempty" validate:"max=500"`
+}
+
+// writeBlockError turns user-service block list status codes into HTTP
+// responses.
+func writeBlockError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+// ListBlockedUsers returns the users the caller blocked, newest first.
+func (app *Config) ListBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListBlockedUsers")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	resp, err := app.ListBlockedUsersViaGRPC(ctx, int(claims.UserID))
+	if err != nil {
+		writeBlockError(w, err, "Failed to list blocked users")
+		return
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"blocks":      resp.Blocks,
+		"total_count": resp.TotalCount,
+	})
+}
+
+// BlockUser stops the caller from being matched with another user, in either
+// direction. Blocking someone twice returns the existing block.
+func (app *Config) BlockUser(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "BlockUser")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var req BlockUserRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.BlockUserViaGRPC(ctx, int(claims.UserID), req.UserID, req.Reason)
+	if err != nil {
+		writeBlockError(w, err, "Failed to block user")
+		return
+	}
+	response.Success(w, resp.Message, resp.Block)
+}
+
+func (app *Config) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "UnblockUser")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	blockedUserID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || blockedUserID <= 0 {
+		response.BadRequest(w, "User ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.UnblockUserViaGRPC(ctx, int(claims.UserID), blockedUserID)
+	if err != nil {
+		writeBlockError(w, err, "Failed to unblock user")
+		return
+	}
+	response.Success(w, resp.Message, nil)
+}
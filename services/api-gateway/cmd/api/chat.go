@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	trippb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chatHeartbeat keeps idle live chat connections open through proxies.
+const chatHeartbeat = 20 * time.Second
+
+// ============================================
+// Trip Chat Handlers
+// ============================================
+
+type SendChatMessageRequest struct {
+	Body       string `json:"body,omitempty" validate:"max=1000"`
+	QuickReply string `json:"quick_reply,omitempty"`
+}
+
+type MarkChatReadRequest struct {
+	UpToID int64 `json:"up_to_id" validate:"required,gt=0"`
+}
+
+// writeChatError turns trip-service chat status codes into HTTP responses.
+func writeChatError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+func chatTripID(r *http.Request) (int, bool) {
+	tripID, err := strconv.Atoi(chi.URLParam(r, "tripID"))
+	return tripID, err == nil && tripID > 0
+}
+
+func (app *Config) SendChatMessage(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "SendChatMessage")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	var req SendChatMessageRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.SendChatMessageViaGRPC(ctx, tripID, int(claims.UserID), req.Body, req.QuickReply)
+	if err != nil {
+		writeChatError(w, err, "Failed to send message")
+		return
+	}
+	response.Created(w, "Message sent successfully", resp)
+}
+
+// GetChatHistory returns the trip's messages, oldest first. Query parameters:
+// after_id (exclusive) and limit (default 50, at most 200).
+func (app *Config) GetChatHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetChatHistory")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+	query := r.URL.Query()
+	var afterID int64
+	if v := query.Get("after_id"); v != "" {
+		if afterID, err = strconv.ParseInt(v, 10, 64); err != nil || afterID < 0 {
+			response.BadRequest(w, "after_id must be a non-negative integer")
+			return
+		}
+	}
+	limit := 0
+	if v := query.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			response.BadRequest(w, "limit must be a positive integer")
+			return
+		}
+	}
+
+	resp, err := app.GetChatHistoryViaGRPC(ctx, tripID, int(claims.UserID), afterID, limit)
+	if err != nil {
+		writeChatError(w, err, "Failed to get chat history")
+		return
+	}
+	response.Success(w, "Chat history retrieved successfully", resp.Messages)
+}
+
+func (app *Config) MarkChatRead(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "MarkChatRead")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	var req MarkChatReadRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.MarkChatReadViaGRPC(ctx, tripID, int(claims.UserID), req.UpToID)
+	if err != nil {
+		writeChatError(w, err, "Failed to mark messages read")
+		return
+	}
+	response.Success(w, "Messages marked read", resp)
+}
+
+// GetChatQuickReplies lists the canned messages for ?role=passenger|driver,
+// defaulting to the caller's own role.
+func (app *Config) GetChatQuickReplies(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetChatQuickReplies")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	role := r.URL.Query().Get("role")
+	if role == "" {
+		role = "passenger"
+		if claims.Role == "driver" {
+			role = "driver"
+		}
+	}
+
+	resp, err := app.ListQuickRepliesViaGRPC(ctx, role)
+	if err != nil {
+		writeChatError(w, err, "Failed to get quick replies")
+		return
+	}
+	response.Success(w, "Quick replies retrieved successfully", resp.Replies)
+}
+
+// writeSSE writes one server-sent event; id is omitted when empty.
+func writeSSE(w io.Writer, id string, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+// StreamTripChat is the live chat channel, served as server-sent events:
+// "message" events (id = message id) for new messages, "receipt" events for
+// delivery/read receipts and a final "closed" event when the trip ends. A
+// reconnecting client that sends Last-Event-ID first gets the messages it
+// missed.
+func (app *Config) StreamTripChat(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "StreamTripChat")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+	var lastEventID int64
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		if lastEventID, err = strconv.ParseInt(v, 10, 64); err != nil || lastEventID < 0 {
+			response.BadRequest(w, "Last-Event-ID must be a message id")
+			return
+		}
+	}
+
+	stream, err := app.SubscribeTripChatViaGRPC(ctx, tripID, int(claims.UserID))
+	if err != nil {
+		writeChatError(w, err, "Failed to open chat")
+		return
+	}
+	// Subscribing before reading the backlog means nothing falls in between;
+	// a message may arrive twice and clients dedupe by id.
+	var backlog []*trippb.ChatMessage
+	if lastEventID > 0 {
+		history, err := app.GetChatHistoryViaGRPC(ctx, tripID, int(claims.UserID), lastEventID, 0)
+		if err != nil {
+			writeChatError(w, err, "Failed to get chat history")
+			return
+		}
+		backlog = history.Messages
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	for _, message := range backlog {
+		if err := writeSSE(w, strconv.FormatInt(message.Id, 10), "message", message); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "Live chat needs a flushable response", "error", err)
+		return
+	}
+
+	events := make(chan *trippb.ChatEvent)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				// recvErr must always be sent before events is closed.
+				recvErr <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(chatHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": ping\n\n")
+		case event, ok := <-events:
+			if !ok {
+				if err := <-recvErr; err != io.EOF {
+					logger.WithContext(ctx).ErrorContext(ctx, "Live chat stream failed", "trip_id", tripID, "error", err)
+					writeSSE(w, "", "error", map[string]string{"message": status.Convert(err).Message()})
+				} else {
+					writeSSE(w, "", "closed", map[string]int{"trip_id": tripID})
+				}
+				rc.Flush()
+				return
+			}
+			switch e := event.Event.(type) {
+			case *trippb.ChatEvent_Message:
+				err = writeSSE(w, strconv.FormatInt(e.Message.Id, 10), "message", e.Message)
+			case *trippb.ChatEvent_Receipt:
+				err = writeSSE(w, "", "receipt", e.Receipt)
+			}
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			// The client has gone away.
+			return
+		}
+	}
+}
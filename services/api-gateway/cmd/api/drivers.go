@@ -0,0 +1,98 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	trippb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Driver Profile and Offer Report Handlers
+// ============================================
+
+// GetDriverProfile returns a driver with their vehicles. Acceptance and
+// cancellation stats are only included for the driver themselves and admins.
+func (app *Config) GetDriverProfile(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetDriverProfile")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	driverID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || driverID <= 0 {
+		response.BadRequest(w, "User ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.GetDriverProfileViaGRPC(ctx, driverID)
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.NotFound {
+			response.NotFound(w, st.Message())
+			return
+		}
+		response.InternalServerError(w, "Failed to get driver profile: "+st.Message())
+		return
+	}
+
+	payload := map[string]interface{}{
+		"driver":   resp.Driver,
+		"vehicles": resp.Vehicles,
+	}
+	if int(claims.UserID) == driverID || claims.Role == RoleAdmin {
+		payload["offer_stats"] = resp.OfferStats
+	}
+	response.Success(w, resp.Message, payload)
+}
+
+// GetDriverOfferReport returns the drivers' offer outcomes, lowest acceptance
+// rate first. Query parameters (all optional): from/to (RFC 3339, default the
+// offer rate window), driver_id, min_offers and limit.
+func (app *Config) GetDriverOfferReport(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetDriverOfferReport")
+	defer span.End()
+
+	query := r.URL.Query()
+	req := &trippb.DriverOfferReportRequest{}
+	var err error
+	if req.From, req.To, err = timeRangeQuery(query); err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	for name, dst := range map[string]*int32{
+		"driver_id":  &req.DriverId,
+		"min_offers": &req.MinOffers,
+		"limit":      &req.Limit,
+	} {
+		v := query.Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 0 {
+			response.BadRequest(w, name+" must be a non-negative integer")
+			return
+		}
+		*dst = int32(n)
+	}
+
+	resp, err := app.GetDriverOfferReportViaGRPC(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			response.BadRequest(w, st.Message())
+			return
+		}
+		response.InternalServerError(w, "Failed to get driver offer report: "+err.Error())
+		return
+	}
+	response.Success(w, "Driver offer report retrieved successfully", resp)
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	trippb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// exportFlushEvery bounds how many rows sit in buffers before being pushed
+	// to the client.
+	exportFlushEvery = 500
+	// exportTimeout caps a single export; large exports should be split by time.
+	exportTimeout = 30 * time.Minute
+	// Trailers set once the export has finished, so clients can tell a complete
+	// file from one cut short by an error.
+	exportStatusTrailer = "X-Export-Status"
+	exportRowsTrailer   = "X-Export-Rows"
+)
+
+// exportColumn is a trip field that can be selected for export.
+type exportColumn struct {
+	name  string
+	value func(t *trippb.Trip) any
+}
+
+func timestampValue(ts *timestamppb.Timestamp) any {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// exportColumns lists the exportable columns in their default order.
+var exportColumns = []exportColumn{
+	{"id", func(t *trippb.Trip) any { return t.Id }},
+	{"passenger_id", func(t *trippb.Trip) any { return t.PassengerId }},
+	{"driver_id", func(t *trippb.Trip) any { return t.DriverId }},
+	{"origin_lat", func(t *trippb.Trip) any { return t.OriginLat }},
+	{"origin_lng", func(t *trippb.Trip) any { return t.OriginLng }},
+	{"dest_lat", func(t *trippb.Trip) any { return t.DestLat }},
+	{"dest_lng", func(t *trippb.Trip) any { return t.DestLng }},
+	{"status", func(t *trippb.Trip) any { return t.Status.String() }},
+	{"distance", func(t *trippb.Trip) any { return t.Distance }},
+	{"fare", func(t *trippb.Trip) any { return t.Fare }},
+	{"payment_method", func(t *trippb.Trip) any { return t.PaymentMethod }},
+	{"rating", func(t *trippb.Trip) any { return t.Rating }},
+	{"review", func(t *trippb.Trip) any { return t.Review }},
+	{"created_at", func(t *trippb.Trip) any { return timestampValue(t.CreatedAt) }},
+	{"updated_at", func(t *trippb.Trip) any { return timestampValue(t.UpdatedAt) }},
+	{"accepted_at", func(t *trippb.Trip) any { return timestampValue(t.AcceptedAt) }},
+	{"started_at", func(t *trippb.Trip) any { return timestampValue(t.StartedAt) }},
+	{"completed_at", func(t *trippb.Trip) any { return timestampValue(t.CompletedAt) }},
+	{"cancelled_at", func(t *trippb.Trip) any { return timestampValue(t.CancelledAt) }},
+	{"cancel_by_user_id", func(t *trippb.Trip) any { return t.CancelByUserId }},
+	{"vehicle_class", func(t *trippb.Trip) any { return t.VehicleClass }},
+}
+
+// selectExportColumns resolves a comma-separated column list; empty selects all.
+func selectExportColumns(list string) ([]exportColumn, error) {
+	if list == "" {
+		return exportColumns, nil
+	}
+	byName := make(map[string]exportColumn, len(exportColumns))
+	for _, c := range exportColumns {
+		byName[c.name] = c
+	}
+	var selected []exportColumn
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		c, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		if !seen[name] {
+			selected = append(selected, c)
+			seen[name] = true
+		}
+	}
+	return selected, nil
+}
+
+// exportWriter writes trips in one output format.
+type exportWriter interface {
+	WriteTrip(t *trippb.Trip) error
+	Flush() error
+}
+
+type csvExportWriter struct {
+	w       *csv.Writer
+	columns []exportColumn
+	record  []string
+}
+
+func newCSVExportWriter(w io.Writer, columns []exportColumn) (*csvExportWriter, error) {
+	cw := &csvExportWriter{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+	for i, c := range columns {
+		cw.record[i] = c.name
+	}
+	return cw, cw.w.Write(cw.record)
+}
+
+func (cw *csvExportWriter) WriteTrip(t *trippb.Trip) error {
+	for i, c := range cw.columns {
+		switch v := c.value(t).(type) {
+		case nil:
+			cw.record[i] = ""
+		case string:
+			cw.record[i] = v
+		case float64:
+			cw.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			cw.record[i] = fmt.Sprint(v)
+		}
+	}
+	return cw.w.Write(cw.record)
+}
+
+func (cw *csvExportWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// ndjsonExportWriter writes one JSON object per line, keys in column order.
+type ndjsonExportWriter struct {
+	w       io.Writer
+	columns []exportColumn
+	buf     []byte
+}
+
+func (nw *ndjsonExportWriter) WriteTrip(t *trippb.Trip) error {
+	nw.buf = append(nw.buf[:0], '{')
+	for i, c := range nw.columns {
+		if i > 0 {
+			nw.buf = append(nw.buf, ',')
+		}
+		nw.buf = strconv.AppendQuote(nw.buf, c.name)
+		value, err := json.Marshal(c.value(t))
+		if err != nil {
+			return err
+		}
+		nw.buf = append(append(nw.buf, ':'), value...)
+	}
+	nw.buf = append(nw.buf, '}', '\n')
+	_, err := nw.w.Write(nw.buf)
+	return err
+}
+
+func (nw *ndjsonExportWriter) Flush() error {
+	return nil
+}
+
+func exportTripsRequest(r *http.Request) (*trippb.ExportTripsRequest, error) {
+	query := r.URL.Query()
+	req := &trippb.ExportTripsRequest{PaymentMethod: query.Get("payment_method")}
+
+	var err error
+	if req.From, req.To, err = timeRangeQuery(query); err != nil {
+		return nil, err
+	}
+	if req.Zone, err = zoneQuery(query); err != nil {
+		return nil, err
+	}
+	if v := query.Get("status"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			st, ok := trippb.TripStatus_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok || st == int32(trippb.TripStatus_STATUS_UNKNOWN) {
+				return nil, fmt.Errorf("unknown trip status %q", name)
+			}
+			req.Statuses = append(req.Statuses, trippb.TripStatus(st))
+		}
+	}
+	for name, dst := range map[string]*int32{"passenger_id": &req.PassengerId, "driver_id": &req.DriverId} {
+		if v := query.Get(name); v != "" {
+			id, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("%s must be a positive integer", name)
+			}
+			*dst = int32(id)
+		}
+	}
+	return req, nil
+}
+
+// ExportTrips streams the trips matching the query as CSV (default) or NDJSON.
+// Besides the filters of exportTripsRequest it accepts format=csv|ndjson,
+// columns=<comma-separated list> and gzip=true. Rows are written as they
+// arrive from trip-service, so memory use doesn't grow with the export.
+func (app *Config) ExportTrips(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ExportTrips")
+	defer span.End()
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "ndjson" {
+		response.BadRequest(w, "format must be csv or ndjson")
+		return
+	}
+	columns, err := selectExportColumns(query.Get("columns"))
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	compress := false
+	if v := query.Get("gzip"); v != "" {
+		if compress, err = strconv.ParseBool(v); err != nil {
+			response.BadRequest(w, "gzip must be true or false")
+			return
+		}
+	}
+	req, err := exportTripsRequest(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+	stream, err := app.ExportTripsViaGRPC(ctx, req)
+	if err != nil {
+		response.InternalServerError(w, "Failed to export trips: "+err.Error())
+		return
+	}
+	// Read the first trip before committing to a 200, so that invalid filters
+	// and an unavailable trip-service still get a proper error response.
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			response.BadRequest(w, st.Message())
+			return
+		}
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ExportTrips failed", "error", err)
+		response.InternalServerError(w, "Failed to export trips: "+err.Error())
+		return
+	}
+
+	filename := "trips-" + time.Now().UTC().Format("20060102T150405Z") + "." + format
+	contentType := "text/csv; charset=utf-8"
+	if format == "ndjson" {
+		contentType = "application/x-ndjson"
+	}
+	if compress {
+		filename += ".gz"
+		contentType = "application/gzip"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Trailer", exportStatusTrailer+", "+exportRowsTrailer)
+	w.WriteHeader(http.StatusOK)
+
+	var out io.Writer = w
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(w)
+		out = gz
+	}
+	var ew exportWriter
+	if format == "csv" {
+		ew, err = newCSVExportWriter(out, columns)
+	} else {
+		ew = &ndjsonExportWriter{w: out, columns: columns}
+	}
+
+	rc := http.NewResponseController(w)
+	flush := func() error {
+		if err := ew.Flush(); err != nil {
+			return err
+		}
+		if gz != nil {
+			if err := gz.Flush(); err != nil {
+				return err
+			}
+		}
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}
+
+	rows := 0
+	trip := first
+	for err == nil && trip != nil {
+		if err = ew.WriteTrip(trip); err != nil {
+			break
+		}
+		rows++
+		if rows%exportFlushEvery == 0 {
+			if err = flush(); err != nil {
+				break
+			}
+		}
+		trip, err = stream.Recv()
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	if flushErr := flush(); err == nil {
+		err = flushErr
+	}
+	if gz != nil {
+		if closeErr := gz.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	w.Header().Set(exportRowsTrailer, strconv.Itoa(rows))
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "Trip export aborted", "rows", rows, "error", err)
+		w.Header().Set(exportStatusTrailer, "error")
+		return
+	}
+	w.Header().Set(exportStatusTrailer, "complete")
+	logger.WithContext(ctx).InfoContext(ctx, "Trip export finished", "rows", rows, "format", format, "gzip", compress)
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
@@ -13,6 +15,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 // GRPCClients holds all gRPC client connections
@@ -244,18 +247,175 @@ func (app *Config) GetAllLocationsViaGRPC(ctx context.Context) (*locationpb.GetA
 	return resp, nil
 }
 
-func (app *Config) CreateTripViaGRPC(ctx context.Context, passengerID int, originLat float64, originLng float64, DestLat float64, DestLng float64, PaymentMethod string) (*trippb.CreateTripResponse, error) {
+// RemoveLocationViaGRPC deletes a user's location via gRPC
+func (app *Config) RemoveLocationViaGRPC(ctx context.Context, userID int, role string) (*locationpb.RemoveLocationResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	req := &trippb.CreateTripRequest{
-		PassengerId:   int32(passengerID),
-		OriginLat:     originLat,
-		OriginLng:     originLng,
-		DestLat:       DestLat,
-		DestLng:       DestLng,
-		PaymentMethod: PaymentMethod,
+	req := &locationpb.RemoveLocationRequest{
+		UserId: int32(userID),
+		Role:   role,
+	}
+	resp, err := app.GRPCClients.LocationClient.RemoveLocation(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC RemoveLocation failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// StreamLocationsViaGRPC sends the batches over one StreamLocations stream and
+// returns the final acknowledgement
+func (app *Config) StreamLocationsViaGRPC(ctx context.Context, batches []*locationpb.LocationBatch) (*locationpb.LocationAck, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	stream, err := app.GRPCClients.LocationClient.StreamLocations(ctx)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC StreamLocations failed", "error", err)
+		return nil, err
+	}
+	for _, batch := range batches {
+		if err := stream.Send(batch); err != nil {
+			// The server ended the stream; Recv below returns its status.
+			break
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC StreamLocations failed", "error", err)
+		return nil, err
+	}
+
+	var ack *locationpb.LocationAck
+	for {
+		next, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logger.WithContext(ctx).ErrorContext(ctx, "gRPC StreamLocations failed", "error", err)
+			return nil, err
+		}
+		ack = next
+	}
+	if ack == nil {
+		return nil, errors.New("location stream closed without an acknowledgement")
+	}
+	return ack, nil
+}
+
+// SearchLocationsViaGRPC searches locations around a point or in a box via gRPC
+func (app *Config) SearchLocationsViaGRPC(ctx context.Context, req *locationpb.SearchLocationsRequest) (*locationpb.SearchLocationsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.LocationClient.SearchLocations(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC SearchLocations failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetHeatmapViaGRPC counts users per geohash cell via gRPC
+func (app *Config) GetHeatmapViaGRPC(ctx context.Context, req *locationpb.GetHeatmapRequest) (*locationpb.GetHeatmapResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.LocationClient.GetHeatmap(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetHeatmap failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetLocationHistoryViaGRPC reads a user's recorded locations via gRPC
+func (app *Config) GetLocationHistoryViaGRPC(ctx context.Context, req *locationpb.GetLocationHistoryRequest) (*locationpb.GetLocationHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.LocationClient.GetLocationHistory(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetLocationHistory failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UpdateUserRoleViaGRPC moves a user's location to the index of their new
+// role via gRPC
+func (app *Config) UpdateUserRoleViaGRPC(ctx context.Context, userID int, oldRole, newRole string) (*locationpb.UpdateUserRoleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.UpdateUserRoleRequest{
+		UserId:  int32(userID),
+		OldRole: oldRole,
+		NewRole: newRole,
+	}
+	resp, err := app.GRPCClients.LocationClient.UpdateUserRole(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC UpdateUserRole failed", "error", err)
+		return nil, err
 	}
+
+	return resp, nil
+}
+
+// GoOnlineViaGRPC makes a driver available for matching via gRPC
+func (app *Config) GoOnlineViaGRPC(ctx context.Context, driverID int) (*locationpb.DriverAvailabilityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.DriverAvailabilityRequest{DriverId: int32(driverID)}
+	resp, err := app.GRPCClients.LocationClient.GoOnline(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GoOnline failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GoOfflineViaGRPC stops a driver from being matched via gRPC
+func (app *Config) GoOfflineViaGRPC(ctx context.Context, driverID int) (*locationpb.DriverAvailabilityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.DriverAvailabilityRequest{DriverId: int32(driverID)}
+	resp, err := app.GRPCClients.LocationClient.GoOffline(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GoOffline failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetDriverAvailabilityViaGRPC gets a driver's availability via gRPC
+func (app *Config) GetDriverAvailabilityViaGRPC(ctx context.Context, driverID int) (*locationpb.DriverAvailabilityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.DriverAvailabilityRequest{DriverId: int32(driverID)}
+	resp, err := app.GRPCClients.LocationClient.GetDriverAvailability(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetDriverAvailability failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (app *Config) CreateTripViaGRPC(ctx context.Context, req *trippb.CreateTripRequest) (*trippb.CreateTripResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
 	resp, err := app.GRPCClients.TripClient.CreateTrip(ctx, req)
 	if err != nil {
 		logger.WithContext(ctx).ErrorContext(ctx, "gRPC CreateTrip failed", "error", err)
@@ -265,13 +425,14 @@ func (app *Config) CreateTripViaGRPC(ctx context.Context, passengerID int, origi
 	return resp, nil
 }
 
-func (app *Config) AcceptTripViaGRPC(ctx context.Context, driverID int, tripID int) (*trippb.MessageResponse, error) {
+func (app *Config) AcceptTripViaGRPC(ctx context.Context, driverID int, tripID int, idempotencyKey string) (*trippb.MessageResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := &trippb.AcceptTripRequest{
-		DriverId: int32(driverID),
-		TripId:   int32(tripID),
+		DriverId:       int32(driverID),
+		TripId:         int32(tripID),
+		IdempotencyKey: idempotencyKey,
 	}
 	resp, err := app.GRPCClients.TripClient.AcceptTrip(ctx, req)
 	if err != nil {
@@ -282,13 +443,14 @@ func (app *Config) AcceptTripViaGRPC(ctx context.Context, driverID int, tripID i
 	return resp, nil
 }
 
-func (app *Config) RejectTripViaGRPC(ctx context.Context, driverID int, tripID int) (*trippb.MessageResponse, error) {
+func (app *Config) RejectTripViaGRPC(ctx context.Context, driverID int, tripID int, idempotencyKey string) (*trippb.MessageResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := &trippb.RejectTripRequest{
-		DriverId: int32(driverID),
-		TripId:   int32(tripID),
+		DriverId:       int32(driverID),
+		TripId:         int32(tripID),
+		IdempotencyKey: idempotencyKey,
 	}
 	resp, err := app.GRPCClients.TripClient.RejectTrip(ctx, req)
 	if err != nil {
@@ -381,16 +543,138 @@ func (app *Config) GetAllTripsViaGRPC(ctx context.Context, page int, limit int)
 	return resp, nil
 }
 
-func (app *Config) UpdateTripStatusViaGRPC(ctx context.Context, tripID int, driverID int, newStatus string) (*trippb.MessageResponse, error) {
+func (app *Config) GetTripReportViaGRPC(ctx context.Context, req *trippb.TripReportRequest) (*trippb.TripReportResponse, error) {
+	// Aggregates over long ranges take longer than the usual lookups.
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.TripClient.GetTripReport(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetTripReport failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ExportTripsViaGRPC opens the trip export stream. Unlike the other calls it
+// sets no timeout of its own, since an export runs as long as the client keeps
+// reading; the caller's context bounds it.
+func (app *Config) ExportTripsViaGRPC(ctx context.Context, req *trippb.ExportTripsRequest) (grpc.ServerStreamingClient[trippb.Trip], error) {
+	stream, err := app.GRPCClients.TripClient.ExportTrips(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ExportTrips failed", "error", err)
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+func (app *Config) SendChatMessageViaGRPC(ctx context.Context, tripID int, senderID int, body string, quickReply string) (*trippb.ChatMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.SendChatMessageRequest{
+		TripId:     int32(tripID),
+		SenderId:   int32(senderID),
+		Body:       body,
+		QuickReply: quickReply,
+	}
+	resp, err := app.GRPCClients.TripClient.SendChatMessage(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC SendChatMessage failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (app *Config) GetChatHistoryViaGRPC(ctx context.Context, tripID int, userID int, afterID int64, limit int) (*trippb.ChatHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.ChatHistoryRequest{
+		TripId:  int32(tripID),
+		UserId:  int32(userID),
+		AfterId: afterID,
+		Limit:   int32(limit),
+	}
+	resp, err := app.GRPCClients.TripClient.GetChatHistory(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetChatHistory failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (app *Config) MarkChatReadViaGRPC(ctx context.Context, tripID int, userID int, upToID int64) (*trippb.MarkChatReadResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.MarkChatReadRequest{
+		TripId: int32(tripID),
+		UserId: int32(userID),
+		UpToId: upToID,
+	}
+	resp, err := app.GRPCClients.TripClient.MarkChatRead(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC MarkChatRead failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (app *Config) ListQuickRepliesViaGRPC(ctx context.Context, role string) (*trippb.QuickRepliesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.TripClient.ListQuickReplies(ctx, &trippb.QuickRepliesRequest{Role: role})
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListQuickReplies failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SubscribeTripChatViaGRPC opens the live chat stream. Like ExportTripsViaGRPC
+// it has no timeout of its own; it lasts until ctx ends or the trip finishes.
+// It returns once trip-service has accepted or refused the subscription.
+func (app *Config) SubscribeTripChatViaGRPC(ctx context.Context, tripID int, userID int) (grpc.ServerStreamingClient[trippb.ChatEvent], error) {
+	req := &trippb.SubscribeTripChatRequest{
+		TripId: int32(tripID),
+		UserId: int32(userID),
+	}
+	stream, err := app.GRPCClients.TripClient.SubscribeTripChat(ctx, req)
+	if err == nil {
+		// trip-service sends headers once subscribed; a refusal ends the stream
+		// without any, and its status comes from Recv.
+		var md metadata.MD
+		if md, err = stream.Header(); err == nil && md == nil {
+			_, err = stream.Recv()
+		}
+	}
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC SubscribeTripChat failed", "error", err)
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+func (app *Config) UpdateTripStatusViaGRPC(ctx context.Context, tripID int, driverID int, newStatus string, idempotencyKey string) (*trippb.MessageResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Convert string status to trippb.TripStatus enum; unknown strings map to zero value.
 	statusEnum := trippb.TripStatus(trippb.TripStatus_value[newStatus])
 	req := &trippb.UpdateTripStatusRequest{
-		TripId:   int32(tripID),
-		DriverId: int32(driverID),
-		Status:   statusEnum,
+		TripId:         int32(tripID),
+		DriverId:       int32(driverID),
+		Status:         statusEnum,
+		IdempotencyKey: idempotencyKey,
 	}
 	resp, err := app.GRPCClients.TripClient.UpdateTripStatus(ctx, req)
 	if err != nil {
@@ -400,13 +684,14 @@ func (app *Config) UpdateTripStatusViaGRPC(ctx context.Context, tripID int, driv
 	return resp, nil
 }
 
-func (app *Config) CancelTripViaGRPC(ctx context.Context, tripID int, userID int) (*trippb.MessageResponse, error) {
+func (app *Config) CancelTripViaGRPC(ctx context.Context, tripID int, userID int, idempotencyKey string) (*trippb.MessageResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	req := &trippb.CancelTripRequest{
-		TripId: int32(tripID),
-		UserId: int32(userID),
+		TripId:         int32(tripID),
+		UserId:         int32(userID),
+		IdempotencyKey: idempotencyKey,
 	}
 	resp, err := app.GRPCClients.TripClient.CancelTrip(ctx, req)
 	if err != nil {
@@ -452,6 +737,182 @@ func (app *Config) GetReviewViaGRPC(ctx context.Context, tripID int, userID int)
 	return resp, nil
 }
 
+// RaiseSOSViaGRPC gets a longer timeout than the usual calls because
+// trip-service gathers locations and vehicle details before answering.
+func (app *Config) RaiseSOSViaGRPC(ctx context.Context, tripID int, userID int, note string) (*trippb.RaiseSOSResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req := &trippb.RaiseSOSRequest{
+		TripId: int32(tripID),
+		UserId: int32(userID),
+		Note:   note,
+	}
+	resp, err := app.GRPCClients.TripClient.RaiseSOS(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC RaiseSOS failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetIncidentViaGRPC(ctx context.Context, incidentID int64) (*trippb.Incident, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.TripClient.GetIncident(ctx, &trippb.IncidentRequest{IncidentId: incidentID})
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetIncident failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) ListIncidentsViaGRPC(ctx context.Context, status string, tripID int) (*trippb.ListIncidentsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.ListIncidentsRequest{
+		Status: status,
+		TripId: int32(tripID),
+	}
+	resp, err := app.GRPCClients.TripClient.ListIncidents(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListIncidents failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetIncidentTrackViaGRPC(ctx context.Context, incidentID int64, afterID int64, limit int) (*trippb.IncidentTrackResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.IncidentTrackRequest{
+		IncidentId: incidentID,
+		AfterId:    afterID,
+		Limit:      int32(limit),
+	}
+	resp, err := app.GRPCClients.TripClient.GetIncidentTrack(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetIncidentTrack failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) CloseIncidentViaGRPC(ctx context.Context, incidentID int64, operatorID int, resolution string) (*trippb.Incident, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.CloseIncidentRequest{
+		IncidentId: incidentID,
+		OperatorId: int32(operatorID),
+		Resolution: resolution,
+	}
+	resp, err := app.GRPCClients.TripClient.CloseIncident(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC CloseIncident failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) CreateShareLinkViaGRPC(ctx context.Context, tripID, userID int, ttlSeconds int) (*trippb.ShareLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.CreateShareLinkRequest{
+		TripId:     int32(tripID),
+		UserId:     int32(userID),
+		TtlSeconds: int32(ttlSeconds),
+	}
+	resp, err := app.GRPCClients.TripClient.CreateShareLink(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC CreateShareLink failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) ListShareLinksViaGRPC(ctx context.Context, tripID, userID int) (*trippb.ListShareLinksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.ListShareLinksRequest{
+		TripId: int32(tripID),
+		UserId: int32(userID),
+	}
+	resp, err := app.GRPCClients.TripClient.ListShareLinks(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListShareLinks failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) RevokeShareLinkViaGRPC(ctx context.Context, tripID, userID int, shareID int64) (*trippb.MessageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.RevokeShareLinkRequest{
+		TripId:  int32(tripID),
+		UserId:  int32(userID),
+		ShareId: shareID,
+	}
+	resp, err := app.GRPCClients.TripClient.RevokeShareLink(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC RevokeShareLink failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetSharedTripViaGRPC(ctx context.Context, token string) (*trippb.SharedTripView, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.TripClient.GetSharedTrip(ctx, &trippb.SharedTripRequest{Token: token})
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetSharedTrip failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) AddTipViaGRPC(ctx context.Context, tripID, passengerID int, amount float64) (*trippb.TripReceipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.AddTipRequest{
+		TripId:      int32(tripID),
+		PassengerId: int32(passengerID),
+		Amount:      amount,
+	}
+	resp, err := app.GRPCClients.TripClient.AddTip(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC AddTip failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetTripReceiptViaGRPC(ctx context.Context, tripID, userID int) (*trippb.TripReceipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &trippb.TripReceiptRequest{
+		TripId: int32(tripID),
+		UserId: int32(userID),
+	}
+	resp, err := app.GRPCClients.TripClient.GetTripReceipt(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetTripReceipt failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 // I ain't touching all that
 // ============================================
 // User Service gRPC Client Methods
@@ -618,6 +1079,24 @@ func (app *Config) UpdateVehicleViaGRPC(ctx context.Context, vehicleID int, lice
 	return resp, nil
 }
 
+// VerifyVehicleViaGRPC marks a vehicle verified, which makes its driver
+// eligible for trips of the vehicle's class.
+func (app *Config) VerifyVehicleViaGRPC(ctx context.Context, vehicleID int) (*userpb.UpdateVehicleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.UpdateVehicleRequest{
+		VehicleId: int32(vehicleID),
+		Verify:    true,
+	}
+	resp, err := app.GRPCClients.UserClient.UpdateVehicle(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC UpdateVehicle (verify) failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (app *Config) DeleteVehicleViaGRPC(ctx context.Context, vehicleID int) (*userpb.DeleteVehicleResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -632,3 +1111,253 @@ func (app *Config) DeleteVehicleViaGRPC(ctx context.Context, vehicleID int) (*us
 	}
 	return resp, nil
 }
+
+// ============================================
+// Support Ticket gRPC Client Methods
+// ============================================
+
+func (app *Config) OpenTicketViaGRPC(ctx context.Context, req *userpb.OpenTicketRequest) (*userpb.TicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.UserClient.OpenTicket(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC OpenTicket failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetTicketViaGRPC(ctx context.Context, ticketID int, userID int, isAdmin bool) (*userpb.TicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetTicketRequest{
+		TicketId: int32(ticketID),
+		UserId:   int32(userID),
+		IsAdmin:  isAdmin,
+	}
+	resp, err := app.GRPCClients.UserClient.GetTicket(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetTicket failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) ListTicketsViaGRPC(ctx context.Context, req *userpb.ListTicketsRequest) (*userpb.ListTicketsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.UserClient.ListTickets(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListTickets failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) AddTicketMessageViaGRPC(ctx context.Context, ticketID int, userID int, isAdmin bool, body string) (*userpb.TicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.AddTicketMessageRequest{
+		TicketId: int32(ticketID),
+		UserId:   int32(userID),
+		IsAdmin:  isAdmin,
+		Body:     body,
+	}
+	resp, err := app.GRPCClients.UserClient.AddTicketMessage(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC AddTicketMessage failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) UpdateTicketStatusViaGRPC(ctx context.Context, ticketID int, status string) (*userpb.TicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.UpdateTicketStatusRequest{
+		TicketId: int32(ticketID),
+		Status:   status,
+	}
+	resp, err := app.GRPCClients.UserClient.UpdateTicketStatus(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC UpdateTicketStatus failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) AssignTicketViaGRPC(ctx context.Context, ticketID int, assigneeID int) (*userpb.TicketResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.AssignTicketRequest{
+		TicketId:   int32(ticketID),
+		AssigneeId: int32(assigneeID),
+	}
+	resp, err := app.GRPCClients.UserClient.AssignTicket(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC AssignTicket failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ============================================
+// Saved Place gRPC Client Methods
+// ============================================
+
+func (app *Config) CreateSavedPlaceViaGRPC(ctx context.Context, req *userpb.CreateSavedPlaceRequest) (*userpb.SavedPlaceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.UserClient.CreateSavedPlace(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC CreateSavedPlace failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetSavedPlaceViaGRPC(ctx context.Context, placeID int, userID int) (*userpb.SavedPlaceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetSavedPlaceRequest{
+		PlaceId: int32(placeID),
+		UserId:  int32(userID),
+	}
+	resp, err := app.GRPCClients.UserClient.GetSavedPlace(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetSavedPlace failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) ListSavedPlacesViaGRPC(ctx context.Context, userID int) (*userpb.ListSavedPlacesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.ListSavedPlacesRequest{
+		UserId: int32(userID),
+	}
+	resp, err := app.GRPCClients.UserClient.ListSavedPlaces(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListSavedPlaces failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) UpdateSavedPlaceViaGRPC(ctx context.Context, req *userpb.UpdateSavedPlaceRequest) (*userpb.SavedPlaceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.UserClient.UpdateSavedPlace(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC UpdateSavedPlace failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) DeleteSavedPlaceViaGRPC(ctx context.Context, placeID int, userID int) (*userpb.DeleteSavedPlaceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.DeleteSavedPlaceRequest{
+		PlaceId: int32(placeID),
+		UserId:  int32(userID),
+	}
+	resp, err := app.GRPCClients.UserClient.DeleteSavedPlace(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC DeleteSavedPlace failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ============================================
+// Block List gRPC Client Methods
+// ============================================
+
+func (app *Config) BlockUserViaGRPC(ctx context.Context, userID int, blockedUserID int, reason string) (*userpb.BlockUserResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.BlockUserRequest{
+		UserId:        int32(userID),
+		BlockedUserId: int32(blockedUserID),
+		Reason:        reason,
+	}
+	resp, err := app.GRPCClients.UserClient.BlockUser(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC BlockUser failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) UnblockUserViaGRPC(ctx context.Context, userID int, blockedUserID int) (*userpb.UnblockUserResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.UnblockUserRequest{
+		UserId:        int32(userID),
+		BlockedUserId: int32(blockedUserID),
+	}
+	resp, err := app.GRPCClients.UserClient.UnblockUser(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC UnblockUser failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) ListBlockedUsersViaGRPC(ctx context.Context, userID int) (*userpb.ListBlockedUsersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.ListBlockedUsersRequest{
+		UserId: int32(userID),
+	}
+	resp, err := app.GRPCClients.UserClient.ListBlockedUsers(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC ListBlockedUsers failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetDriverProfileViaGRPC(ctx context.Context, driverID int) (*userpb.GetDriverProfileResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetDriverProfileRequest{
+		DriverId: int32(driverID),
+	}
+	resp, err := app.GRPCClients.UserClient.GetDriverProfile(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetDriverProfile failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (app *Config) GetDriverOfferReportViaGRPC(ctx context.Context, req *trippb.DriverOfferReportRequest) (*trippb.DriverOfferReportResponse, error) {
+	// Aggregates over long ranges take longer than the usual lookups.
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	resp, err := app.GRPCClients.TripClient.GetDriverOfferReport(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "gRPC GetDriverOfferReport failed", "error", err)
+		return nil, err
+	}
+	return resp, nil
+}
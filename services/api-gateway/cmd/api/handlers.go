@@ -1,14 +1,22 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	trippb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type RegisterPayload struct {
@@ -38,12 +46,18 @@ type LocationPayload struct {
 	Radius    float64 `json:"radius,omitempty"`
 }
 
+// CreateTripRequest takes each end of the trip either as coordinates or as
+// one of the passenger's saved places.
 type CreateTripRequest struct {
-	OriginLat     float64 `json:"origin_lat" validate:"required"`
-	OriginLng     float64 `json:"origin_lng" validate:"required"`
-	DestLat       float64 `json:"dest_lat" validate:"required"`
-	DestLng       float64 `json:"dest_lng" validate:"required"`
+	OriginLat     float64 `json:"origin_lat" validate:"required_without=OriginPlaceID"`
+	OriginLng     float64 `json:"origin_lng" validate:"required_without=OriginPlaceID"`
+	DestLat       float64 `json:"dest_lat" validate:"required_without=DestPlaceID"`
+	DestLng       float64 `json:"dest_lng" validate:"required_without=DestPlaceID"`
+	OriginPlaceID int     `json:"origin_place_id,omitempty" validate:"gte=0"`
+	DestPlaceID   int     `json:"dest_place_id,omitempty" validate:"gte=0"`
 	PaymentMethod string  `json:"payment_method" validate:"required,oneof=cash card"`
+	// VehicleClass defaults to car_4.
+	VehicleClass string `json:"vehicle_class,omitempty" validate:"omitempty,oneof=motorbike car_4 car_7 premium"`
 }
 
 type UpdateTripStatusRequest struct {
@@ -323,20 +337,40 @@ func (app *Config) CreateTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	idemKey, err := idempotencyKey(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	var tripReq CreateTripRequest
 	err = request.ReadAndValidate(w, r, &tripReq)
 	if request.HandleError(w, err) {
 		response.BadRequest(w, "Invalid request payload: "+err.Error())
 		return
 	}
-	resp, err := app.CreateTripViaGRPC(ctx,
-		int(claims.UserID),
-		tripReq.OriginLat,
-		tripReq.OriginLng,
-		tripReq.DestLat,
-		tripReq.DestLng,
-		tripReq.PaymentMethod,
-	)
+	resp, err := app.CreateTripViaGRPC(ctx, &trippb.CreateTripRequest{
+		PassengerId:    claims.UserID,
+		OriginLat:      tripReq.OriginLat,
+		OriginLng:      tripReq.OriginLng,
+		DestLat:        tripReq.DestLat,
+		DestLng:        tripReq.DestLng,
+		OriginPlaceId:  int32(tripReq.OriginPlaceID),
+		DestPlaceId:    int32(tripReq.DestPlaceID),
+		PaymentMethod:  tripReq.PaymentMethod,
+		VehicleClass:   tripReq.VehicleClass,
+		IdempotencyKey: idemKey,
+	})
+	if writeIdempotencyError(w, err) {
+		return
+	}
+	if st, _ := status.FromError(err); st.Code() == codes.NotFound {
+		response.NotFound(w, st.Message())
+		return
+	} else if st.Code() == codes.InvalidArgument {
+		response.BadRequest(w, st.Message())
+		return
+	}
 	if err != nil {
 		response.InternalServerError(w, "Failed to create trip: "+err.Error())
 		return
@@ -354,6 +388,12 @@ func (app *Config) AcceptTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	idemKey, err := idempotencyKey(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	tripID := chi.URLParam(r, "tripID")
 	if tripID == "" {
 		response.BadRequest(w, "Invalid trip ID")
@@ -364,7 +404,17 @@ func (app *Config) AcceptTrip(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Trip ID must be an integer")
 		return
 	}
-	resp, err := app.AcceptTripViaGRPC(ctx, int(claims.UserID), tripIDInt)
+	resp, err := app.AcceptTripViaGRPC(ctx, int(claims.UserID), tripIDInt, idemKey)
+	if writeIdempotencyError(w, err) {
+		return
+	}
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+		return
+	}
 	if err != nil || !resp.Success {
 		response.InternalServerError(w, "Failed to accept trip: "+err.Error())
 		return
@@ -382,6 +432,12 @@ func (app *Config) RejectTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	idemKey, err := idempotencyKey(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	tripID := chi.URLParam(r, "tripID")
 	if tripID == "" {
 		response.BadRequest(w, "Invalid trip ID")
@@ -392,7 +448,10 @@ func (app *Config) RejectTrip(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Trip ID must be an integer")
 		return
 	}
-	resp, err := app.RejectTripViaGRPC(ctx, int(claims.UserID), tripIDInt)
+	resp, err := app.RejectTripViaGRPC(ctx, int(claims.UserID), tripIDInt, idemKey)
+	if writeIdempotencyError(w, err) {
+		return
+	}
 	if err != nil || !resp.Success {
 		response.InternalServerError(w, "Failed to reject trip: "+err.Error())
 		return
@@ -536,6 +595,12 @@ func (app *Config) UpdateTripStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	idemKey, err := idempotencyKey(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	tripID := chi.URLParam(r, "tripID")
 	if tripID == "" {
 		response.BadRequest(w, "Invalid trip ID")
@@ -558,7 +623,11 @@ func (app *Config) UpdateTripStatus(w http.ResponseWriter, r *http.Request) {
 		tripIDInt,
 		int(claims.UserID),
 		statusReq.Status,
+		idemKey,
 	)
+	if writeIdempotencyError(w, err) {
+		return
+	}
 	if err != nil || !resp.Success {
 		response.InternalServerError(w, "Failed to update trip status: "+err.Error())
 		return
@@ -566,6 +635,28 @@ func (app *Config) UpdateTripStatus(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, "Trip status updated successfully", nil)
 }
 
+// writeTripRuleError answers trip-service refusing a cancel or review: 403
+// for someone who may not, 409 when the trip's status doesn't allow it. It
+// reports false for any other error.
+func writeTripRuleError(w http.ResponseWriter, err error) bool {
+	if err == nil {
+		return false
+	}
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	case codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		return false
+	}
+	return true
+}
+
 func (app *Config) CancelTrip(w http.ResponseWriter, r *http.Request) {
 	ctx, span := telemetry.StartSpan(r.Context(), "CancelTrip")
 	defer span.End()
@@ -576,6 +667,12 @@ func (app *Config) CancelTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	idemKey, err := idempotencyKey(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
 	tripID := chi.URLParam(r, "tripID")
 	if tripID == "" {
 		response.BadRequest(w, "Invalid trip ID")
@@ -587,7 +684,10 @@ func (app *Config) CancelTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := app.CancelTripViaGRPC(ctx, tripIDInt, int(claims.UserID))
+	resp, err := app.CancelTripViaGRPC(ctx, tripIDInt, int(claims.UserID), idemKey)
+	if writeIdempotencyError(w, err) || writeTripRuleError(w, err) {
+		return
+	}
 	if err != nil || !resp.Success {
 		response.InternalServerError(w, "Failed to cancel trip: "+err.Error())
 		return
@@ -629,6 +729,9 @@ func (app *Config) SubmitReview(w http.ResponseWriter, r *http.Request) {
 		reviewReq.Rating,
 		reviewReq.Comment,
 	)
+	if writeTripRuleError(w, err) {
+		return
+	}
 	if err != nil || !resp.Success {
 		response.InternalServerError(w, "Failed to submit review: "+err.Error())
 		return
@@ -664,6 +767,114 @@ func (app *Config) GetTripReview(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, "Trip review retrieved successfully", resp)
 }
 
+// GetTripReport returns aggregated trip statistics. Query parameters (all
+// optional): from/to (RFC 3339, default the last 7 days), interval (hour|day),
+// min_lat/min_lng/max_lat/max_lng (zone, all four or none) and zone_cell
+// (grid cell size in degrees for a per-zone breakdown).
+func (app *Config) GetTripReport(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetTripReport")
+	defer span.End()
+
+	req, err := tripReportRequest(r)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	resp, err := app.GetTripReportViaGRPC(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			response.BadRequest(w, st.Message())
+			return
+		}
+		response.InternalServerError(w, "Failed to get trip report: "+err.Error())
+		return
+	}
+	response.Success(w, "Trip report retrieved successfully", resp)
+}
+
+func tripReportRequest(r *http.Request) (*trippb.TripReportRequest, error) {
+	query := r.URL.Query()
+	req := &trippb.TripReportRequest{}
+
+	var err error
+	if req.From, req.To, err = timeRangeQuery(query); err != nil {
+		return nil, err
+	}
+
+	switch query.Get("interval") {
+	case "", "day":
+		req.Interval = trippb.ReportInterval_REPORT_INTERVAL_DAY
+	case "hour":
+		req.Interval = trippb.ReportInterval_REPORT_INTERVAL_HOUR
+	default:
+		return nil, errors.New("interval must be hour or day")
+	}
+
+	zone, err := zoneQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	req.Zone = zone
+
+	if v := query.Get("zone_cell"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, errors.New("zone_cell must be a number")
+		}
+		req.ZoneCellSize = f
+	}
+	return req, nil
+}
+
+// timeRangeQuery parses the optional from/to RFC 3339 query parameters.
+func timeRangeQuery(query url.Values) (from, to *timestamppb.Timestamp, err error) {
+	parse := func(name string) (*timestamppb.Timestamp, error) {
+		v := query.Get(name)
+		if v == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+		}
+		return timestamppb.New(t), nil
+	}
+	if from, err = parse("from"); err != nil {
+		return nil, nil, err
+	}
+	if to, err = parse("to"); err != nil {
+		return nil, nil, err
+	}
+	return from, to, nil
+}
+
+// zoneQuery parses the optional min_lat/min_lng/max_lat/max_lng query
+// parameters, which must be given together.
+func zoneQuery(query url.Values) (*trippb.BoundingBox, error) {
+	zoneParams := []string{"min_lat", "min_lng", "max_lat", "max_lng"}
+	zone := make([]float64, 0, len(zoneParams))
+	for _, name := range zoneParams {
+		v := query.Get(name)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", name)
+		}
+		zone = append(zone, f)
+	}
+	switch len(zone) {
+	case 0:
+		return nil, nil
+	case len(zoneParams):
+		return &trippb.BoundingBox{MinLat: zone[0], MinLng: zone[1], MaxLat: zone[2], MaxLng: zone[3]}, nil
+	default:
+		return nil, errors.New("zone needs all of min_lat, min_lng, max_lat and max_lng")
+	}
+}
+
 // ============================================
 // User Handlers (THIS IS GEN RAW DOG BY AI, DONT ASK ME, ASK AI)
 // ============================================
@@ -932,6 +1143,27 @@ func (app *Config) UpdateVehicle(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, resp.Message, resp.Vehicle)
 }
 
+// VerifyVehicle lets an admin mark a vehicle as checked. Only verified,
+// active vehicles are matched to trips.
+func (app *Config) VerifyVehicle(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "VerifyVehicle")
+	defer span.End()
+
+	vehicleID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.BadRequest(w, "Vehicle ID must be an integer")
+		return
+	}
+
+	resp, err := app.VerifyVehicleViaGRPC(ctx, vehicleID)
+	if err != nil || !resp.Success {
+		response.InternalServerError(w, "Failed to verify vehicle")
+		return
+	}
+
+	response.Success(w, "Vehicle verified successfully", resp.Vehicle)
+}
+
 func (app *Config) DeleteVehicle(w http.ResponseWriter, r *http.Request) {
 	ctx, span := telemetry.StartSpan(r.Context(), "DeleteVehicle")
 	defer span.End()
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/grpcutil"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotencyKey returns the optional Idempotency-Key header. Clients send a
+// unique value (e.g. a UUID) per logical operation and reuse it on retries so
+// the downstream service replays the original result instead of re-executing.
+func idempotencyKey(r *http.Request) (string, error) {
+	key := r.Header.Get(idempotencyKeyHeader)
+	if len(key) > maxIdempotencyKeyLength {
+		return "", errors.New("Idempotency-Key must be at most 255 characters")
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x21 || key[i] > 0x7e {
+			return "", errors.New("Idempotency-Key must contain only visible ASCII characters")
+		}
+	}
+	return key, nil
+}
+
+// writeIdempotencyError maps idempotency conflicts reported over gRPC to HTTP
+// responses. It returns false when err is not an idempotency conflict: the
+// codes are shared with business rule errors, so only errors carrying an
+// idempotency reason are taken.
+func writeIdempotencyError(w http.ResponseWriter, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch grpcutil.Reason(err) {
+	case grpcutil.ReasonIdempotencyKeyInProgress:
+		// The original request is still running; the client should retry later.
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	case grpcutil.ReasonIdempotencyKeyReused:
+		response.WriteJSON(w, http.StatusUnprocessableEntity, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		return false
+	}
+	return true
+}
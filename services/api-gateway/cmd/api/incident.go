@@ -0,0 +1,201 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Safety Incident Handlers
+// ============================================
+
+type RaiseSOSRequest struct {
+	Note string `json:"note,omitempty" validate:"max=1000"`
+}
+
+type CloseIncidentRequest struct {
+	Resolution string `json:"resolution" validate:"required,max=1000"`
+}
+
+// writeIncidentError turns trip-service incident status codes into HTTP
+// responses.
+func writeIncidentError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+func incidentIDParam(r *http.Request) (int64, bool) {
+	incidentID, err := strconv.ParseInt(chi.URLParam(r, "incidentID"), 10, 64)
+	return incidentID, err == nil && incidentID > 0
+}
+
+// RaiseSOS is the panic button for a passenger or driver on an active trip.
+// The body is optional so the app can fire it with a single tap. A new
+// incident answers 201; pressing again while it is open answers 200 with the
+// same incident.
+func (app *Config) RaiseSOS(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "RaiseSOS")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	var req RaiseSOSRequest
+	if r.ContentLength != 0 {
+		err = request.ReadAndValidate(w, r, &req)
+		if request.HandleError(w, err) {
+			return
+		}
+	}
+
+	resp, err := app.RaiseSOSViaGRPC(ctx, tripID, int(claims.UserID), req.Note)
+	if err != nil {
+		writeIncidentError(w, err, "Failed to raise SOS")
+		return
+	}
+	if !resp.Created {
+		response.Success(w, "SOS is already active for this trip", resp.Incident)
+		return
+	}
+	response.Created(w, "SOS raised, the safety team has been alerted", resp.Incident)
+}
+
+// ListIncidents is the safety operators' queue. Query parameters: status
+// (OPEN or CLOSED) and trip_id.
+func (app *Config) ListIncidents(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListIncidents")
+	defer span.End()
+
+	query := r.URL.Query()
+	tripID := 0
+	if v := query.Get("trip_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			response.BadRequest(w, "trip_id must be a positive integer")
+			return
+		}
+		tripID = id
+	}
+
+	resp, err := app.ListIncidentsViaGRPC(ctx, query.Get("status"), tripID)
+	if err != nil {
+		writeIncidentError(w, err, "Failed to list incidents")
+		return
+	}
+	response.Success(w, "Incidents retrieved successfully", resp.Incidents)
+}
+
+func (app *Config) GetIncident(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetIncident")
+	defer span.End()
+
+	incidentID, ok := incidentIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Incident ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.GetIncidentViaGRPC(ctx, incidentID)
+	if err != nil {
+		writeIncidentError(w, err, "Failed to get incident")
+		return
+	}
+	response.Success(w, "Incident retrieved successfully", resp)
+}
+
+// GetIncidentTrack returns the positions recorded since the incident was
+// raised, oldest first. Operators following a live incident poll with
+// after_id set to the last point they have.
+func (app *Config) GetIncidentTrack(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetIncidentTrack")
+	defer span.End()
+
+	incidentID, ok := incidentIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Incident ID must be a positive integer")
+		return
+	}
+	query := r.URL.Query()
+	var afterID int64
+	var err error
+	if v := query.Get("after_id"); v != "" {
+		if afterID, err = strconv.ParseInt(v, 10, 64); err != nil || afterID < 0 {
+			response.BadRequest(w, "after_id must be a non-negative integer")
+			return
+		}
+	}
+	limit := 0
+	if v := query.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			response.BadRequest(w, "limit must be a positive integer")
+			return
+		}
+	}
+
+	resp, err := app.GetIncidentTrackViaGRPC(ctx, incidentID, afterID, limit)
+	if err != nil {
+		writeIncidentError(w, err, "Failed to get incident track")
+		return
+	}
+	response.Success(w, "Incident track retrieved successfully", resp.Points)
+}
+
+// CloseIncident is used by an operator once the situation is resolved; it
+// stops the location recording.
+func (app *Config) CloseIncident(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "CloseIncident")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	incidentID, ok := incidentIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Incident ID must be a positive integer")
+		return
+	}
+
+	var req CloseIncidentRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.CloseIncidentViaGRPC(ctx, incidentID, int(claims.UserID), req.Resolution)
+	if err != nil {
+		writeIncidentError(w, err, "Failed to close incident")
+		return
+	}
+	response.Success(w, "Incident closed", resp)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+)
+
+// ============================================
+// Batched Location Handlers
+// ============================================
+
+// LocationBatchPing is one GPS ping in a batch, oldest first.
+type LocationBatchPing struct {
+	Latitude  float64 `json:"latitude" validate:"min=-90,max=90"`
+	Longitude float64 `json:"longitude" validate:"min=-180,max=180"`
+	Speed     float64 `json:"speed,omitempty"`
+	Heading   string  `json:"heading,omitempty"`
+	Timestamp string  `json:"timestamp,omitempty"`
+}
+
+type LocationBatchRequest struct {
+	Locations []LocationBatchPing `json:"locations" validate:"required,min=1,max=500,dive"`
+}
+
+// SetLocationBatch applies the pings a client buffered since its last upload
+// in one call, instead of one SetLocation per ping.
+func (app *Config) SetLocationBatch(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "SetLocationBatch")
+	defer span.End()
+
+	var req LocationBatchRequest
+	err := request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	batch := &locationpb.LocationBatch{
+		Sequence:  1,
+		Locations: make([]*locationpb.SetLocationRequest, 0, len(req.Locations)),
+	}
+	for _, ping := range req.Locations {
+		batch.Locations = append(batch.Locations, &locationpb.SetLocationRequest{
+			UserId:    int32(claims.UserID),
+			Role:      claims.Role,
+			Latitude:  ping.Latitude,
+			Longitude: ping.Longitude,
+			Speed:     ping.Speed,
+			Heading:   ping.Heading,
+			Timestamp: ping.Timestamp,
+		})
+	}
+
+	ack, err := app.StreamLocationsViaGRPC(ctx, []*locationpb.LocationBatch{batch})
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to set locations")
+		return
+	}
+	response.Success(w, "Locations set successfully", map[string]interface{}{
+		"applied":  ack.Applied,
+		"rejected": ack.Rejected,
+	})
+}
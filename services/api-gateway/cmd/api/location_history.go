@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	"github.com/go-chi/chi/v5"
+)
+
+// ============================================
+// Location History Handlers
+// ============================================
+
+// locationHistoryQuery builds a history request for userID from the optional
+// from/to (RFC 3339), interval (a duration such as 30s or 5m) and limit query
+// parameters.
+func locationHistoryQuery(userID int, query url.Values) (*locationpb.GetLocationHistoryRequest, error) {
+	req := &locationpb.GetLocationHistoryRequest{UserId: int32(userID)}
+	for _, name := range []string{"from", "to"} {
+		v := query.Get(name)
+		if v == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+		}
+	}
+	req.From = query.Get("from")
+	req.To = query.Get("to")
+	if v := query.Get("interval"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval < time.Second {
+			return nil, fmt.Errorf("interval must be a duration of at least 1s, such as 30s or 5m")
+		}
+		req.IntervalSeconds = int32(interval / time.Second)
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("limit must be a positive integer")
+		}
+		req.Limit = int32(limit)
+	}
+	return req, nil
+}
+
+func (app *Config) writeLocationHistory(w http.ResponseWriter, r *http.Request, userID int) {
+	req, err := locationHistoryQuery(userID, r.URL.Query())
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	resp, err := app.GetLocationHistoryViaGRPC(r.Context(), req)
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to get location history")
+		return
+	}
+
+	points := make([]map[string]interface{}, 0, len(resp.Points))
+	for _, p := range resp.Points {
+		points = append(points, map[string]interface{}{
+			"recorded_at": p.RecordedAt,
+			"role":        p.Role,
+			"latitude":    p.Latitude,
+			"longitude":   p.Longitude,
+			"speed":       p.Speed,
+			"heading":     p.Heading,
+			"timestamp":   p.Timestamp,
+		})
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"user_id":   userID,
+		"points":    points,
+		"truncated": resp.Truncated,
+	})
+}
+
+// GetMyLocationHistory returns the caller's own recorded locations.
+func (app *Config) GetMyLocationHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetMyLocationHistory")
+	defer span.End()
+
+	claims, err := app.GetClaims(ctx)
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	app.writeLocationHistory(w, r.WithContext(ctx), int(claims.UserID))
+}
+
+// GetLocationHistory returns any user's recorded locations, for investigating
+// disputes and reconstructing trips.
+func (app *Config) GetLocationHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetLocationHistory")
+	defer span.End()
+
+	userID, err := strconv.Atoi(chi.URLParam(r, "userID"))
+	if err != nil || userID <= 0 {
+		response.BadRequest(w, "User ID must be a positive integer")
+		return
+	}
+	app.writeLocationHistory(w, r.WithContext(ctx), userID)
+}
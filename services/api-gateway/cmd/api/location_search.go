@@ -0,0 +1,166 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+)
+
+// ============================================
+// Location Search Handlers
+// ============================================
+
+// locationSearchQuery builds a search from either lat/lng with an optional
+// radius in km, or a min_lat/min_lng/max_lat/max_lng box, plus an optional
+// limit.
+func locationSearchQuery(query url.Values) (*locationpb.SearchLocationsRequest, error) {
+	req := &locationpb.SearchLocationsRequest{}
+	zone, err := zoneQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if zone != nil {
+		req.Box = &locationpb.BoundingBox{MinLat: zone.MinLat, MinLng: zone.MinLng, MaxLat: zone.MaxLat, MaxLng: zone.MaxLng}
+	} else {
+		if query.Get("lat") == "" || query.Get("lng") == "" {
+			return nil, errors.New("either lat and lng or a min_lat/min_lng/max_lat/max_lng box is required")
+		}
+		params := []struct {
+			name string
+			dst  *float64
+		}{{"lat", &req.Latitude}, {"lng", &req.Longitude}, {"radius", &req.Radius}}
+		for _, p := range params {
+			v := query.Get(p.name)
+			if v == "" {
+				continue
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a number", p.name)
+			}
+			*p.dst = f
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return nil, errors.New("limit must be a positive integer")
+		}
+		req.Limit = int32(limit)
+	}
+	return req, nil
+}
+
+func (app *Config) writeLocationSearch(w http.ResponseWriter, r *http.Request, req *locationpb.SearchLocationsRequest) {
+	resp, err := app.SearchLocationsViaGRPC(r.Context(), req)
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to search locations")
+		return
+	}
+
+	locations := make([]map[string]interface{}, 0, len(resp.Locations))
+	for _, l := range resp.Locations {
+		locations = append(locations, map[string]interface{}{
+			"user_id":   l.UserId,
+			"role":      l.Role,
+			"latitude":  l.Latitude,
+			"longitude": l.Longitude,
+			"speed":     l.Speed,
+			"heading":   l.Heading,
+			"timestamp": l.Timestamp,
+			"distance":  l.Distance,
+		})
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"locations": locations,
+		"count":     len(locations),
+	})
+}
+
+// SearchDrivers lists the online drivers around a point or inside a box,
+// such as a pickup point before booking.
+func (app *Config) SearchDrivers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "SearchDrivers")
+	defer span.End()
+
+	req, err := locationSearchQuery(r.URL.Query())
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	req.Role = "driver"
+	app.writeLocationSearch(w, r.WithContext(ctx), req)
+}
+
+// SearchLocations lets operators find drivers, passengers or both around a
+// point or inside a map viewport.
+func (app *Config) SearchLocations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "SearchLocations")
+	defer span.End()
+
+	req, err := locationSearchQuery(r.URL.Query())
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	req.Role = r.URL.Query().Get("role")
+	app.writeLocationSearch(w, r.WithContext(ctx), req)
+}
+
+// GetHeatmap counts the drivers and passengers inside a
+// min_lat/min_lng/max_lat/max_lng box per geohash cell, for the ops
+// dashboard's density map. precision is the geohash length, 1 to 9.
+func (app *Config) GetHeatmap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetHeatmap")
+	defer span.End()
+
+	query := r.URL.Query()
+	zone, err := zoneQuery(query)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	if zone == nil {
+		response.BadRequest(w, "min_lat, min_lng, max_lat and max_lng are required")
+		return
+	}
+	req := &locationpb.GetHeatmapRequest{
+		Box: &locationpb.BoundingBox{MinLat: zone.MinLat, MinLng: zone.MinLng, MaxLat: zone.MaxLat, MaxLng: zone.MaxLng},
+	}
+	if v := query.Get("precision"); v != "" {
+		precision, err := strconv.Atoi(v)
+		if err != nil {
+			response.BadRequest(w, "precision must be an integer")
+			return
+		}
+		req.Precision = int32(precision)
+	}
+
+	resp, err := app.GetHeatmapViaGRPC(ctx, req)
+	if err != nil {
+		writeAvailabilityError(w, err, "Failed to build heatmap")
+		return
+	}
+
+	cells := make([]map[string]interface{}, 0, len(resp.Cells))
+	for _, c := range resp.Cells {
+		cells = append(cells, map[string]interface{}{
+			"geohash":    c.Geohash,
+			"latitude":   c.Latitude,
+			"longitude":  c.Longitude,
+			"drivers":    c.Drivers,
+			"passengers": c.Passengers,
+		})
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"cells":            cells,
+		"total_drivers":    resp.TotalDrivers,
+		"total_passengers": resp.TotalPassengers,
+	})
+}
@@ -19,6 +19,9 @@ type contextKey string
 
 const claimsKey contextKey = "claims"
 
+// RoleAdmin is the user role allowed on /admin routes.
+const RoleAdmin = "admin"
+
 func (app *Config) AuthRequired(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Add("Vary", "Authorization")
@@ -54,6 +57,23 @@ func (app *Config) AuthRequired(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// AdminRequired only lets admins through. It must run after AuthRequired.
+func (app *Config) AdminRequired(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := app.GetClaims(r.Context())
+		if err != nil {
+			response.Unauthorized(w, "Unauthorized: "+err.Error())
+			return
+		}
+		if claims.Role != RoleAdmin {
+			response.Forbidden(w, "Admin access required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (app *Config) GetClaims(ctx context.Context) (*Claims, error) {
 	claims, ok := ctx.Value(claimsKey).(*Claims)
 	if !ok {
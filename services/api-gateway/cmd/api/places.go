@@ -0,0 +1,188 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Saved Place Handlers
+// ============================================
+
+// SavedPlaceRequest is the body of both create and update; an update replaces
+// the whole place.
+type SavedPlaceRequest struct {
+	Kind      string  `json:"kind" validate:"required,oneof=home work custom"`
+	Label     string  `json:"label,omitempty" validate:"required_if=Kind custom,max=50"`
+	Address   string  `json:"address" validate:"required,max=255"`
+	Latitude  float64 `json:"latitude" validate:"required,min=-90,max=90"`
+	Longitude float64 `json:"longitude" validate:"required,min=-180,max=180"`
+}
+
+// writePlaceError turns user-service saved place status codes into HTTP
+// responses.
+func writePlaceError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+func placeIDParam(r *http.Request) (int, bool) {
+	placeID, err := strconv.Atoi(chi.URLParam(r, "placeID"))
+	return placeID, err == nil && placeID > 0
+}
+
+// ListSavedPlaces returns the caller's saved places, home and work first.
+func (app *Config) ListSavedPlaces(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListSavedPlaces")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	resp, err := app.ListSavedPlacesViaGRPC(ctx, int(claims.UserID))
+	if err != nil {
+		writePlaceError(w, err, "Failed to list saved places")
+		return
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"places":      resp.Places,
+		"total_count": resp.TotalCount,
+	})
+}
+
+func (app *Config) CreateSavedPlace(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "CreateSavedPlace")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var req SavedPlaceRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.CreateSavedPlaceViaGRPC(ctx, &userpb.CreateSavedPlaceRequest{
+		UserId:    claims.UserID,
+		Kind:      req.Kind,
+		Label:     req.Label,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		writePlaceError(w, err, "Failed to save place")
+		return
+	}
+	response.Created(w, resp.Message, resp.Place)
+}
+
+func (app *Config) GetSavedPlace(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetSavedPlace")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	placeID, ok := placeIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Place ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.GetSavedPlaceViaGRPC(ctx, placeID, int(claims.UserID))
+	if err != nil {
+		writePlaceError(w, err, "Failed to get saved place")
+		return
+	}
+	response.Success(w, resp.Message, resp.Place)
+}
+
+func (app *Config) UpdateSavedPlace(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "UpdateSavedPlace")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	placeID, ok := placeIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Place ID must be a positive integer")
+		return
+	}
+
+	var req SavedPlaceRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.UpdateSavedPlaceViaGRPC(ctx, &userpb.UpdateSavedPlaceRequest{
+		PlaceId:   int32(placeID),
+		UserId:    claims.UserID,
+		Kind:      req.Kind,
+		Label:     req.Label,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		writePlaceError(w, err, "Failed to update saved place")
+		return
+	}
+	response.Success(w, resp.Message, resp.Place)
+}
+
+func (app *Config) DeleteSavedPlace(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "DeleteSavedPlace")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	placeID, ok := placeIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Place ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.DeleteSavedPlaceViaGRPC(ctx, placeID, int(claims.UserID))
+	if err != nil {
+		writePlaceError(w, err, "Failed to delete saved place")
+		return
+	}
+	response.Success(w, resp.Message, nil)
+}
@@ -41,7 +41,7 @@ func (app *Config) routes() http.Handler {
 	mux.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Request-ID"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "X-Request-ID", "Idempotency-Key", "Last-Event-ID"},
 		ExposedHeaders:   []string{"Link", "X-Request-ID"},
 		AllowCredentials: true,
 		MaxAge:           300,
@@ -101,12 +101,22 @@ func (app *Config) routes() http.Handler {
 		app.logItemViaGRPCClient(w, r, logPayload)
 	})
 
+	// Public live view of a shared trip; the token is the only credential.
+	mux.Get("/share/{token}", app.GetSharedTrip)
+
 	mux.Route("/location", func(r chi.Router) {
 		r.Use(app.AuthRequired)
 		r.Get("/me", app.getLocationViaGRPC)
 		r.Post("/", app.setLocationViaGRPC)
+		r.Post("/batch", app.SetLocationBatch)
 		r.Get("/nearest", app.findNearestUsersViaGRPC)
+		r.Get("/drivers", app.SearchDrivers)
 		r.Get("/", app.getAllLocationsViaGRPC)
+		r.Delete("/me", app.RemoveMyLocation)
+		r.Post("/online", app.GoOnline)
+		r.Post("/offline", app.GoOffline)
+		r.Get("/availability", app.GetMyAvailability)
+		r.Get("/me/history", app.GetMyLocationHistory)
 	})
 
 	mux.Route("/trip", func(r chi.Router) {
@@ -123,6 +133,49 @@ func (app *Config) routes() http.Handler {
 		r.Put("/cancel/{tripID}", app.CancelTrip)
 		r.Put("/review/{tripID}", app.SubmitReview)
 		r.Get("/review/{tripID}", app.GetTripReview)
+		r.Get("/chat/quick-replies", app.GetChatQuickReplies)
+		r.Post("/{tripID}/chat", app.SendChatMessage)
+		r.Get("/{tripID}/chat", app.GetChatHistory)
+		r.Put("/{tripID}/chat/read", app.MarkChatRead)
+		r.Get("/{tripID}/chat/live", app.StreamTripChat)
+		r.Post("/{tripID}/sos", app.RaiseSOS)
+		r.Post("/{tripID}/share", app.CreateShareLink)
+		r.Get("/{tripID}/share", app.ListShareLinks)
+		r.Delete("/{tripID}/share/{shareID}", app.RevokeShareLink)
+		r.Post("/{tripID}/tip", app.AddTip)
+		r.Get("/{tripID}/receipt", app.GetTripReceipt)
+	})
+
+	// Admin-only routes
+	mux.Route("/admin", func(r chi.Router) {
+		r.Use(app.AuthRequired)
+		r.Use(app.AdminRequired)
+		r.Get("/trips/report", app.GetTripReport)
+		r.Get("/trips/export", app.ExportTrips)
+		r.Get("/drivers/offers", app.GetDriverOfferReport)
+		r.Get("/support/tickets", app.ListTickets)
+		r.Get("/support/tickets/{ticketID}", app.GetTicket)
+		r.Post("/support/tickets/{ticketID}/messages", app.AddTicketMessage)
+		r.Put("/support/tickets/{ticketID}/status", app.UpdateTicketStatus)
+		r.Put("/support/tickets/{ticketID}/assign", app.AssignTicket)
+		r.Get("/incidents", app.ListIncidents)
+		r.Get("/incidents/{incidentID}", app.GetIncident)
+		r.Get("/incidents/{incidentID}/track", app.GetIncidentTrack)
+		r.Put("/incidents/{incidentID}/close", app.CloseIncident)
+		r.Put("/vehicles/{id}/verify", app.VerifyVehicle)
+		r.Put("/location/{userID}/role", app.UpdateLocationRole)
+		r.Get("/location/{userID}/history", app.GetLocationHistory)
+		r.Get("/location/search", app.SearchLocations)
+		r.Get("/location/heatmap", app.GetHeatmap)
+	})
+
+	// Support tickets for passengers and drivers
+	mux.Route("/support", func(r chi.Router) {
+		r.Use(app.AuthRequired)
+		r.Post("/tickets", app.OpenTicket)
+		r.Get("/tickets", app.ListMyTickets)
+		r.Get("/tickets/{ticketID}", app.GetTicket)
+		r.Post("/tickets/{ticketID}/messages", app.AddTicketMessage)
 	})
 
 	// User and Vehicle routes
@@ -134,6 +187,25 @@ func (app *Config) routes() http.Handler {
 		r.Put("/{id}", app.UpdateUser)
 		r.Delete("/{id}", app.DeleteUser)
 		r.Get("/{id}/vehicles", app.GetVehiclesByUserId)
+		r.Get("/{id}/driver-profile", app.GetDriverProfile)
+	})
+
+	// Saved places of the caller, usable as trip origin or destination
+	mux.Route("/places", func(r chi.Router) {
+		r.Use(app.AuthRequired)
+		r.Get("/", app.ListSavedPlaces)
+		r.Post("/", app.CreateSavedPlace)
+		r.Get("/{placeID}", app.GetSavedPlace)
+		r.Put("/{placeID}", app.UpdateSavedPlace)
+		r.Delete("/{placeID}", app.DeleteSavedPlace)
+	})
+
+	// Users the caller never wants to be matched with
+	mux.Route("/blocks", func(r chi.Router) {
+		r.Use(app.AuthRequired)
+		r.Get("/", app.ListBlockedUsers)
+		r.Post("/", app.BlockUser)
+		r.Delete("/{userID}", app.UnblockUser)
 	})
 
 	mux.Route("/vehicles", func(r chi.Router) {
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	trippb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Trip Share Link Handlers
+// ============================================
+
+type CreateShareLinkRequest struct {
+	// TTLSeconds is how long the link lasts; 0 uses the default (4 hours),
+	// anything over a day is capped.
+	TTLSeconds int `json:"ttl_seconds,omitempty" validate:"min=0"`
+}
+
+// ShareLinkResponse is a new link with the public path that serves it.
+type ShareLinkResponse struct {
+	*trippb.ShareLink
+	Path string `json:"path"`
+}
+
+// writeShareError turns trip-service share status codes into HTTP responses.
+// An expired link on the public route answers 410 so the viewer can tell it
+// apart from a link that never existed.
+func writeShareError(w http.ResponseWriter, err error, fallback string, gone bool) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.FailedPrecondition:
+		code := http.StatusConflict
+		if gone {
+			code = http.StatusGone
+		}
+		response.WriteJSON(w, code, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+// CreateShareLink lets the passenger mint a link to the trip's live view. The
+// token is only returned here; trip-service keeps just its hash.
+func (app *Config) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "CreateShareLink")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	var req CreateShareLinkRequest
+	if r.ContentLength != 0 {
+		err = request.ReadAndValidate(w, r, &req)
+		if request.HandleError(w, err) {
+			return
+		}
+	}
+
+	resp, err := app.CreateShareLinkViaGRPC(ctx, tripID, int(claims.UserID), req.TTLSeconds)
+	if err != nil {
+		writeShareError(w, err, "Failed to create share link", false)
+		return
+	}
+	response.Created(w, "Share link created", ShareLinkResponse{
+		ShareLink: resp,
+		Path:      "/share/" + resp.Token,
+	})
+}
+
+func (app *Config) ListShareLinks(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListShareLinks")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.ListShareLinksViaGRPC(ctx, tripID, int(claims.UserID))
+	if err != nil {
+		writeShareError(w, err, "Failed to list share links", false)
+		return
+	}
+	response.Success(w, "Share links retrieved successfully", resp.Links)
+}
+
+func (app *Config) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "RevokeShareLink")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+	shareID, err := strconv.ParseInt(chi.URLParam(r, "shareID"), 10, 64)
+	if err != nil || shareID <= 0 {
+		response.BadRequest(w, "Share ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.RevokeShareLinkViaGRPC(ctx, tripID, int(claims.UserID), shareID)
+	if err != nil {
+		writeShareError(w, err, "Failed to revoke share link", false)
+		return
+	}
+	response.Success(w, resp.Message, nil)
+}
+
+// GetSharedTrip serves the read-only live view behind a share link. It needs
+// no login: whoever has the token sees the trip status, the driver's first
+// name, vehicle, position and ETA, and nothing else.
+func (app *Config) GetSharedTrip(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetSharedTrip")
+	defer span.End()
+
+	// The view is live and the URL is a credential; keep it out of caches.
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	resp, err := app.GetSharedTripViaGRPC(ctx, chi.URLParam(r, "token"))
+	if err != nil {
+		writeShareError(w, err, "Failed to get shared trip", true)
+		return
+	}
+	response.Success(w, "Shared trip retrieved successfully", resp)
+}
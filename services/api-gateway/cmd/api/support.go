@@ -0,0 +1,275 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Support Ticket Handlers
+// ============================================
+
+type OpenTicketRequest struct {
+	TripID          int    `json:"trip_id" validate:"required,gt=0"`
+	Category        string `json:"category" validate:"required"`
+	Subject         string `json:"subject" validate:"required,max=200"`
+	Message         string `json:"message" validate:"required,max=5000"`
+	ItemDescription string `json:"item_description,omitempty" validate:"max=1000"`
+}
+
+type TicketMessageRequest struct {
+	Message string `json:"message" validate:"required,max=5000"`
+}
+
+type TicketStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=open pending resolved"`
+}
+
+type AssignTicketRequest struct {
+	// AssigneeID defaults to the calling admin.
+	AssigneeID int `json:"assignee_id,omitempty" validate:"gte=0"`
+}
+
+// writeTicketError turns user-service status codes into HTTP responses.
+func writeTicketError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+func ticketIDParam(r *http.Request) (int, bool) {
+	ticketID, err := strconv.Atoi(chi.URLParam(r, "ticketID"))
+	return ticketID, err == nil && ticketID > 0
+}
+
+// OpenTicket lets a passenger or driver report a problem with one of their
+// trips. The trip lookup doubles as the participation check, and decides
+// whether the ticket is opened as passenger or driver.
+func (app *Config) OpenTicket(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "OpenTicket")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	var req OpenTicketRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	trip, err := app.GetTripDetailViaGRPC(ctx, req.TripID, int(claims.UserID))
+	if err != nil {
+		if st, _ := status.FromError(err); st.Code() == codes.Unknown {
+			response.Forbidden(w, "Trip not found or you did not take part in it")
+			return
+		}
+		response.InternalServerError(w, "Failed to look up trip: "+err.Error())
+		return
+	}
+	role := "driver"
+	if trip.Trip.PassengerId == claims.UserID {
+		role = "passenger"
+	}
+
+	resp, err := app.OpenTicketViaGRPC(ctx, &userpb.OpenTicketRequest{
+		TripId:          int32(req.TripID),
+		UserId:          claims.UserID,
+		Role:            role,
+		Category:        req.Category,
+		Subject:         req.Subject,
+		Message:         req.Message,
+		ItemDescription: req.ItemDescription,
+	})
+	if err != nil {
+		writeTicketError(w, err, "Failed to open ticket")
+		return
+	}
+	response.Created(w, resp.Message, resp.Ticket)
+}
+
+// ListMyTickets returns the caller's own tickets, optionally filtered by ?status=.
+func (app *Config) ListMyTickets(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListMyTickets")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+
+	resp, err := app.ListTicketsViaGRPC(ctx, &userpb.ListTicketsRequest{
+		OpenedBy: claims.UserID,
+		Status:   r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		writeTicketError(w, err, "Failed to list tickets")
+		return
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"tickets":     resp.Tickets,
+		"total_count": resp.TotalCount,
+	})
+}
+
+// ListTickets is the admin queue. Query parameters: status, assignee_id,
+// trip_id and opened_by.
+func (app *Config) ListTickets(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "ListTickets")
+	defer span.End()
+
+	query := r.URL.Query()
+	req := &userpb.ListTicketsRequest{Status: query.Get("status")}
+	for name, dst := range map[string]*int32{"assignee_id": &req.AssigneeId, "trip_id": &req.TripId, "opened_by": &req.OpenedBy} {
+		if v := query.Get(name); v != "" {
+			id, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || id <= 0 {
+				response.BadRequest(w, name+" must be a positive integer")
+				return
+			}
+			*dst = int32(id)
+		}
+	}
+
+	resp, err := app.ListTicketsViaGRPC(ctx, req)
+	if err != nil {
+		writeTicketError(w, err, "Failed to list tickets")
+		return
+	}
+	response.Success(w, resp.Message, map[string]interface{}{
+		"tickets":     resp.Tickets,
+		"total_count": resp.TotalCount,
+	})
+}
+
+// GetTicket returns a ticket with its messages. Openers see their own
+// tickets; admins see all of them.
+func (app *Config) GetTicket(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetTicket")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	ticketID, ok := ticketIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Ticket ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.GetTicketViaGRPC(ctx, ticketID, int(claims.UserID), claims.Role == RoleAdmin)
+	if err != nil {
+		writeTicketError(w, err, "Failed to get ticket")
+		return
+	}
+	response.Success(w, resp.Message, resp.Ticket)
+}
+
+// AddTicketMessage posts a reply to a ticket thread, as the opener or as
+// support staff.
+func (app *Config) AddTicketMessage(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "AddTicketMessage")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	ticketID, ok := ticketIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Ticket ID must be a positive integer")
+		return
+	}
+
+	var req TicketMessageRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.AddTicketMessageViaGRPC(ctx, ticketID, int(claims.UserID), claims.Role == RoleAdmin, req.Message)
+	if err != nil {
+		writeTicketError(w, err, "Failed to add message")
+		return
+	}
+	response.Success(w, resp.Message, resp.Ticket)
+}
+
+func (app *Config) UpdateTicketStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "UpdateTicketStatus")
+	defer span.End()
+
+	ticketID, ok := ticketIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Ticket ID must be a positive integer")
+		return
+	}
+
+	var req TicketStatusRequest
+	err := request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.UpdateTicketStatusViaGRPC(ctx, ticketID, req.Status)
+	if err != nil {
+		writeTicketError(w, err, "Failed to update ticket status")
+		return
+	}
+	response.Success(w, resp.Message, resp.Ticket)
+}
+
+func (app *Config) AssignTicket(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "AssignTicket")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	ticketID, ok := ticketIDParam(r)
+	if !ok {
+		response.BadRequest(w, "Ticket ID must be a positive integer")
+		return
+	}
+
+	var req AssignTicketRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+	if req.AssigneeID == 0 {
+		req.AssigneeID = int(claims.UserID)
+	}
+
+	resp, err := app.AssignTicketViaGRPC(ctx, ticketID, req.AssigneeID)
+	if err != nil {
+		writeTicketError(w, err, "Failed to assign ticket")
+		return
+	}
+	response.Success(w, resp.Message, resp.Ticket)
+}
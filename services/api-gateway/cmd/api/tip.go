@@ -0,0 +1,98 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/request"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/response"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Tip and Receipt Handlers
+// ============================================
+
+type AddTipRequest struct {
+	Amount float64 `json:"amount" validate:"required,gt=0"`
+}
+
+// writeTipError turns trip-service tip status codes into HTTP responses.
+func writeTipError(w http.ResponseWriter, err error, fallback string) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		response.BadRequest(w, st.Message())
+	case codes.PermissionDenied:
+		response.Forbidden(w, st.Message())
+	case codes.NotFound:
+		response.NotFound(w, st.Message())
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		response.WriteJSON(w, http.StatusConflict, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	case codes.Unimplemented:
+		response.WriteJSON(w, http.StatusNotImplemented, response.Response{
+			Error:   true,
+			Message: st.Message(),
+		})
+	default:
+		response.InternalServerError(w, fallback+": "+st.Message())
+	}
+}
+
+// AddTip lets the passenger tip the driver after a completed trip. The tip is
+// charged to the trip's payment method and the updated receipt is returned.
+func (app *Config) AddTip(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "AddTip")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	var req AddTipRequest
+	err = request.ReadAndValidate(w, r, &req)
+	if request.HandleError(w, err) {
+		return
+	}
+
+	resp, err := app.AddTipViaGRPC(ctx, tripID, int(claims.UserID), req.Amount)
+	if err != nil {
+		writeTipError(w, err, "Failed to add tip")
+		return
+	}
+	response.Created(w, "Tip added, thank you", resp)
+}
+
+func (app *Config) GetTripReceipt(w http.ResponseWriter, r *http.Request) {
+	ctx, span := telemetry.StartSpan(r.Context(), "GetTripReceipt")
+	defer span.End()
+
+	claims, err := app.GetClaims(r.Context())
+	if err != nil {
+		response.Unauthorized(w, "Unauthorized: "+err.Error())
+		return
+	}
+	tripID, ok := chatTripID(r)
+	if !ok {
+		response.BadRequest(w, "Trip ID must be a positive integer")
+		return
+	}
+
+	resp, err := app.GetTripReceiptViaGRPC(ctx, tripID, int(claims.UserID))
+	if err != nil {
+		writeTipError(w, err, "Failed to get receipt")
+		return
+	}
+	response.Success(w, "Receipt retrieved successfully", resp)
+}
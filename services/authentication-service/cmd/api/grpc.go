@@ -2,12 +2,12 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"net"
 	"time"
 
 	"authentication-service/data"
 
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/jwt"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/auth"
@@ -110,13 +110,25 @@ func (s *AuthServer) Authenticate(ctx context.Context, req *pb.AuthRequest) (*pb
 	user, err := s.Config.Models.User.GetByEmail(ctx, req.Email)
 	if err != nil {
 		logger.WithContext(ctx).ErrorContext(ctx, "User not found", "email", req.Email, "error", err)
+		s.Config.logAuditEventAsync(ctx, events.AnonymousActor(), events.Subject{Type: events.SubjectUser}, events.LoginAttempted{
+			Email:  req.Email,
+			Status: events.StatusFailure,
+			Reason: "User not found",
+			Client: events.Client{Channel: "grpc"},
+		})
 		return nil, status.Error(codes.Unauthenticated, "Invalid credentials")
 	}
 
 	// Check password
-	valid, err := user.PasswordMatches(req.Password)
+	valid, err := user.PasswordMatches(ctx, req.Password)
 	if err != nil || !valid {
 		logger.WithContext(ctx).ErrorContext(ctx, "Invalid password", "email", req.Email)
+		s.Config.logAuditEventAsync(ctx, events.AnonymousActor(), events.UserSubject(user.ID), events.LoginAttempted{
+			Email:  req.Email,
+			Status: events.StatusFailure,
+			Reason: "Invalid password",
+			Client: events.Client{Channel: "grpc"},
+		})
 		return nil, status.Error(codes.Unauthenticated, "Invalid credentials")
 	}
 
@@ -146,10 +158,11 @@ func (s *AuthServer) Authenticate(ctx context.Context, req *pb.AuthRequest) (*pb
 	)
 
 	// Publish authentication event to RabbitMQ
-	if s.Config.RabbitConn != nil {
-		eventData := fmt.Sprintf("User %s (ID: %d) authenticated successfully", user.Email, user.ID)
-		go PublishEvent(s.Config.RabbitConn, "user.login", eventData)
-	}
+	s.Config.logAuditEventAsync(ctx, events.UserActor(user.ID), events.UserSubject(user.ID), events.LoginAttempted{
+		Email:  user.Email,
+		Status: events.StatusSuccess,
+		Client: events.Client{Channel: "grpc"},
+	})
 
 	return &pb.AuthResponse{
 		Success: true,
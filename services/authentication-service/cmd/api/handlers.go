@@ -5,10 +5,10 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/jwt"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 	commonMiddleware "github.com/OneKeyCoder/UIT-Go-Backend/common/middleware"
@@ -60,30 +60,28 @@ func (app *Config) Authenticate(w http.ResponseWriter, r *http.Request) {
 			"error", err,
 		)
 		// Log failed authentication attempt for security monitoring
-		app.logAuditEventAsync(r, "USER_LOGIN", requestPayload.Email, "failure", AuditMetadata{
-			IP:        getClientIP(r),
-			UserAgent: r.UserAgent(),
-			Email:     requestPayload.Email,
-			Action:    "Login attempt",
-			Reason:    "User not found",
+		app.logAuditEventAsync(r.Context(), events.AnonymousActor(), events.Subject{Type: events.SubjectUser}, events.LoginAttempted{
+			Email:  requestPayload.Email,
+			Status: events.StatusFailure,
+			Reason: "User not found",
+			Client: httpClient(r),
 		})
 		response.Unauthorized(w, "Invalid credentials")
 		return
 	}
 
-	valid, err := user.PasswordMatches(requestPayload.Password)
+	valid, err := user.PasswordMatches(r.Context(), requestPayload.Password)
 	if err != nil || !valid {
 		reqLogger.Warn("Invalid password",
 			"email", requestPayload.Email,
 			"user_id", user.ID,
 		)
 		// Log failed password attempt for brute-force detection
-		app.logAuditEventAsync(r, "USER_LOGIN", strconv.Itoa(user.ID), "failure", AuditMetadata{
-			IP:        getClientIP(r),
-			UserAgent: r.UserAgent(),
-			Email:     requestPayload.Email,
-			Action:    "Login attempt",
-			Reason:    "Invalid password",
+		app.logAuditEventAsync(r.Context(), events.AnonymousActor(), events.UserSubject(user.ID), events.LoginAttempted{
+			Email:  requestPayload.Email,
+			Status: events.StatusFailure,
+			Reason: "Invalid password",
+			Client: httpClient(r),
 		})
 		response.Unauthorized(w, "Invalid credentials")
 		return
@@ -114,11 +112,10 @@ func (app *Config) Authenticate(w http.ResponseWriter, r *http.Request) {
 	)
 
 	// Log successful authentication with full context
-	app.logAuditEventAsync(r, "USER_LOGIN", strconv.Itoa(user.ID), "success", AuditMetadata{
-		IP:        getClientIP(r),
-		UserAgent: r.UserAgent(),
-		Email:     user.Email,
-		Action:    "User logged in successfully",
+	app.logAuditEventAsync(r.Context(), events.UserActor(user.ID), events.UserSubject(user.ID), events.LoginAttempted{
+		Email:  user.Email,
+		Status: events.StatusSuccess,
+		Client: httpClient(r),
 	})
 
 	// Return user data with tokens
@@ -257,15 +254,12 @@ func (app *Config) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Log registration event with full context
-	app.logAuditEventAsync(r, "USER_REGISTRATION", strconv.Itoa(userID), "success", AuditMetadata{
-		IP:        getClientIP(r),
-		UserAgent: r.UserAgent(),
+	app.logAuditEventAsync(r.Context(), events.UserActor(userID), events.UserSubject(userID), events.UserRegistered{
+		UserID:    userID,
 		Email:     requestPayload.Email,
-		Action:    "New user registered",
-		Extra: map[string]interface{}{
-			"first_name": requestPayload.FirstName,
-			"last_name":  requestPayload.LastName,
-		},
+		FirstName: requestPayload.FirstName,
+		LastName:  requestPayload.LastName,
+		Client:    httpClient(r),
 	})
 
 	// Get the newly created user (without password)
@@ -302,7 +296,7 @@ func (app *Config) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Verify old password
-	valid, err := user.PasswordMatches(requestPayload.OldPassword)
+	valid, err := user.PasswordMatches(r.Context(), requestPayload.OldPassword)
 	if err != nil || !valid {
 		logger.Warn("Invalid old password during password change",
 			"email", requestPayload.Email,
@@ -312,7 +306,7 @@ func (app *Config) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Update to new password
-	err = user.ResetPassword(requestPayload.NewPassword)
+	err = user.ResetPassword(r.Context(), requestPayload.NewPassword)
 	if err != nil {
 		logger.Error("Failed to change password",
 			"email", user.Email,
@@ -328,11 +322,10 @@ func (app *Config) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	)
 
 	// Log password change event with full context
-	app.logAuditEventAsync(r, "PASSWORD_CHANGE", strconv.Itoa(user.ID), "success", AuditMetadata{
-		IP:        getClientIP(r),
-		UserAgent: r.UserAgent(),
-		Email:     user.Email,
-		Action:    "Password changed successfully",
+	app.logAuditEventAsync(r.Context(), events.UserActor(user.ID), events.UserSubject(user.ID), events.PasswordChanged{
+		UserID: user.ID,
+		Email:  user.Email,
+		Client: httpClient(r),
 	})
 
 	response.Success(w, "Password changed successfully", nil)
@@ -362,31 +355,39 @@ func getClientIP(r *http.Request) string {
 	return r.RemoteAddr
 }
 
+// httpClient describes the HTTP client for audit events
+func httpClient(r *http.Request) events.Client {
+	return events.Client{
+		IP:        getClientIP(r),
+		UserAgent: r.UserAgent(),
+		Channel:   "http",
+	}
+}
+
 // logAuditEventAsync sends structured audit events to RabbitMQ asynchronously
-// This provides comprehensive context: Who (actor), When (timestamp), What (event), Where (IP/UA)
-func (app *Config) logAuditEventAsync(r *http.Request, eventName, actorID, status string, metadata AuditMetadata) {
+// The envelope is built before returning so it carries the caller's trace context
+func (app *Config) logAuditEventAsync(ctx context.Context, actor events.Actor, subject events.Subject, payload events.Payload) {
 	// Skip if RabbitMQ connection is not available
 	if app.RabbitConn == nil {
-		logger.Warn("RabbitMQ not available, skipping audit log", "event", eventName)
+		logger.Warn("RabbitMQ not available, skipping audit log", "event", payload.EventType())
+		return
+	}
+
+	envelope, err := events.New(ctx, eventSource, actor, subject, payload)
+	if err != nil {
+		logger.Error("Failed to build audit event", "event", payload.EventType(), "error", err)
 		return
 	}
 
 	// Run in goroutine to avoid blocking the request handler
 	go func() {
 		// Use reusable session if available (reduces connection overhead under load)
-		var err error
-		if app.RabbitSession != nil {
-			err = PublishAuditEventWithSession(app.RabbitSession, app.RabbitConn, eventName, actorID, status, metadata)
-		} else {
-			err = PublishAuditEvent(app.RabbitConn, eventName, actorID, status, metadata)
-		}
-
-		if err != nil {
+		if err := PublishAuditEvent(app.RabbitSession, app.RabbitConn, envelope); err != nil {
 			// Log error but don't fail the request
 			logger.Error("Failed to publish audit event to RabbitMQ",
-				"event", eventName,
-				"actor", actorID,
-				"status", status,
+				"event_id", envelope.ID,
+				"event", envelope.Type,
+				"actor", envelope.Actor.ID,
 				"error", err,
 			)
 		}
@@ -83,6 +83,18 @@ func main() {
 		logger.Fatal("Cannot connect to database")
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err := runMigrateCommand(conn, os.Args[2:])
+		conn.Close()
+		if err != nil {
+			logger.Fatal("Migration failed", "error", err)
+		}
+		return
+	}
+	if err := migrateOnStartup(conn); err != nil {
+		logger.Fatal("Database schema is not ready", "error", err)
+	}
+
 	// Get JWT configuration from environment
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
@@ -152,7 +164,7 @@ func main() {
 	// set up config
 	app := Config{
 		DB:            conn,
-		Models:        data.New(conn),
+		Models:        data.New(conn, dbQueryTimeout()),
 		JWTSecret:     jwtSecret,
 		JWTExpiry:     jwtExpiry,
 		RefreshExpiry: refreshExpiry,
@@ -204,6 +216,15 @@ func main() {
 	logger.Info("Server exited")
 }
 
+// dbQueryTimeout reads DB_QUERY_TIMEOUT, the upper bound for a single query.
+func dbQueryTimeout() time.Duration {
+	d, err := time.ParseDuration(env.Get("DB_QUERY_TIMEOUT", ""))
+	if err != nil || d <= 0 {
+		return data.DefaultTimeout
+	}
+	return d
+}
+
 func openDB(dsn string) (*sql.DB, error) {
 	// Use otelsql for automatic PostgreSQL tracing
 	db, err := otelsql.Open("pgx", dsn,
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"authentication-service/data/migrations"
+	"context"
+	"database/sql"
+	"os"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/migrate"
+)
+
+const migrateTimeout = 5 * time.Minute
+
+// runMigrateCommand handles "authentication-service migrate [up|down [n]|status|version]".
+func runMigrateCommand(conn *sql.DB, args []string) error {
+	migrator, err := migrate.New(conn, migrations.FS, "authentication-service")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	return migrate.Command(ctx, migrator, args, os.Stdout)
+}
+
+// migrateOnStartup applies pending migrations unless DB_AUTO_MIGRATE=false,
+// and refuses to continue if the schema doesn't match the embedded migrations.
+func migrateOnStartup(conn *sql.DB) error {
+	migrator, err := migrate.New(conn, migrations.FS, "authentication-service")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	return migrate.OnStartup(ctx, migrator, env.Get("DB_AUTO_MIGRATE", "true") == "true")
+}
@@ -0,0 +1,2 @@
+DELETE FROM users
+WHERE email IN ('admin@example.com', 'john.doe@example.com', 'jane.smith@example.com');
@@ -0,0 +1,8 @@
+-- Test users for local development and demos.
+-- NOTE: Password is 'password123' hashed with bcrypt
+INSERT INTO users (email, first_name, last_name, password, user_active, role)
+VALUES
+    ('admin@example.com', 'Admin', 'User', '$2a$12$jTyjFOoRBzkkndUdrx678uUEPXrtPw235PlWZ2l8jwdaI4PWBFUwS', 1, 'admin'),
+    ('john.doe@example.com', 'John', 'Doe', '$2a$12$jTyjFOoRBzkkndUdrx678uUEPXrtPw235PlWZ2l8jwdaI4PWBFUwS', 1, 'user'),
+    ('jane.smith@example.com', 'Jane', 'Smith', '$2a$12$jTyjFOoRBzkkndUdrx678uUEPXrtPw235PlWZ2l8jwdaI4PWBFUwS', 1, 'driver')
+ON CONFLICT (email) DO NOTHING;
@@ -0,0 +1,8 @@
+// Package migrations embeds the users database schema. See common/migrate for
+// the file naming rules.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
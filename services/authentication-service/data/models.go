@@ -12,20 +12,40 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-const dbTimeout = time.Second * 3
+// DefaultTimeout bounds each query when New is given no timeout.
+const DefaultTimeout = time.Second * 3
 
 var db *sql.DB
 
+var queryTimeout = DefaultTimeout
+
 // New is the function used to create an instance of the data package. It returns the type
 // Model, which embeds all the types we want to be available to our application.
-func New(dbPool *sql.DB) Models {
+// timeout bounds each query; the caller's context deadline still applies when it is sooner.
+func New(dbPool *sql.DB, timeout time.Duration) Models {
 	db = dbPool
+	if timeout > 0 {
+		queryTimeout = timeout
+	}
 
 	return Models{
 		User: User{},
 	}
 }
 
+// dbtx is the subset of *sql.DB and *sql.Tx used by the model queries.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// withTimeout derives the per-query context from the caller's context, so
+// cancellation and trace spans propagate down to the driver.
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, queryTimeout)
+}
+
 // Models is the type for this package. Note that any model that is included as a member
 // in this type is available to us throughout the application, anywhere that the
 // app variable is used, provided that the model is also added in the New function.
@@ -33,6 +53,27 @@ type Models struct {
 	User User
 }
 
+// WithTx runs fn with models bound to a single transaction, committing when fn
+// returns nil and rolling back otherwise. Users loaded through the bound models
+// stay bound, so their Update/ResetPassword calls join the same transaction.
+// Calls on models that are already inside a transaction reuse it.
+func (m Models) WithTx(ctx context.Context, fn func(tx Models) error) error {
+	if m.User.tx != nil {
+		return fn(m)
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(Models{User: User{tx: tx}}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
 type User struct {
 	ID        int       `json:"id"`
 	Email     string    `json:"email"`
@@ -43,17 +84,28 @@ type User struct {
 	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// tx is set when the user was loaded inside Models.WithTx.
+	tx *sql.Tx
+}
+
+// conn returns the transaction when the user is bound to one, else the pool.
+func (u *User) conn() dbtx {
+	if u.tx != nil {
+		return u.tx
+	}
+	return db
 }
 
 // GetAll returns a slice of all users, sorted by last name
-func (u *User) GetAll() ([]*User, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (u *User) GetAll(ctx context.Context) ([]*User, error) {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, email, first_name, last_name, password, user_active, role, created_at, updated_at
 	from users order by last_name`
 
-	rows, err := db.QueryContext(ctx, query)
+	rows, err := u.conn().QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +114,7 @@ func (u *User) GetAll() ([]*User, error) {
 	var users []*User
 
 	for rows.Next() {
-		var user User
+		user := User{tx: u.tx}
 		err := rows.Scan(
 			&user.ID,
 			&user.Email,
@@ -82,18 +134,18 @@ func (u *User) GetAll() ([]*User, error) {
 		users = append(users, &user)
 	}
 
-	return users, nil
+	return users, rows.Err()
 }
 
 // GetByEmail returns one user by email
-func (u *User) GetByEmail(parentCtx context.Context, email string) (*User, error) {
-	ctx, cancel := context.WithTimeout(parentCtx, dbTimeout)
+func (u *User) GetByEmail(ctx context.Context, email string) (*User, error) {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, email, first_name, last_name, password, user_active, role, created_at, updated_at from users where email = $1`
 
-	var user User
-	row := db.QueryRowContext(ctx, query, email)
+	user := User{tx: u.tx}
+	row := u.conn().QueryRowContext(ctx, query, email)
 
 	err := row.Scan(
 		&user.ID,
@@ -115,14 +167,14 @@ func (u *User) GetByEmail(parentCtx context.Context, email string) (*User, error
 }
 
 // GetOne returns one user by id
-func (u *User) GetOne(parentCtx context.Context, id int) (*User, error) {
-	ctx, cancel := context.WithTimeout(parentCtx, dbTimeout)
+func (u *User) GetOne(ctx context.Context, id int) (*User, error) {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, email, first_name, last_name, password, user_active, role, created_at, updated_at from users where id = $1`
 
-	var user User
-	row := db.QueryRowContext(ctx, query, id)
+	user := User{tx: u.tx}
+	row := u.conn().QueryRowContext(ctx, query, id)
 
 	err := row.Scan(
 		&user.ID,
@@ -145,8 +197,8 @@ func (u *User) GetOne(parentCtx context.Context, id int) (*User, error) {
 
 // Update updates one user in the database, using the information
 // stored in the receiver u
-func (u *User) Update() error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (u *User) Update(ctx context.Context) error {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	stmt := `update users set
@@ -157,7 +209,7 @@ func (u *User) Update() error {
 		where id = $5
 	`
 
-	_, err := db.ExecContext(ctx, stmt,
+	_, err := u.conn().ExecContext(ctx, stmt,
 		u.FirstName,
 		u.LastName,
 		u.Active,
@@ -173,13 +225,13 @@ func (u *User) Update() error {
 }
 
 // Delete deletes one user from the database, by User.ID
-func (u *User) Delete() error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (u *User) Delete(ctx context.Context) error {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	stmt := `delete from users where id = $1`
 
-	_, err := db.ExecContext(ctx, stmt, u.ID)
+	_, err := u.conn().ExecContext(ctx, stmt, u.ID)
 	if err != nil {
 		return err
 	}
@@ -188,13 +240,13 @@ func (u *User) Delete() error {
 }
 
 // DeleteByID deletes one user from the database, by ID
-func (u *User) DeleteByID(id int) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (u *User) DeleteByID(ctx context.Context, id int) error {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	stmt := `delete from users where id = $1`
 
-	_, err := db.ExecContext(ctx, stmt, id)
+	_, err := u.conn().ExecContext(ctx, stmt, id)
 	if err != nil {
 		return err
 	}
@@ -203,13 +255,13 @@ func (u *User) DeleteByID(id int) error {
 }
 
 // Insert inserts a new user into the database, and returns the ID of the newly inserted row
-func (u *User) Insert(parentCtx context.Context, user User) (int, error) {
-	ctx, cancel := context.WithTimeout(parentCtx, dbTimeout)
+func (u *User) Insert(ctx context.Context, user User) (int, error) {
+	queryCtx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	// Trace bcrypt hashing operation (expensive CPU operation)
 	tracer := otel.Tracer("authentication-service")
-	_, hashSpan := tracer.Start(parentCtx, "bcrypt.GenerateFromPassword")
+	_, hashSpan := tracer.Start(ctx, "bcrypt.GenerateFromPassword")
 	hashSpan.SetAttributes(
 		attribute.String("operation", "password_hash"),
 		attribute.Int("cost", 12),
@@ -224,7 +276,7 @@ func (u *User) Insert(parentCtx context.Context, user User) (int, error) {
 	stmt := `insert into users (email, first_name, last_name, password, user_active, role, created_at, updated_at)
 		values ($1, $2, $3, $4, $5, $6, $7, $8) returning id`
 
-	err = db.QueryRowContext(ctx, stmt,
+	err = u.conn().QueryRowContext(queryCtx, stmt,
 		user.Email,
 		user.FirstName,
 		user.LastName,
@@ -243,8 +295,8 @@ func (u *User) Insert(parentCtx context.Context, user User) (int, error) {
 }
 
 // ResetPassword is the method we will use to change a user's password.
-func (u *User) ResetPassword(password string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (u *User) ResetPassword(ctx context.Context, password string) error {
+	ctx, cancel := withTimeout(ctx)
 	defer cancel()
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 12)
@@ -253,7 +305,7 @@ func (u *User) ResetPassword(password string) error {
 	}
 
 	stmt := `update users set password = $1 where id = $2`
-	_, err = db.ExecContext(ctx, stmt, hashedPassword, u.ID)
+	_, err = u.conn().ExecContext(ctx, stmt, hashedPassword, u.ID)
 	if err != nil {
 		return err
 	}
@@ -264,10 +316,10 @@ func (u *User) ResetPassword(password string) error {
 // PasswordMatches uses Go's bcrypt package to compare a user supplied password
 // with the hash we have stored for a given user in the database. If the password
 // and hash match, we return true; otherwise, we return false.
-func (u *User) PasswordMatches(plainText string) (bool, error) {
+func (u *User) PasswordMatches(ctx context.Context, plainText string) (bool, error) {
 	// Trace bcrypt comparison (expensive CPU operation)
 	tracer := otel.Tracer("authentication-service")
-	_, compareSpan := tracer.Start(context.Background(), "bcrypt.CompareHashAndPassword")
+	_, compareSpan := tracer.Start(ctx, "bcrypt.CompareHashAndPassword")
 	compareSpan.SetAttributes(
 		attribute.String("operation", "password_verify"),
 	)
@@ -0,0 +1,56 @@
+package events
+
+// Auth event types, published by authentication-service as its audit trail.
+const (
+	TypeLoginAttempted  = "auth.login_attempted"
+	TypeUserRegistered  = "auth.user_registered"
+	TypePasswordChanged = "auth.password_changed"
+)
+
+// Login outcomes
+const (
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+)
+
+// Client describes where an audited request came from.
+type Client struct {
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	// Channel is the transport the request arrived on: "http" or "grpc".
+	Channel string `json:"channel,omitempty"`
+}
+
+// LoginAttempted is published for every login, successful or not, so that
+// brute-force attempts can be detected from the failures.
+type LoginAttempted struct {
+	Email  string `json:"email"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+	Client Client `json:"client"`
+}
+
+func (LoginAttempted) EventType() string { return TypeLoginAttempted }
+func (LoginAttempted) EventVersion() int { return 1 }
+
+// UserRegistered is published when a new account is created.
+type UserRegistered struct {
+	UserID    int    `json:"user_id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Client    Client `json:"client"`
+}
+
+func (UserRegistered) EventType() string { return TypeUserRegistered }
+func (UserRegistered) EventVersion() int { return 1 }
+
+// PasswordChanged is published after a user changes their password.
+type PasswordChanged struct {
+	UserID int    `json:"user_id"`
+	Email  string `json:"email"`
+	Client Client `json:"client"`
+}
+
+func (PasswordChanged) EventType() string { return TypePasswordChanged }
+func (PasswordChanged) EventVersion() int { return 1 }
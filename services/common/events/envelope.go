@@ -0,0 +1,168 @@
+// Package events defines the versioned envelope shared by every service that
+// publishes to RabbitMQ, together with the typed payload for each event.
+//
+// Publishers build an Envelope with New and send Marshal's output as the
+// message body; consumers call Decode and then DecodePayload into the struct
+// matching Envelope.Type. A payload struct changes its Version whenever a
+// field is removed or changes meaning; adding optional fields does not.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// ContentType is set on AMQP messages carrying an Envelope.
+const ContentType = "application/json"
+
+// Actor types
+const (
+	ActorUser      = "user"
+	ActorDriver    = "driver"
+	ActorSystem    = "system"
+	ActorAnonymous = "anonymous"
+)
+
+// Subject types
+const (
+	SubjectTrip = "trip"
+	SubjectUser = "user"
+)
+
+// Envelope is the wire format of every event.
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Source     string          `json:"source"`
+	Actor      Actor           `json:"actor"`
+	Subject    Subject         `json:"subject"`
+	Trace      TraceContext    `json:"trace"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// Actor is who caused the event.
+type Actor struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+// Subject is the entity the event is about.
+type Subject struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+// TraceContext carries W3C trace context so consumers can link their spans
+// to the request that produced the event.
+type TraceContext struct {
+	TraceParent string `json:"traceparent,omitempty"`
+	TraceState  string `json:"tracestate,omitempty"`
+}
+
+// Payload is implemented by every typed event payload.
+type Payload interface {
+	EventType() string
+	EventVersion() int
+}
+
+func UserActor(userID int) Actor {
+	return Actor{Type: ActorUser, ID: strconv.Itoa(userID)}
+}
+
+func DriverActor(driverID int) Actor {
+	return Actor{Type: ActorDriver, ID: strconv.Itoa(driverID)}
+}
+
+func SystemActor() Actor {
+	return Actor{Type: ActorSystem}
+}
+
+func AnonymousActor() Actor {
+	return Actor{Type: ActorAnonymous}
+}
+
+func TripSubject(tripID int) Subject {
+	return Subject{Type: SubjectTrip, ID: strconv.Itoa(tripID)}
+}
+
+func UserSubject(userID int) Subject {
+	return Subject{Type: SubjectUser, ID: strconv.Itoa(userID)}
+}
+
+// New wraps payload in an envelope with a fresh ID, the current time and the
+// trace context found in ctx.
+func New(ctx context.Context, source string, actor Actor, subject Subject, payload Payload) (Envelope, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("failed to marshal %s payload: %w", payload.EventType(), err)
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	return Envelope{
+		ID:         uuid.NewString(),
+		Type:       payload.EventType(),
+		Version:    payload.EventVersion(),
+		OccurredAt: time.Now().UTC(),
+		Source:     source,
+		Actor:      actor,
+		Subject:    subject,
+		Trace: TraceContext{
+			TraceParent: carrier.Get("traceparent"),
+			TraceState:  carrier.Get("tracestate"),
+		},
+		Payload: data,
+	}, nil
+}
+
+// Marshal returns the JSON message body for the envelope.
+func (e Envelope) Marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Decode parses a message body into an envelope and checks its required fields.
+func Decode(data []byte) (Envelope, error) {
+	var e Envelope
+	if err := json.Unmarshal(data, &e); err != nil {
+		return Envelope{}, err
+	}
+	if e.ID == "" || e.Type == "" || e.Version <= 0 {
+		return Envelope{}, errors.New("event envelope is missing id, type or version")
+	}
+	return e, nil
+}
+
+// DecodePayload unmarshals the payload into dst, which should be the payload
+// struct registered for e.Type.
+func (e Envelope) DecodePayload(dst Payload) error {
+	if dst.EventType() != e.Type {
+		return fmt.Errorf("cannot decode %s payload into %T", e.Type, dst)
+	}
+	if e.Version > dst.EventVersion() {
+		return fmt.Errorf("unsupported %s version %d (max %d)", e.Type, e.Version, dst.EventVersion())
+	}
+	return json.Unmarshal(e.Payload, dst)
+}
+
+// Context returns ctx carrying the remote span context recorded in the envelope.
+func (e Envelope) Context(ctx context.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	if e.Trace.TraceParent != "" {
+		carrier.Set("traceparent", e.Trace.TraceParent)
+	}
+	if e.Trace.TraceState != "" {
+		carrier.Set("tracestate", e.Trace.TraceState)
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
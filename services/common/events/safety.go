@@ -0,0 +1,54 @@
+package events
+
+import "strconv"
+
+// Safety event types, published by trip-service to the safety queue.
+const (
+	TypeSOSRaised      = "safety.sos_raised"
+	TypeIncidentClosed = "safety.incident_closed"
+)
+
+// SubjectIncident is the subject type of safety events.
+const SubjectIncident = "incident"
+
+func IncidentSubject(incidentID int64) Subject {
+	return Subject{Type: SubjectIncident, ID: strconv.FormatInt(incidentID, 10)}
+}
+
+// PartyPosition is where a trip participant last reported to be.
+type PartyPosition struct {
+	UserID     int     `json:"user_id"`
+	Role       string  `json:"role"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	ReportedAt string  `json:"reported_at,omitempty"`
+}
+
+// SOSRaised is published when a trip participant presses SOS. Positions only
+// lists the participants whose location was known.
+type SOSRaised struct {
+	IncidentID   int64           `json:"incident_id"`
+	TripID       int             `json:"trip_id"`
+	RaisedBy     int             `json:"raised_by"`
+	Role         string          `json:"role"`
+	Note         string          `json:"note,omitempty"`
+	PassengerID  int             `json:"passenger_id"`
+	DriverID     int             `json:"driver_id"`
+	TripStatus   string          `json:"trip_status"`
+	LicensePlate string          `json:"license_plate,omitempty"`
+	Positions    []PartyPosition `json:"positions"`
+}
+
+func (SOSRaised) EventType() string { return TypeSOSRaised }
+func (SOSRaised) EventVersion() int { return 1 }
+
+// IncidentClosed is published when a safety operator closes an incident.
+type IncidentClosed struct {
+	IncidentID int64  `json:"incident_id"`
+	TripID     int    `json:"trip_id"`
+	ClosedBy   int    `json:"closed_by"`
+	Resolution string `json:"resolution"`
+}
+
+func (IncidentClosed) EventType() string { return TypeIncidentClosed }
+func (IncidentClosed) EventVersion() int { return 1 }
@@ -0,0 +1,101 @@
+package events
+
+// Trip event types, published by trip-service.
+const (
+	TypeTripRequested     = "trip.requested"
+	TypeTripAccepted      = "trip.accepted"
+	TypeTripRejected      = "trip.rejected"
+	TypeTripStatusChanged = "trip.status_changed"
+	TypeTripCancelled     = "trip.cancelled"
+	TypeTripReviewed      = "trip.reviewed"
+	TypeTripTipped        = "trip.tipped"
+	TypeTripHistoryViewed = "trip.history_viewed"
+)
+
+// TripRequested is published when a passenger creates a trip.
+type TripRequested struct {
+	TripID        int     `json:"trip_id"`
+	PassengerID   int     `json:"passenger_id"`
+	OriginLat     float64 `json:"origin_lat"`
+	OriginLng     float64 `json:"origin_lng"`
+	DestLat       float64 `json:"dest_lat"`
+	DestLng       float64 `json:"dest_lng"`
+	Distance      float64 `json:"distance"`
+	Fare          float64 `json:"fare"`
+	PaymentMethod string  `json:"payment_method,omitempty"`
+	VehicleClass  string  `json:"vehicle_class,omitempty"`
+}
+
+func (TripRequested) EventType() string { return TypeTripRequested }
+func (TripRequested) EventVersion() int { return 1 }
+
+// TripAccepted is published when the suggested driver accepts a trip.
+type TripAccepted struct {
+	TripID   int `json:"trip_id"`
+	DriverID int `json:"driver_id"`
+}
+
+func (TripAccepted) EventType() string { return TypeTripAccepted }
+func (TripAccepted) EventVersion() int { return 1 }
+
+// TripRejected is published when a driver turns down a trip offer.
+type TripRejected struct {
+	TripID      int `json:"trip_id"`
+	DriverID    int `json:"driver_id"`
+	PassengerID int `json:"passenger_id"`
+}
+
+func (TripRejected) EventType() string { return TypeTripRejected }
+func (TripRejected) EventVersion() int { return 1 }
+
+// TripStatusChanged is published when the driver moves a trip to a new status.
+type TripStatusChanged struct {
+	TripID   int    `json:"trip_id"`
+	DriverID int    `json:"driver_id"`
+	Status   string `json:"status"`
+}
+
+func (TripStatusChanged) EventType() string { return TypeTripStatusChanged }
+func (TripStatusChanged) EventVersion() int { return 1 }
+
+// TripCancelled is published when a participant cancels a trip.
+type TripCancelled struct {
+	TripID      int `json:"trip_id"`
+	CancelledBy int `json:"cancelled_by"`
+}
+
+func (TripCancelled) EventType() string { return TypeTripCancelled }
+func (TripCancelled) EventVersion() int { return 1 }
+
+// TripReviewed is published when the passenger rates a finished trip.
+type TripReviewed struct {
+	TripID      int    `json:"trip_id"`
+	PassengerID int    `json:"passenger_id"`
+	Rating      int    `json:"rating"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+func (TripReviewed) EventType() string { return TypeTripReviewed }
+func (TripReviewed) EventVersion() int { return 1 }
+
+// TripTipped is published when the passenger tips the driver after a trip.
+type TripTipped struct {
+	TripID        int     `json:"trip_id"`
+	PassengerID   int     `json:"passenger_id"`
+	DriverID      int     `json:"driver_id"`
+	Amount        float64 `json:"amount"`
+	PaymentMethod string  `json:"payment_method"`
+}
+
+func (TripTipped) EventType() string { return TypeTripTipped }
+func (TripTipped) EventVersion() int { return 1 }
+
+// TripHistoryViewed is published when a user lists their past trips.
+type TripHistoryViewed struct {
+	UserID int    `json:"user_id"`
+	Role   string `json:"role"`
+	Count  int    `json:"count"`
+}
+
+func (TripHistoryViewed) EventType() string { return TypeTripHistoryViewed }
+func (TripHistoryViewed) EventVersion() int { return 1 }
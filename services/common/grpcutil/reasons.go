@@ -0,0 +1,42 @@
+package grpcutil
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain scopes the reasons below in ErrorInfo details.
+const errorDomain = "uit-go"
+
+// Reasons attached to idempotency errors, which share their codes with
+// ordinary business rule errors.
+const (
+	ReasonIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	ReasonIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+)
+
+// ErrorWithReason returns a status error that carries reason as an ErrorInfo
+// detail, so callers can tell it apart from other errors with the same code.
+func ErrorWithReason(code codes.Code, reason, msg string) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Reason returns the ErrorInfo reason err carries, or "" if it has none.
+func Reason(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			return info.Reason
+		}
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+// Usage documents the arguments accepted by Command.
+const Usage = `usage: migrate <command>
+
+commands:
+  up          apply all pending migrations (default)
+  down [n]    roll back the last n migrations (default 1)
+  status      list migrations and whether they are applied
+  version     print the current schema version`
+
+// Command runs the migrate subcommand described by Usage, writing its output
+// to out. Services call it when started as "<binary> migrate ...".
+func Command(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		version, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "applied %d migration(s), schema version %d\n", n, version)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		version, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "rolled back %d migration(s), schema version %d\n", n, version)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, s := range statuses {
+			state, appliedAt := "pending", ""
+			if s.Applied {
+				state, appliedAt = "applied", s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if s.Unknown {
+				state = "unknown"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+		}
+		return w.Flush()
+	case "version":
+		version, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "schema version %d (latest embedded %d)\n", version, m.Latest())
+	default:
+		return errors.New(Usage)
+	}
+	return nil
+}
+
+// OnStartup prepares the schema before a service starts serving. With
+// autoMigrate it applies pending migrations first; either way it fails if the
+// schema is not exactly the embedded one, so a binary never runs against a
+// schema it doesn't know.
+func OnStartup(ctx context.Context, m *Migrator, autoMigrate bool) error {
+	if autoMigrate {
+		if _, err := m.Up(ctx); err != nil {
+			return err
+		}
+	}
+	return m.Check(ctx)
+}
@@ -0,0 +1,373 @@
+// Package migrate applies versioned SQL migrations embedded in a service binary
+// to its Postgres database.
+//
+// Migrations are pairs of files named <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Applied versions are recorded in the
+// schema_migrations table. Every migration runs in its own transaction, and
+// Up/Down hold a Postgres advisory lock so that replicas starting at the same
+// time apply each migration exactly once.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+)
+
+var (
+	// ErrUnknownVersion means the database has migrations applied that this
+	// binary does not know about, e.g. after rolling back to an older release.
+	ErrUnknownVersion = errors.New("database schema is newer than this binary")
+	// ErrPending means the database is missing migrations this binary needs.
+	ErrPending = errors.New("database schema has pending migrations")
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status describes a migration known to the binary or recorded in the database.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	// Unknown is set for versions recorded in the database but not embedded.
+	Unknown bool
+}
+
+// Migrator applies migrations to a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	lockID     int64
+}
+
+// New loads the migrations in the root of fsys. service names the advisory
+// lock, so different services sharing a Postgres server don't block each other.
+func New(db *sql.DB, fsys fs.FS, service string) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	h := fnv.New64a()
+	h.Write([]byte("schema_migrations:" + service))
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		lockID:     int64(h.Sum64()),
+	}, nil
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+		body, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %q and %q", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest returns the highest embedded version, or 0 if there are none.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the highest version applied to the database, or 0.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return 0, err
+	}
+	var version int64
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+// Status lists every embedded migration, followed by any unknown versions
+// found in the database.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		s := Status{Version: migration.Version, Name: migration.Name}
+		if row, ok := applied[migration.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = row.appliedAt
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, s)
+	}
+	unknown := make([]Status, 0, len(applied))
+	for version, row := range applied {
+		unknown = append(unknown, Status{
+			Version:   version,
+			Name:      row.name,
+			Applied:   true,
+			AppliedAt: row.appliedAt,
+			Unknown:   true,
+		})
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i].Version < unknown[j].Version })
+	return append(statuses, unknown...), nil
+}
+
+// Check returns ErrUnknownVersion if the database has versions this binary
+// does not embed, and ErrPending if embedded versions are not yet applied.
+func (m *Migrator) Check(ctx context.Context) error {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return err
+	}
+	return m.check(applied, true)
+}
+
+func (m *Migrator) check(applied map[int64]appliedRow, requireAll bool) error {
+	known := make(map[int64]bool, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("%w: version %d is applied but not embedded (latest known %d)", ErrUnknownVersion, version, m.Latest())
+		}
+	}
+	if requireAll {
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok {
+				return fmt.Errorf("%w: version %d (%s) is not applied", ErrPending, migration.Version, migration.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Up applies all pending migrations in version order and returns how many
+// were applied. It refuses to run against a schema with unknown versions.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.check(applied, false); err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, true); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Down rolls back the most recently applied steps migrations and returns how
+// many were rolled back.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	count := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.check(applied, false); err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && count < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, false); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration, up bool) error {
+	direction, body := "up", migration.Up
+	if !up {
+		direction, body = "down", migration.Down
+	}
+	start := time.Now()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, body); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d_%s %s failed: %w", migration.Version, migration.Name, direction, err)
+	}
+	if up {
+		_, err = tx.ExecContext(ctx,
+			`insert into schema_migrations (version, name, applied_at) values ($1, $2, now())`,
+			migration.Version, migration.Name)
+	} else {
+		_, err = tx.ExecContext(ctx, `delete from schema_migrations where version = $1`, migration.Version)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	logger.Info("Applied migration",
+		"version", migration.Version,
+		"name", migration.Name,
+		"direction", direction,
+		"duration", time.Since(start),
+	)
+	return nil
+}
+
+// withLock runs fn on a dedicated connection holding the advisory lock.
+// Session-level advisory locks belong to a connection, so everything that
+// must be serialised runs on conn rather than the pool.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `select pg_advisory_lock($1)`, m.lockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so the lock is released even if ctx was cancelled.
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.ExecContext(unlockCtx, `select pg_advisory_unlock($1)`, m.lockID); err != nil {
+			logger.Error("Failed to release migration lock", "error", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `create table if not exists schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+type appliedRow struct {
+	name      string
+	appliedAt time.Time
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// applied returns the versions recorded in schema_migrations. A database
+// without the table has nothing applied.
+func (m *Migrator) applied(ctx context.Context, q queryer) (map[int64]appliedRow, error) {
+	var exists bool
+	if err := q.QueryRowContext(ctx, `select to_regclass('schema_migrations') is not null`).Scan(&exists); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]appliedRow)
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `select version, name, applied_at from schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version int64
+		var row appliedRow
+		if err := rows.Scan(&version, &row.name, &row.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = row
+	}
+	return applied, rows.Err()
+}
@@ -5,9 +5,17 @@ const (
 	// LogsQueue is the queue for log messages
 	LogsQueue = "logs"
 
+	// SafetyQueue carries SOS incidents for the safety operations team
+	SafetyQueue = "safety"
+
+	// SafetyMaxPriority is the highest message priority the safety queue honours
+	SafetyMaxPriority = 9
+
 	// Default AMQP 1.0 address format for queues
 	// RabbitMQ expects /queues/<queue_name> format for AMQP 1.0
 	LogsQueueAddress = "/queues/logs"
+
+	SafetyQueueAddress = "/queues/safety"
 )
 
 // DefaultQueues returns the list of queues that should be created on startup
@@ -18,6 +26,12 @@ func DefaultQueues() []QueueConfig {
 			Durable:    true,
 			AutoDelete: false,
 		},
+		{
+			Name:       SafetyQueue,
+			Durable:    true,
+			AutoDelete: false,
+			Arguments:  map[string]interface{}{"x-max-priority": SafetyMaxPriority},
+		},
 		// Add more queues here as needed
 		// {
 		// 	Name:       "notifications",
@@ -2,18 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 
 	location_service "location-service/internal"
 
-
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const grpcPort = "50053"
@@ -141,6 +144,88 @@ func (s *LocationServer) FindNearestUsers(ctx context.Context, req *pb.FindNeare
 	}, nil
 }
 
+func (s *LocationServer) SearchLocations(ctx context.Context, req *pb.SearchLocationsRequest) (*pb.SearchLocationsResponse, error) {
+	logger.Info("gRPC SearchLocations called",
+		"role", req.Role,
+		"limit", req.Limit,
+		"box", req.Box != nil)
+
+	query := location_service.SearchQuery{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		RadiusKm:  req.Radius,
+		Role:      req.Role,
+		Limit:     int(req.Limit),
+	}
+	if b := req.Box; b != nil {
+		query.Box = &location_service.BoundingBox{MinLat: b.MinLat, MinLng: b.MinLng, MaxLat: b.MaxLat, MaxLng: b.MaxLng}
+	}
+
+	locations, err := s.service.SearchLocations(ctx, query)
+	if err != nil {
+		if errors.Is(err, location_service.ErrInvalidSearch) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to search locations", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbLocations := make([]*pb.Location, 0, len(locations))
+	for _, loc := range locations {
+		pbLocations = append(pbLocations, &pb.Location{
+			UserId:    int32(loc.UserID),
+			Role:      loc.Role,
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Speed:     loc.Speed,
+			Heading:   loc.Heading,
+			Timestamp: loc.Timestamp,
+			Distance:  loc.Distance,
+		})
+	}
+
+	return &pb.SearchLocationsResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Found %d users", len(pbLocations)),
+		Locations: pbLocations,
+	}, nil
+}
+
+func (s *LocationServer) GetHeatmap(ctx context.Context, req *pb.GetHeatmapRequest) (*pb.GetHeatmapResponse, error) {
+	logger.Info("gRPC GetHeatmap called", "precision", req.Precision)
+
+	if req.Box == nil {
+		return nil, status.Error(codes.InvalidArgument, "box is required")
+	}
+	box := location_service.BoundingBox{MinLat: req.Box.MinLat, MinLng: req.Box.MinLng, MaxLat: req.Box.MaxLat, MaxLng: req.Box.MaxLng}
+	cells, err := s.service.GetHeatmap(ctx, box, int(req.Precision))
+	if err != nil {
+		if errors.Is(err, location_service.ErrInvalidHeatmap) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to build heatmap", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetHeatmapResponse{
+		Success: true,
+		Cells:   make([]*pb.HeatmapCell, 0, len(cells)),
+	}
+	for _, cell := range cells {
+		resp.Cells = append(resp.Cells, &pb.HeatmapCell{
+			Geohash:    cell.Geohash,
+			Latitude:   cell.Latitude,
+			Longitude:  cell.Longitude,
+			Drivers:    int32(cell.Drivers),
+			Passengers: int32(cell.Passengers),
+		})
+		resp.TotalDrivers += int32(cell.Drivers)
+		resp.TotalPassengers += int32(cell.Passengers)
+	}
+	resp.Message = fmt.Sprintf("Found %d cells", len(resp.Cells))
+	return resp, nil
+}
+
 func (s *LocationServer) GetAllLocations(ctx context.Context, req *pb.GetAllLocationsRequest) (*pb.GetAllLocationsResponse, error) {
 	locations, err := s.service.GetAllLocations(ctx)
 	if err != nil {
@@ -171,6 +256,187 @@ func (s *LocationServer) GetAllLocations(ctx context.Context, req *pb.GetAllLoca
 	}, nil
 }
 
+func (s *LocationServer) RemoveLocation(ctx context.Context, req *pb.RemoveLocationRequest) (*pb.RemoveLocationResponse, error) {
+	logger.Info("gRPC RemoveLocation called", "user_id", strconv.Itoa(int(req.UserId)))
+
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := s.service.RemoveLocation(ctx, int(req.UserId), req.Role); err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to remove location", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RemoveLocationResponse{
+		Success: true,
+		Message: "Location removed successfully",
+	}, nil
+}
+
+func (s *LocationServer) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	logger.Info("gRPC UpdateUserRole called",
+		"user_id", strconv.Itoa(int(req.UserId)),
+		"old_role", req.OldRole,
+		"new_role", req.NewRole)
+
+	if req.UserId <= 0 || req.NewRole == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and new_role are required")
+	}
+	location, err := s.service.GetCurrentLocation(ctx, int(req.UserId))
+	if err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to get location", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if location == nil {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+	if err := s.service.UpdateUserRole(ctx, int(req.UserId), req.OldRole, req.NewRole); err != nil {
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to update user role", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	location.Role = req.NewRole
+
+	return &pb.UpdateUserRoleResponse{
+		Success: true,
+		Message: "User role updated successfully",
+		Location: &pb.Location{
+			UserId:    int32(location.UserID),
+			Role:      location.Role,
+			Latitude:  location.Latitude,
+			Longitude: location.Longitude,
+			Speed:     location.Speed,
+			Heading:   location.Heading,
+			Timestamp: location.Timestamp,
+		},
+	}, nil
+}
+
+func (s *LocationServer) GoOnline(ctx context.Context, req *pb.DriverAvailabilityRequest) (*pb.DriverAvailabilityResponse, error) {
+	logger.Info("gRPC GoOnline called", "driver_id", req.DriverId)
+
+	availability, err := s.service.GoOnline(ctx, int(req.DriverId))
+	if err != nil {
+		return nil, availabilityError(ctx, "Failed to bring driver online", err)
+	}
+	return availabilityResponse(availability, "Driver is online"), nil
+}
+
+func (s *LocationServer) GoOffline(ctx context.Context, req *pb.DriverAvailabilityRequest) (*pb.DriverAvailabilityResponse, error) {
+	logger.Info("gRPC GoOffline called", "driver_id", req.DriverId)
+
+	availability, err := s.service.GoOffline(ctx, int(req.DriverId))
+	if err != nil {
+		return nil, availabilityError(ctx, "Failed to take driver offline", err)
+	}
+	return availabilityResponse(availability, "Driver is offline"), nil
+}
+
+func (s *LocationServer) MarkDriverOnTrip(ctx context.Context, req *pb.DriverTripRequest) (*pb.DriverAvailabilityResponse, error) {
+	logger.Info("gRPC MarkDriverOnTrip called", "driver_id", req.DriverId, "trip_id", req.TripId)
+
+	availability, err := s.service.MarkOnTrip(ctx, int(req.DriverId), int(req.TripId))
+	if err != nil {
+		return nil, availabilityError(ctx, "Failed to mark driver on trip", err)
+	}
+	return availabilityResponse(availability, "Driver is on trip"), nil
+}
+
+func (s *LocationServer) ReleaseDriver(ctx context.Context, req *pb.DriverTripRequest) (*pb.DriverAvailabilityResponse, error) {
+	logger.Info("gRPC ReleaseDriver called", "driver_id", req.DriverId, "trip_id", req.TripId)
+
+	availability, err := s.service.ReleaseDriver(ctx, int(req.DriverId), int(req.TripId))
+	if err != nil {
+		return nil, availabilityError(ctx, "Failed to release driver", err)
+	}
+	return availabilityResponse(availability, "Driver released"), nil
+}
+
+func (s *LocationServer) GetDriverAvailability(ctx context.Context, req *pb.DriverAvailabilityRequest) (*pb.DriverAvailabilityResponse, error) {
+	availability, err := s.service.GetDriverAvailability(ctx, int(req.DriverId))
+	if err != nil {
+		return nil, availabilityError(ctx, "Failed to get driver availability", err)
+	}
+	return availabilityResponse(availability, "Driver availability retrieved successfully"), nil
+}
+
+func availabilityResponse(a location_service.DriverAvailability, message string) *pb.DriverAvailabilityResponse {
+	return &pb.DriverAvailabilityResponse{
+		Success: true,
+		Message: message,
+		Availability: &pb.DriverAvailability{
+			DriverId:  int32(a.DriverID),
+			Status:    a.Status,
+			TripId:    int32(a.TripID),
+			UpdatedAt: a.UpdatedAt,
+		},
+	}
+}
+
+// availabilityError maps availability errors to gRPC status codes.
+func availabilityError(ctx context.Context, msg string, err error) error {
+	switch {
+	case errors.Is(err, location_service.ErrInvalidAvailability):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, location_service.ErrDriverOnTrip):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger.WithContext(ctx).ErrorContext(ctx, msg, "error", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *LocationServer) GetLocationHistory(ctx context.Context, req *pb.GetLocationHistoryRequest) (*pb.GetLocationHistoryResponse, error) {
+	logger.Info("gRPC GetLocationHistory called", "user_id", req.UserId, "from", req.From, "to", req.To)
+
+	query := location_service.HistoryQuery{
+		UserID:   int(req.UserId),
+		Interval: time.Duration(req.IntervalSeconds) * time.Second,
+		Limit:    int(req.Limit),
+	}
+	for _, bound := range []struct {
+		name  string
+		value string
+		dst   *time.Time
+	}{{"from", req.From, &query.From}, {"to", req.To, &query.To}} {
+		if bound.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be an ISO 8601 timestamp", bound.name)
+		}
+		*bound.dst = t
+	}
+
+	points, truncated, err := s.service.GetLocationHistory(ctx, query)
+	if err != nil {
+		if errors.Is(err, location_service.ErrInvalidHistoryQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to get location history", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPoints := make([]*pb.LocationPoint, 0, len(points))
+	for _, point := range points {
+		pbPoints = append(pbPoints, &pb.LocationPoint{
+			Id:         point.ID,
+			RecordedAt: point.RecordedAt.Format(time.RFC3339Nano),
+			Role:       point.Role,
+			Latitude:   point.Latitude,
+			Longitude:  point.Longitude,
+			Speed:      point.Speed,
+			Heading:    point.Heading,
+			Timestamp:  point.Timestamp,
+		})
+	}
+	return &pb.GetLocationHistoryResponse{
+		Success:   true,
+		Message:   "Location history retrieved successfully",
+		Points:    pbPoints,
+		Truncated: truncated,
+	}, nil
+}
+
 func startGRPCServer(locationService *location_service.LocationService) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcPort))
 	if err != nil {
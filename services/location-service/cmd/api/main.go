@@ -91,6 +91,11 @@ func main() {
 	// Start gRPC server in goroutine
 	go startGRPCServer(locationService)
 
+	// Drop geo index members whose location expired
+	sweepCtx, stopSweep := context.WithCancel(context.Background())
+	defer stopSweep()
+	go locationService.RunSweeper(sweepCtx, location_service.SweepInterval())
+
 	// HTTP Server
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%s", webPort),
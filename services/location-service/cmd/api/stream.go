@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	location_service "location-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxStreamBatchSize = 500
+	// A stream is acknowledged after streamAckBatches batches, and every
+	// streamAckInterval while batches are waiting for an ack.
+	streamAckBatches  = 20
+	streamAckInterval = time.Second
+)
+
+// recvResult is one stream.Recv result, handed from the receiving goroutine
+// to StreamLocations.
+type recvResult struct {
+	batch *pb.LocationBatch
+	err   error
+}
+
+// StreamLocations applies the batches of location updates sent over the
+// stream and acknowledges them periodically. Invalid updates are counted and
+// skipped; a batch that fails to apply ends the stream after acknowledging
+// the batches before it, so the client resends from there. Batches are
+// received in their own goroutine so the time-based ack goes out even while
+// the client sends nothing.
+func (s *LocationServer) StreamLocations(stream pb.LocationService_StreamLocationsServer) error {
+	ctx := stream.Context()
+	logger.Info("gRPC StreamLocations called")
+
+	ack := &pb.LocationAck{}
+	pending := 0
+	send := func() error {
+		pending = 0
+		return stream.Send(ack)
+	}
+
+	// The context ends when StreamLocations returns, which stops the
+	// receiver.
+	received := make(chan recvResult)
+	go func() {
+		for {
+			batch, err := stream.Recv()
+			select {
+			case received <- recvResult{batch: batch, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(streamAckInterval)
+	defer ticker.Stop()
+	for {
+		var r recvResult
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+			if pending > 0 {
+				if err := send(); err != nil {
+					return err
+				}
+			}
+			continue
+		case r = <-received:
+		}
+
+		batch, err := r.batch, r.err
+		if err == io.EOF {
+			logger.Info("gRPC StreamLocations closed", "sequence", ack.Sequence, "applied", ack.Applied, "rejected", ack.Rejected)
+			return send()
+		}
+		if err != nil {
+			return err
+		}
+
+		if batch.Sequence <= 0 {
+			return status.Error(codes.InvalidArgument, "batch sequence must be positive")
+		}
+		if len(batch.Locations) > maxStreamBatchSize {
+			return status.Errorf(codes.InvalidArgument, "batch has more than %d locations", maxStreamBatchSize)
+		}
+		if batch.Sequence <= ack.Sequence {
+			ack.Duplicates++
+			continue
+		}
+
+		locations := make([]*location_service.CurrentLocation, 0, len(batch.Locations))
+		var rejected int32
+		for _, req := range batch.Locations {
+			location := &location_service.CurrentLocation{
+				UserID:    int(req.UserId),
+				Role:      req.Role,
+				Latitude:  req.Latitude,
+				Longitude: req.Longitude,
+				Speed:     req.Speed,
+				Heading:   req.Heading,
+				Timestamp: req.Timestamp,
+			}
+			if err := location_service.ValidateLocation(location); err != nil {
+				rejected++
+				continue
+			}
+			locations = append(locations, location)
+		}
+
+		if err := s.service.SetLocations(ctx, locations); err != nil {
+			logger.WithContext(ctx).ErrorContext(ctx, "Failed to apply location batch", "sequence", batch.Sequence, "error", err)
+			if pending > 0 {
+				if sendErr := send(); sendErr != nil {
+					err = errors.Join(err, sendErr)
+				}
+			}
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		ack.Sequence = batch.Sequence
+		ack.Applied += int32(len(locations))
+		ack.Rejected += rejected
+		pending++
+
+		if pending >= streamAckBatches {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package location_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	AvailabilityOffline = "offline"
+	AvailabilityOnline  = "online"
+	AvailabilityOnTrip  = "on_trip"
+)
+
+// availabilityRetries bounds how often a transition is retried when the
+// driver's availability changes underneath it.
+const availabilityRetries = 5
+
+var (
+	ErrInvalidAvailability = errors.New("invalid availability request")
+	ErrDriverOnTrip        = errors.New("driver is on a trip")
+)
+
+// DriverAvailability is whether a driver can be offered trips. Drivers with no
+// recorded availability have the service's default status.
+type DriverAvailability struct {
+	DriverID  int    `json:"driver_id"`
+	Status    string `json:"status"`
+	TripID    int    `json:"trip_id,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// availabilityKey isn't numeric, so GetAllLocations skips it.
+func availabilityKey(driverID int) string {
+	return "driver:availability:" + strconv.Itoa(driverID)
+}
+
+// loadDefaultAvailability reads DRIVER_DEFAULT_AVAILABILITY, the status of
+// drivers who never went online or offline. It is online until every driver
+// app calls GoOnline, so drivers from before availability existed keep being
+// matched; after that it should be set to offline.
+func loadDefaultAvailability() string {
+	if env.Get("DRIVER_DEFAULT_AVAILABILITY", "") == AvailabilityOffline {
+		return AvailabilityOffline
+	}
+	return AvailabilityOnline
+}
+
+func (s *LocationService) readAvailability(ctx context.Context, client redis.Cmdable, driverID int) (DriverAvailability, error) {
+	data, err := client.Get(ctx, availabilityKey(driverID)).Result()
+	if err == redis.Nil {
+		return DriverAvailability{DriverID: driverID, Status: s.defaultAvailability}, nil
+	}
+	if err != nil {
+		return DriverAvailability{}, fmt.Errorf("failed to get driver availability: %w", err)
+	}
+	var availability DriverAvailability
+	if err := json.Unmarshal([]byte(data), &availability); err != nil {
+		return DriverAvailability{}, fmt.Errorf("failed to unmarshal driver availability: %w", err)
+	}
+	return availability, nil
+}
+
+// onlineStatus reports whether an availability value read with MGET is
+// online; a missing value has the default status.
+func (s *LocationService) onlineStatus(value interface{}) (bool, error) {
+	data, ok := value.(string)
+	if !ok {
+		return s.defaultAvailability == AvailabilityOnline, nil
+	}
+	var availability DriverAvailability
+	if err := json.Unmarshal([]byte(data), &availability); err != nil {
+		return false, fmt.Errorf("failed to unmarshal driver availability: %w", err)
+	}
+	return availability.Status == AvailabilityOnline, nil
+}
+
+func (s *LocationService) GetDriverAvailability(ctx context.Context, driverID int) (DriverAvailability, error) {
+	if driverID <= 0 {
+		return DriverAvailability{}, fmt.Errorf("%w: driver_id is required", ErrInvalidAvailability)
+	}
+	return s.readAvailability(ctx, s.redisClient, driverID)
+}
+
+// GoOnline makes the driver a matching candidate. A driver on a trip stays on
+// it until trip-service releases them.
+func (s *LocationService) GoOnline(ctx context.Context, driverID int) (DriverAvailability, error) {
+	return s.transition(ctx, driverID, func(current DriverAvailability) (DriverAvailability, error) {
+		if current.Status == AvailabilityOnTrip {
+			return current, fmt.Errorf("%w: %d", ErrDriverOnTrip, current.TripID)
+		}
+		current.Status = AvailabilityOnline
+		return current, nil
+	})
+}
+
+// GoOffline stops the driver from being offered trips. It is refused during a
+// trip, which has to be completed or cancelled first.
+func (s *LocationService) GoOffline(ctx context.Context, driverID int) (DriverAvailability, error) {
+	return s.transition(ctx, driverID, func(current DriverAvailability) (DriverAvailability, error) {
+		if current.Status == AvailabilityOnTrip {
+			return current, fmt.Errorf("%w: %d", ErrDriverOnTrip, current.TripID)
+		}
+		current.Status = AvailabilityOffline
+		return current, nil
+	})
+}
+
+// MarkOnTrip takes the driver out of matching for tripID. Marking the same
+// trip again is a no-op; a driver already on another trip is refused.
+func (s *LocationService) MarkOnTrip(ctx context.Context, driverID int, tripID int) (DriverAvailability, error) {
+	if tripID <= 0 {
+		return DriverAvailability{}, fmt.Errorf("%w: trip_id is required", ErrInvalidAvailability)
+	}
+	return s.transition(ctx, driverID, func(current DriverAvailability) (DriverAvailability, error) {
+		if current.Status == AvailabilityOnTrip && current.TripID != tripID {
+			return current, fmt.Errorf("%w: %d", ErrDriverOnTrip, current.TripID)
+		}
+		current.Status = AvailabilityOnTrip
+		current.TripID = tripID
+		return current, nil
+	})
+}
+
+// ReleaseDriver puts a driver on tripID back online. Releasing a driver who
+// isn't on that trip leaves them as they are, so repeated releases are safe.
+func (s *LocationService) ReleaseDriver(ctx context.Context, driverID int, tripID int) (DriverAvailability, error) {
+	if tripID <= 0 {
+		return DriverAvailability{}, fmt.Errorf("%w: trip_id is required", ErrInvalidAvailability)
+	}
+	return s.transition(ctx, driverID, func(current DriverAvailability) (DriverAvailability, error) {
+		if current.Status != AvailabilityOnTrip || current.TripID != tripID {
+			return current, nil
+		}
+		current.Status = AvailabilityOnline
+		current.TripID = 0
+		return current, nil
+	})
+}
+
+// transition applies next to the driver's availability and keeps the driver
+// geo index in step: only online drivers are in it. The read and the writes
+// run in one WATCH transaction so concurrent transitions can't interleave.
+func (s *LocationService) transition(ctx context.Context, driverID int, next func(DriverAvailability) (DriverAvailability, error)) (DriverAvailability, error) {
+	if driverID <= 0 {
+		return DriverAvailability{}, fmt.Errorf("%w: driver_id is required", ErrInvalidAvailability)
+	}
+	key := availabilityKey(driverID)
+	member := strconv.Itoa(driverID)
+
+	var result DriverAvailability
+	txf := func(tx *redis.Tx) error {
+		current, err := s.readAvailability(ctx, tx, driverID)
+		if err != nil {
+			return err
+		}
+		updated, err := next(current)
+		if err != nil {
+			return err
+		}
+		// A default status isn't recorded yet, so it is saved even when
+		// unchanged and outlasts a change of the default.
+		if updated == current && current.UpdatedAt != "" {
+			result = current
+			return nil
+		}
+		updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+		data, err := json.Marshal(updated)
+		if err != nil {
+			return fmt.Errorf("failed to marshal driver availability: %w", err)
+		}
+
+		var location *CurrentLocation
+		if updated.Status == AvailabilityOnline {
+			raw, err := tx.Get(ctx, member).Result()
+			if err != nil && err != redis.Nil {
+				return fmt.Errorf("failed to get location from Redis: %w", err)
+			}
+			if err == nil {
+				location = &CurrentLocation{}
+				if err := json.Unmarshal([]byte(raw), location); err != nil {
+					return fmt.Errorf("failed to unmarshal location data: %w", err)
+				}
+			}
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, 0)
+			if location != nil && location.Role == "driver" {
+				// Without a location the driver joins the index on their
+				// next SetLocation.
+				pipe.GeoAdd(ctx, GeoKeyDrivers, &redis.GeoLocation{
+					Name:      member,
+					Longitude: location.Longitude,
+					Latitude:  location.Latitude,
+				})
+			} else if updated.Status != AvailabilityOnline {
+				pipe.ZRem(ctx, GeoKeyDrivers, member)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save driver availability: %w", err)
+		}
+		result = updated
+		return nil
+	}
+
+	for i := 0; i < availabilityRetries; i++ {
+		err := s.redisClient.Watch(ctx, txf, key, member)
+		if err == redis.TxFailedErr {
+			continue
+		}
+		return result, err
+	}
+	return DriverAvailability{}, fmt.Errorf("availability of driver %d kept changing, try again", driverID)
+}
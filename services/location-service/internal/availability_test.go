@@ -0,0 +1,154 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestGoOfflineRefusedOnTrip(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.77, 106.70)
+	if _, err := s.MarkOnTrip(ctx, 1, 42); err != nil {
+		t.Fatalf("MarkOnTrip: %v", err)
+	}
+
+	if _, err := s.GoOffline(ctx, 1); !errors.Is(err, ErrDriverOnTrip) {
+		t.Errorf("GoOffline err = %v, want ErrDriverOnTrip", err)
+	}
+	availability, err := s.GetDriverAvailability(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if availability.Status != AvailabilityOnTrip || availability.TripID != 42 {
+		t.Errorf("availability = %+v, want on trip 42", availability)
+	}
+}
+
+func TestMarkOnTripRefusedForAnotherTrip(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.77, 106.70)
+	if _, err := s.MarkOnTrip(ctx, 1, 42); err != nil {
+		t.Fatalf("MarkOnTrip: %v", err)
+	}
+	if inIndex(t, s, GeoKeyDrivers, 1) {
+		t.Error("driver on a trip still indexed")
+	}
+
+	if _, err := s.MarkOnTrip(ctx, 1, 42); err != nil {
+		t.Errorf("MarkOnTrip for the same trip: %v, want no-op", err)
+	}
+	if _, err := s.MarkOnTrip(ctx, 1, 43); !errors.Is(err, ErrDriverOnTrip) {
+		t.Errorf("MarkOnTrip for another trip err = %v, want ErrDriverOnTrip", err)
+	}
+	availability, err := s.GetDriverAvailability(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if availability.TripID != 42 {
+		t.Errorf("availability = %+v, want still on trip 42", availability)
+	}
+}
+
+func TestReleaseDriverIsIdempotent(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.77, 106.70)
+	if _, err := s.MarkOnTrip(ctx, 1, 42); err != nil {
+		t.Fatalf("MarkOnTrip: %v", err)
+	}
+
+	released, err := s.ReleaseDriver(ctx, 1, 42)
+	if err != nil {
+		t.Fatalf("ReleaseDriver: %v", err)
+	}
+	if released.Status != AvailabilityOnline || released.TripID != 0 {
+		t.Errorf("released = %+v, want online", released)
+	}
+	if !inIndex(t, s, GeoKeyDrivers, 1) {
+		t.Error("released driver not back in the index")
+	}
+
+	again, err := s.ReleaseDriver(ctx, 1, 42)
+	if err != nil {
+		t.Fatalf("second ReleaseDriver: %v", err)
+	}
+	if again != released {
+		t.Errorf("second release = %+v, want unchanged %+v", again, released)
+	}
+
+	// Releasing from a trip the driver isn't on leaves them offline.
+	if _, err := s.GoOffline(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	other, err := s.ReleaseDriver(ctx, 1, 43)
+	if err != nil {
+		t.Fatalf("ReleaseDriver of another trip: %v", err)
+	}
+	if other.Status != AvailabilityOffline {
+		t.Errorf("availability = %+v, want offline", other)
+	}
+}
+
+func TestGoOnlineIndexesOnlyWithLocation(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := s.GoOnline(ctx, 1); err != nil {
+		t.Fatalf("GoOnline: %v", err)
+	}
+	if inIndex(t, s, GeoKeyDrivers, 1) {
+		t.Error("driver without a location indexed")
+	}
+
+	setLocation(t, s, 2, "driver", 10.77, 106.70)
+	if _, err := s.GoOffline(ctx, 2); err != nil {
+		t.Fatalf("GoOffline: %v", err)
+	}
+	if inIndex(t, s, GeoKeyDrivers, 2) {
+		t.Error("offline driver still indexed")
+	}
+	if _, err := s.GoOnline(ctx, 2); err != nil {
+		t.Fatalf("GoOnline: %v", err)
+	}
+	if !inIndex(t, s, GeoKeyDrivers, 2) {
+		t.Error("driver with a location not indexed when going online")
+	}
+}
+
+func TestDefaultAvailability(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	driver := func(userID int) *CurrentLocation {
+		return &CurrentLocation{UserID: userID, Role: "driver", Latitude: 10.77, Longitude: 106.70}
+	}
+
+	// Drivers from before availability was recorded stay matchable.
+	if err := s.SetCurrentLocation(ctx, driver(1)); err != nil {
+		t.Fatal(err)
+	}
+	if !inIndex(t, s, GeoKeyDrivers, 1) {
+		t.Error("driver with no recorded availability not indexed by default")
+	}
+	// Going online records it, so it survives the default changing.
+	if _, err := s.GoOnline(ctx, 1); err != nil {
+		t.Fatalf("GoOnline: %v", err)
+	}
+
+	s.defaultAvailability = AvailabilityOffline
+	if err := s.SetCurrentLocation(ctx, driver(2)); err != nil {
+		t.Fatal(err)
+	}
+	if inIndex(t, s, GeoKeyDrivers, 2) {
+		t.Error("driver with no recorded availability indexed with an offline default")
+	}
+	availability, err := s.GetDriverAvailability(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if availability.Status != AvailabilityOnline || availability.UpdatedAt == "" {
+		t.Errorf("availability = %+v, want recorded online", availability)
+	}
+}
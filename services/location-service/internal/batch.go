@@ -0,0 +1,119 @@
+package location_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrInvalidLocation = errors.New("invalid location")
+
+// ValidateLocation checks the fields a location update can't do without. Like
+// SetCurrentLocation, it takes any role other than driver, such as the user
+// and admin roles of the gateway's tokens, as a passenger.
+func ValidateLocation(location *CurrentLocation) error {
+	switch {
+	case location.UserID <= 0:
+		return fmt.Errorf("%w: user_id is required", ErrInvalidLocation)
+	case location.Latitude < -90 || location.Latitude > 90:
+		return fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidLocation)
+	case location.Longitude < -180 || location.Longitude > 180:
+		return fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidLocation)
+	}
+	return nil
+}
+
+// SetLocations applies a batch of location updates in order, the way
+// SetCurrentLocation applies one, in two round trips to Redis: one reading the
+// availability of the drivers in the batch and one pipeline writing it all.
+// Every update lands in its user's history; the last one of each user decides
+// where they are in the geo index.
+func (s *LocationService) SetLocations(ctx context.Context, locations []*CurrentLocation) error {
+	if len(locations) == 0 {
+		return nil
+	}
+
+	latest := make(map[int]*CurrentLocation, len(locations))
+	users := make([]int, 0, len(locations))
+	for _, location := range locations {
+		if _, ok := latest[location.UserID]; !ok {
+			users = append(users, location.UserID)
+		}
+		latest[location.UserID] = location
+	}
+	drivers := make([]int, 0, len(users))
+	for _, userID := range users {
+		if latest[userID].Role == "driver" {
+			drivers = append(drivers, userID)
+		}
+	}
+	online, err := s.onlineDrivers(ctx, drivers)
+	if err != nil {
+		return err
+	}
+
+	ttl := locationTTL()
+	now := float64(time.Now().Unix())
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, location := range locations {
+			data, err := json.Marshal(location)
+			if err != nil {
+				return fmt.Errorf("failed to marshal location: %w", err)
+			}
+			member := strconv.Itoa(location.UserID)
+			pipe.Set(ctx, member, data, ttl)
+			pipe.ZAdd(ctx, LastSeenKey, redis.Z{Score: now, Member: member})
+			s.appendHistory(ctx, pipe, location)
+		}
+		for _, userID := range users {
+			location := latest[userID]
+			member := strconv.Itoa(userID)
+			geoKey := s.getGeoKey(location.Role)
+			if geoKey == GeoKeyDrivers && !online[userID] {
+				pipe.ZRem(ctx, geoKey, member)
+				continue
+			}
+			pipe.GeoAdd(ctx, geoKey, &redis.GeoLocation{
+				Name:      member,
+				Longitude: location.Longitude,
+				Latitude:  location.Latitude,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set locations in Redis: %w", err)
+	}
+	return nil
+}
+
+// onlineDrivers reports which of driverIDs are online, with a single MGET of
+// their availability.
+func (s *LocationService) onlineDrivers(ctx context.Context, driverIDs []int) (map[int]bool, error) {
+	online := make(map[int]bool, len(driverIDs))
+	if len(driverIDs) == 0 {
+		return online, nil
+	}
+	keys := make([]string, 0, len(driverIDs))
+	for _, driverID := range driverIDs {
+		keys = append(keys, availabilityKey(driverID))
+	}
+
+	values, err := s.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver availability: %w", err)
+	}
+	for i, value := range values {
+		status, err := s.onlineStatus(value)
+		if err != nil {
+			return nil, err
+		}
+		online[driverIDs[i]] = status
+	}
+	return online, nil
+}
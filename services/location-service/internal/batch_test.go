@@ -0,0 +1,68 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValidateLocation(t *testing.T) {
+	cases := []struct {
+		name     string
+		location CurrentLocation
+		valid    bool
+	}{
+		{"driver", CurrentLocation{UserID: 1, Role: "driver", Latitude: 10.77, Longitude: 106.7}, true},
+		{"passenger", CurrentLocation{UserID: 1, Role: "passenger"}, true},
+		{"token user role", CurrentLocation{UserID: 1, Role: "user"}, true},
+		{"token admin role", CurrentLocation{UserID: 1, Role: "admin"}, true},
+		{"no user", CurrentLocation{Role: "driver"}, false},
+		{"latitude out of range", CurrentLocation{UserID: 1, Role: "user", Latitude: -91}, false},
+		{"longitude out of range", CurrentLocation{UserID: 1, Role: "user", Longitude: 181}, false},
+	}
+	for _, c := range cases {
+		err := ValidateLocation(&c.location)
+		if c.valid && err != nil {
+			t.Errorf("%s: ValidateLocation = %v, want valid", c.name, err)
+		}
+		if !c.valid && !errors.Is(err, ErrInvalidLocation) {
+			t.Errorf("%s: ValidateLocation = %v, want ErrInvalidLocation", c.name, err)
+		}
+	}
+}
+
+func TestSetLocationsFromUserRole(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	if _, err := s.GoOnline(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	// What the gateway sends for a batch: the role from the caller's token.
+	batch := []*CurrentLocation{
+		{UserID: 1, Role: "user", Latitude: 10.770, Longitude: 106.70},
+		{UserID: 1, Role: "user", Latitude: 10.771, Longitude: 106.70},
+		{UserID: 2, Role: "admin", Latitude: 10.772, Longitude: 106.70},
+		{UserID: 3, Role: "driver", Latitude: 10.773, Longitude: 106.70},
+	}
+	for _, location := range batch {
+		if err := ValidateLocation(location); err != nil {
+			t.Fatalf("ValidateLocation(%+v): %v", location, err)
+		}
+	}
+	if err := s.SetLocations(ctx, batch); err != nil {
+		t.Fatalf("SetLocations: %v", err)
+	}
+
+	for _, id := range []int{1, 2} {
+		if !inIndex(t, s, GeoKeyPassengers, id) {
+			t.Errorf("user %d not indexed as a passenger", id)
+		}
+	}
+	if !inIndex(t, s, GeoKeyDrivers, 3) {
+		t.Error("driver 3 not indexed")
+	}
+	location, err := s.GetCurrentLocation(ctx, 1)
+	if err != nil || location == nil || location.Latitude != 10.771 {
+		t.Errorf("GetCurrentLocation(1) = %+v, %v, want the last ping", location, err)
+	}
+}
@@ -0,0 +1,156 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultHeatmapPrecision = 6
+	maxHeatmapPrecision     = 9
+	geohashAlphabet         = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+var ErrInvalidHeatmap = errors.New("invalid heatmap query")
+
+// HeatmapCell counts the users whose location falls in one geohash cell.
+// Latitude and Longitude are the center of the cell.
+type HeatmapCell struct {
+	Geohash    string
+	Latitude   float64
+	Longitude  float64
+	Drivers    int
+	Passengers int
+}
+
+// encodeGeohash returns the standard base32 geohash of a point with
+// precision characters.
+func encodeGeohash(latitude, longitude float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	hash := make([]byte, 0, precision)
+	bit, ch, even := 0, 0, true
+	for len(hash) < precision {
+		// Bits alternate between longitude and latitude, longitude first.
+		value, r := latitude, &latRange
+		if even {
+			value, r = longitude, &lngRange
+		}
+		mid := (r[0] + r[1]) / 2
+		ch <<= 1
+		if value >= mid {
+			ch |= 1
+			r[0] = mid
+		} else {
+			r[1] = mid
+		}
+		even = !even
+		if bit++; bit == 5 {
+			hash = append(hash, geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return string(hash)
+}
+
+// geohashCenter returns the center of the cell a geohash names.
+func geohashCenter(hash string) (latitude, longitude float64) {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		ch := 0
+		for ; ch < len(geohashAlphabet) && geohashAlphabet[ch] != hash[i]; ch++ {
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			r := &latRange
+			if even {
+				r = &lngRange
+			}
+			mid := (r[0] + r[1]) / 2
+			if ch&mask != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+	return (latRange[0] + latRange[1]) / 2, (lngRange[0] + lngRange[1]) / 2
+}
+
+// GetHeatmap counts the drivers and passengers inside box per geohash cell of
+// the given precision, ordered by geohash. Redis does the spatial filtering
+// and only returns member coordinates, so no location is read in full. As in
+// the geo index, drivers are only counted while online.
+func (s *LocationService) GetHeatmap(ctx context.Context, box BoundingBox, precision int) ([]HeatmapCell, error) {
+	if precision == 0 {
+		precision = defaultHeatmapPrecision
+	}
+	if precision < 1 || precision > maxHeatmapPrecision {
+		return nil, fmt.Errorf("%w: precision must be between 1 and %d", ErrInvalidHeatmap, maxHeatmapPrecision)
+	}
+	if err := box.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHeatmap, err)
+	}
+
+	latitude, longitude := box.center()
+	width, height := boxSize(box)
+	search := &redis.GeoSearchLocationQuery{
+		GeoSearchQuery: redis.GeoSearchQuery{
+			Longitude: longitude,
+			Latitude:  latitude,
+			BoxWidth:  width,
+			BoxHeight: height,
+			BoxUnit:   "km",
+		},
+		WithCoord: true,
+	}
+	var drivers, passengers *redis.GeoSearchLocationCmd
+	_, err := s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		drivers = pipe.GeoSearchLocation(ctx, GeoKeyDrivers, search)
+		passengers = pipe.GeoSearchLocation(ctx, GeoKeyPassengers, search)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search locations: %w", err)
+	}
+
+	cells := make(map[string]*HeatmapCell)
+	count := func(results []redis.GeoLocation, driver bool) {
+		for _, r := range results {
+			// The box searched is a little larger than the one asked for
+			// away from the equator.
+			if !box.contains(r.Latitude, r.Longitude) {
+				continue
+			}
+			hash := encodeGeohash(r.Latitude, r.Longitude, precision)
+			cell, ok := cells[hash]
+			if !ok {
+				cell = &HeatmapCell{Geohash: hash}
+				cell.Latitude, cell.Longitude = geohashCenter(hash)
+				cells[hash] = cell
+			}
+			if driver {
+				cell.Drivers++
+			} else {
+				cell.Passengers++
+			}
+		}
+	}
+	count(drivers.Val(), true)
+	count(passengers.Val(), false)
+
+	heatmap := make([]HeatmapCell, 0, len(cells))
+	for _, cell := range cells {
+		heatmap = append(heatmap, *cell)
+	}
+	sort.Slice(heatmap, func(i, j int) bool {
+		return heatmap[i].Geohash < heatmap[j].Geohash
+	})
+	return heatmap, nil
+}
@@ -0,0 +1,93 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestGeohash(t *testing.T) {
+	cases := []struct {
+		latitude, longitude float64
+		precision           int
+		want                string
+	}{
+		{57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{57.64911, 10.40744, 5, "u4pru"},
+		{10.762622, 106.660172, 6, "w3gv5p"},
+		{0, 0, 1, "s"},
+		{-90, -180, 2, "00"},
+		{90, 180, 2, "zz"},
+	}
+	for _, c := range cases {
+		got := encodeGeohash(c.latitude, c.longitude, c.precision)
+		if got != c.want {
+			t.Errorf("encodeGeohash(%v, %v, %d) = %q, want %q", c.latitude, c.longitude, c.precision, got, c.want)
+			continue
+		}
+
+		// The center is within half a cell of the point and names the
+		// same cell.
+		latitude, longitude := geohashCenter(got)
+		bits := 5 * c.precision
+		latErr := 90 / math.Pow(2, float64(bits/2))
+		lngErr := 180 / math.Pow(2, float64(bits-bits/2))
+		if math.Abs(latitude-c.latitude) > latErr || math.Abs(longitude-c.longitude) > lngErr {
+			t.Errorf("geohashCenter(%q) = %v, %v, want within %v, %v of %v, %v",
+				got, latitude, longitude, latErr, lngErr, c.latitude, c.longitude)
+		}
+		if again := encodeGeohash(latitude, longitude, c.precision); again != got {
+			t.Errorf("center of %q encodes to %q", got, again)
+		}
+	}
+}
+
+func TestGetHeatmap(t *testing.T) {
+	s, mr := newTestService(t)
+	withGeoSearch(t, mr)
+	ctx := context.Background()
+	// Two drivers and a passenger in one cell, a passenger in another.
+	setLocation(t, s, 1, "driver", 10.7626, 106.6601)
+	setLocation(t, s, 2, "driver", 10.7630, 106.6605)
+	setLocation(t, s, 3, "passenger", 10.7628, 106.6603)
+	setLocation(t, s, 4, "passenger", 10.80, 106.72)
+	// Offline drivers aren't counted.
+	setLocation(t, s, 5, "driver", 10.7627, 106.6602)
+	if _, err := s.GoOffline(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	// Outside the box.
+	setLocation(t, s, 6, "driver", 10.95, 106.66)
+
+	box := BoundingBox{MinLat: 10.70, MinLng: 106.60, MaxLat: 10.90, MaxLng: 106.80}
+	cells, err := s.GetHeatmap(ctx, box, 6)
+	if err != nil {
+		t.Fatalf("GetHeatmap: %v", err)
+	}
+	want := []HeatmapCell{
+		{Geohash: encodeGeohash(10.7626, 106.6601, 6), Drivers: 2, Passengers: 1},
+		{Geohash: encodeGeohash(10.80, 106.72, 6), Passengers: 1},
+	}
+	if want[0].Geohash > want[1].Geohash {
+		want[0], want[1] = want[1], want[0]
+	}
+	if len(cells) != len(want) {
+		t.Fatalf("got %+v, want %+v", cells, want)
+	}
+	for i, cell := range cells {
+		if cell.Geohash != want[i].Geohash || cell.Drivers != want[i].Drivers || cell.Passengers != want[i].Passengers {
+			t.Errorf("cell %d = %+v, want %+v", i, cell, want[i])
+		}
+		if latitude, longitude := geohashCenter(cell.Geohash); cell.Latitude != latitude || cell.Longitude != longitude {
+			t.Errorf("cell %s at %v, %v, want its center", cell.Geohash, cell.Latitude, cell.Longitude)
+		}
+	}
+
+	if _, err := s.GetHeatmap(ctx, box, maxHeatmapPrecision+1); !errors.Is(err, ErrInvalidHeatmap) {
+		t.Errorf("precision %d err = %v, want ErrInvalidHeatmap", maxHeatmapPrecision+1, err)
+	}
+	if _, err := s.GetHeatmap(ctx, BoundingBox{MinLat: 11, MinLng: 106, MaxLat: 10, MaxLng: 107}, 0); !errors.Is(err, ErrInvalidHeatmap) {
+		t.Errorf("inverted box err = %v, want ErrInvalidHeatmap", err)
+	}
+}
@@ -0,0 +1,198 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultHistoryMaxLen    = 20000
+	defaultHistoryRetention = 72 * time.Hour
+	defaultHistoryRange     = time.Hour
+	maxHistoryRange         = 7 * 24 * time.Hour
+	defaultHistoryLimit     = 1000
+	maxHistoryLimit         = 10000
+	// historyPageSize is how many entries a history query reads per XRANGE.
+	historyPageSize = 1000
+)
+
+var ErrInvalidHistoryQuery = errors.New("invalid location history query")
+
+// HistoryPolicy caps each user's location history stream. Both caps are
+// applied approximately, so a stream can briefly hold a little more.
+type HistoryPolicy struct {
+	MaxLen    int64
+	Retention time.Duration
+}
+
+// LoadHistoryPolicy reads LOCATION_HISTORY_MAX_LEN and
+// LOCATION_HISTORY_RETENTION, keeping the defaults when they are unset or
+// invalid.
+func LoadHistoryPolicy() HistoryPolicy {
+	policy := HistoryPolicy{MaxLen: defaultHistoryMaxLen, Retention: defaultHistoryRetention}
+	if n, err := strconv.ParseInt(env.Get("LOCATION_HISTORY_MAX_LEN", ""), 10, 64); err == nil && n > 0 {
+		policy.MaxLen = n
+	}
+	if d, err := time.ParseDuration(env.Get("LOCATION_HISTORY_RETENTION", "")); err == nil && d > 0 {
+		policy.Retention = d
+	}
+	return policy
+}
+
+// historyKey isn't numeric, so GetAllLocations skips it.
+func historyKey(userID int) string {
+	return "location:history:" + strconv.Itoa(userID)
+}
+
+// LocationPoint is one recorded location update. RecordedAt is when
+// location-service received it, taken from the stream entry id; Timestamp is
+// what the device reported.
+type LocationPoint struct {
+	ID         string    `json:"id"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Role       string    `json:"role"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	Speed      float64   `json:"speed"`
+	Heading    string    `json:"heading"`
+	Timestamp  string    `json:"timestamp"`
+}
+
+// HistoryQuery selects a user's locations recorded in [From, To). With an
+// Interval only the first point of each interval is kept.
+type HistoryQuery struct {
+	UserID   int
+	From     time.Time
+	To       time.Time
+	Interval time.Duration
+	Limit    int
+}
+
+// appendHistory queues the location onto the user's history stream and trims
+// the stream to the policy.
+func (s *LocationService) appendHistory(ctx context.Context, pipe redis.Pipeliner, location *CurrentLocation) {
+	key := historyKey(location.UserID)
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: s.history.MaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"role":      location.Role,
+			"latitude":  strconv.FormatFloat(location.Latitude, 'f', -1, 64),
+			"longitude": strconv.FormatFloat(location.Longitude, 'f', -1, 64),
+			"speed":     strconv.FormatFloat(location.Speed, 'f', -1, 64),
+			"heading":   location.Heading,
+			"timestamp": location.Timestamp,
+		},
+	})
+	// Entry ids start with the time they were added in milliseconds, so
+	// trimming by id drops everything older than the retention. The stream
+	// also expires once the user stops sending updates.
+	minID := strconv.FormatInt(time.Now().Add(-s.history.Retention).UnixMilli(), 10)
+	pipe.XTrimMinIDApprox(ctx, key, minID, 0)
+	pipe.Expire(ctx, key, s.history.Retention)
+}
+
+func normalizeHistoryQuery(q HistoryQuery, now time.Time) (HistoryQuery, error) {
+	if q.To.IsZero() {
+		q.To = now
+	}
+	if q.From.IsZero() {
+		q.From = q.To.Add(-defaultHistoryRange)
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultHistoryLimit
+	}
+	if q.Limit > maxHistoryLimit {
+		q.Limit = maxHistoryLimit
+	}
+
+	switch {
+	case q.UserID <= 0:
+		return q, fmt.Errorf("%w: user_id is required", ErrInvalidHistoryQuery)
+	case !q.From.Before(q.To):
+		return q, fmt.Errorf("%w: from must be before to", ErrInvalidHistoryQuery)
+	case q.To.Sub(q.From) > maxHistoryRange:
+		return q, fmt.Errorf("%w: range is longer than %d days", ErrInvalidHistoryQuery, int(maxHistoryRange.Hours()/24))
+	case q.Interval < 0 || (q.Interval > 0 && q.Interval < time.Second):
+		return q, fmt.Errorf("%w: interval must be at least a second", ErrInvalidHistoryQuery)
+	}
+	return q, nil
+}
+
+func parseHistoryEntry(entry redis.XMessage) (LocationPoint, error) {
+	msPart, _, _ := strings.Cut(entry.ID, "-")
+	ms, err := strconv.ParseInt(msPart, 10, 64)
+	if err != nil {
+		return LocationPoint{}, fmt.Errorf("invalid history entry id %q", entry.ID)
+	}
+	field := func(name string) string {
+		v, _ := entry.Values[name].(string)
+		return v
+	}
+	number := func(name string) float64 {
+		f, _ := strconv.ParseFloat(field(name), 64)
+		return f
+	}
+	return LocationPoint{
+		ID:         entry.ID,
+		RecordedAt: time.UnixMilli(ms).UTC(),
+		Role:       field("role"),
+		Latitude:   number("latitude"),
+		Longitude:  number("longitude"),
+		Speed:      number("speed"),
+		Heading:    field("heading"),
+		Timestamp:  field("timestamp"),
+	}, nil
+}
+
+// GetLocationHistory returns the user's recorded locations matching q, oldest
+// first, and whether more points matched than q.Limit allowed.
+func (s *LocationService) GetLocationHistory(ctx context.Context, q HistoryQuery) ([]LocationPoint, bool, error) {
+	q, err := normalizeHistoryQuery(q, time.Now())
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := historyKey(q.UserID)
+	start := strconv.FormatInt(q.From.UnixMilli(), 10)
+	// The end of an XRANGE is inclusive; the last millisecond before To is
+	// the last one in range.
+	end := strconv.FormatInt(q.To.UnixMilli()-1, 10)
+	points := make([]LocationPoint, 0)
+	lastBucket := int64(-1)
+	for {
+		entries, err := s.redisClient.XRangeN(ctx, key, start, end, historyPageSize).Result()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read location history: %w", err)
+		}
+		for _, entry := range entries {
+			point, err := parseHistoryEntry(entry)
+			if err != nil {
+				return nil, false, err
+			}
+			if q.Interval > 0 {
+				bucket := point.RecordedAt.UnixMilli() / q.Interval.Milliseconds()
+				if bucket == lastBucket {
+					continue
+				}
+				lastBucket = bucket
+			}
+			if len(points) == q.Limit {
+				return points, true, nil
+			}
+			points = append(points, point)
+		}
+		if len(entries) < historyPageSize {
+			return points, false, nil
+		}
+		start = "(" + entries[len(entries)-1].ID
+	}
+}
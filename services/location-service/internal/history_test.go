@@ -0,0 +1,191 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var historyBase = time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+// addHistory records points for the user at the given offsets from
+// historyBase, as if location-service had received them then.
+func addHistory(t *testing.T, s *LocationService, userID int, offsets ...time.Duration) {
+	t.Helper()
+	ctx := context.Background()
+	for _, offset := range offsets {
+		ms := historyBase.Add(offset).UnixMilli()
+		if err := s.redisClient.XAdd(ctx, &redis.XAddArgs{
+			Stream: historyKey(userID),
+			ID:     strconv.FormatInt(ms, 10) + "-0",
+			Values: map[string]interface{}{"role": "driver", "latitude": "10.77", "longitude": "106.7"},
+		}).Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func recordedAt(points []LocationPoint) []time.Duration {
+	offsets := make([]time.Duration, len(points))
+	for i, p := range points {
+		offsets[i] = p.RecordedAt.Sub(historyBase)
+	}
+	return offsets
+}
+
+func TestNormalizeHistoryQuery(t *testing.T) {
+	now := historyBase
+	cases := []struct {
+		name    string
+		q       HistoryQuery
+		want    HistoryQuery
+		invalid bool
+	}{
+		{name: "defaults", q: HistoryQuery{UserID: 1},
+			want: HistoryQuery{UserID: 1, From: now.Add(-defaultHistoryRange), To: now, Limit: defaultHistoryLimit}},
+		{name: "limit capped", q: HistoryQuery{UserID: 1, Limit: maxHistoryLimit + 1},
+			want: HistoryQuery{UserID: 1, From: now.Add(-defaultHistoryRange), To: now, Limit: maxHistoryLimit}},
+		{name: "whole week", q: HistoryQuery{UserID: 1, From: now.Add(-maxHistoryRange)},
+			want: HistoryQuery{UserID: 1, From: now.Add(-maxHistoryRange), To: now, Limit: defaultHistoryLimit}},
+		{name: "no user", q: HistoryQuery{}, invalid: true},
+		{name: "from after to", q: HistoryQuery{UserID: 1, From: now.Add(time.Second)}, invalid: true},
+		{name: "empty range", q: HistoryQuery{UserID: 1, From: now, To: now}, invalid: true},
+		{name: "range too long", q: HistoryQuery{UserID: 1, From: now.Add(-maxHistoryRange - time.Second)}, invalid: true},
+		{name: "negative interval", q: HistoryQuery{UserID: 1, Interval: -time.Second}, invalid: true},
+		{name: "sub-second interval", q: HistoryQuery{UserID: 1, Interval: 500 * time.Millisecond}, invalid: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := normalizeHistoryQuery(c.q, now)
+			if c.invalid {
+				if !errors.Is(err, ErrInvalidHistoryQuery) {
+					t.Errorf("err = %v, want ErrInvalidHistoryQuery", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeHistoryQuery: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestLocationHistoryRangeExcludesEnd(t *testing.T) {
+	s, _ := newTestService(t)
+	addHistory(t, s, 1, -time.Millisecond, 0, time.Second, 2*time.Second-time.Millisecond, 2*time.Second)
+
+	points, truncated, err := s.GetLocationHistory(context.Background(), HistoryQuery{
+		UserID: 1,
+		From:   historyBase,
+		To:     historyBase.Add(2 * time.Second),
+	})
+	if err != nil {
+		t.Fatalf("GetLocationHistory: %v", err)
+	}
+	got := recordedAt(points)
+	want := []time.Duration{0, time.Second, 2*time.Second - time.Millisecond}
+	if truncated || len(got) != len(want) {
+		t.Fatalf("got %v (truncated %v), want %v", got, truncated, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("point %d at %v, want %v", i, got[i], want[i])
+		}
+	}
+	if points[0].Role != "driver" || points[0].Latitude != 10.77 || points[0].Longitude != 106.7 {
+		t.Errorf("point = %+v, want the recorded fields", points[0])
+	}
+}
+
+func TestLocationHistoryInterval(t *testing.T) {
+	s, _ := newTestService(t)
+	var offsets []time.Duration
+	for ms := 300; ms < 3000; ms += 250 {
+		offsets = append(offsets, time.Duration(ms)*time.Millisecond)
+	}
+	addHistory(t, s, 1, offsets...)
+
+	points, _, err := s.GetLocationHistory(context.Background(), HistoryQuery{
+		UserID:   1,
+		From:     historyBase,
+		To:       historyBase.Add(time.Minute),
+		Interval: time.Second,
+	})
+	if err != nil {
+		t.Fatalf("GetLocationHistory: %v", err)
+	}
+	// The first point of each second.
+	got := recordedAt(points)
+	want := []time.Duration{300 * time.Millisecond, 1050 * time.Millisecond, 2050 * time.Millisecond}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("point %d at %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLocationHistoryPaging(t *testing.T) {
+	s, _ := newTestService(t)
+	const n = 2*historyPageSize + 500
+	offsets := make([]time.Duration, n)
+	for i := range offsets {
+		offsets[i] = time.Duration(i) * time.Millisecond
+	}
+	addHistory(t, s, 1, offsets...)
+
+	points, truncated, err := s.GetLocationHistory(context.Background(), HistoryQuery{
+		UserID: 1,
+		From:   historyBase,
+		To:     historyBase.Add(time.Minute),
+		Limit:  maxHistoryLimit,
+	})
+	if err != nil {
+		t.Fatalf("GetLocationHistory: %v", err)
+	}
+	if truncated || len(points) != n {
+		t.Fatalf("got %d points (truncated %v), want %d", len(points), truncated, n)
+	}
+	for i, offset := range recordedAt(points) {
+		if offset != offsets[i] {
+			t.Fatalf("point %d at %v, want %v", i, offset, offsets[i])
+		}
+	}
+}
+
+func TestLocationHistoryTruncated(t *testing.T) {
+	s, _ := newTestService(t)
+	addHistory(t, s, 1, 0, time.Second, 2*time.Second, 3*time.Second)
+	ctx := context.Background()
+	q := HistoryQuery{UserID: 1, From: historyBase, To: historyBase.Add(time.Minute)}
+
+	cases := []struct {
+		limit     int
+		points    int
+		truncated bool
+	}{
+		{limit: 3, points: 3, truncated: true},
+		{limit: 4, points: 4, truncated: false},
+		{limit: 5, points: 4, truncated: false},
+	}
+	for _, c := range cases {
+		q.Limit = c.limit
+		points, truncated, err := s.GetLocationHistory(ctx, q)
+		if err != nil {
+			t.Fatalf("GetLocationHistory: %v", err)
+		}
+		if len(points) != c.points || truncated != c.truncated {
+			t.Errorf("limit %d: got %d points (truncated %v), want %d (truncated %v)",
+				c.limit, len(points), truncated, c.points, c.truncated)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultSearchRadius = 10.0
+	maxSearchRadius     = 100.0
+	defaultSearchLimit  = 50
+	maxSearchLimit      = 500
+	// kmPerDegree is the length of a degree of latitude, and of longitude at
+	// the equator.
+	kmPerDegree = 111.32
+)
+
+var ErrInvalidSearch = errors.New("invalid location search")
+
+// BoundingBox is an area between two latitudes and two longitudes. Boxes
+// across the antimeridian aren't supported.
+type BoundingBox struct {
+	MinLat float64
+	MinLng float64
+	MaxLat float64
+	MaxLng float64
+}
+
+func (b BoundingBox) validate() error {
+	switch {
+	case b.MinLat < -90 || b.MaxLat > 90 || b.MinLng < -180 || b.MaxLng > 180:
+		return errors.New("box is outside valid coordinates")
+	case b.MinLat >= b.MaxLat || b.MinLng >= b.MaxLng:
+		return errors.New("box min must be below max")
+	}
+	return nil
+}
+
+func (b BoundingBox) center() (latitude, longitude float64) {
+	return (b.MinLat + b.MaxLat) / 2, (b.MinLng + b.MaxLng) / 2
+}
+
+func (b BoundingBox) contains(latitude, longitude float64) bool {
+	return latitude >= b.MinLat && latitude <= b.MaxLat && longitude >= b.MinLng && longitude <= b.MaxLng
+}
+
+// SearchQuery finds users within RadiusKm of a point, or inside Box when it
+// is set. Role is driver, passenger or empty for both.
+type SearchQuery struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+	Box       *BoundingBox
+	Role      string
+	Limit     int
+}
+
+func normalizeSearchQuery(q SearchQuery) (SearchQuery, error) {
+	if q.Limit <= 0 {
+		q.Limit = defaultSearchLimit
+	}
+	if q.Limit > maxSearchLimit {
+		q.Limit = maxSearchLimit
+	}
+	if q.Role != "" && q.Role != "driver" && q.Role != "passenger" {
+		return q, fmt.Errorf("%w: role must be driver, passenger or empty", ErrInvalidSearch)
+	}
+
+	if q.Box != nil {
+		if err := q.Box.validate(); err != nil {
+			return q, fmt.Errorf("%w: %v", ErrInvalidSearch, err)
+		}
+		q.Latitude, q.Longitude = q.Box.center()
+		return q, nil
+	}
+
+	if q.RadiusKm <= 0 {
+		q.RadiusKm = defaultSearchRadius
+	}
+	switch {
+	case q.Latitude < -90 || q.Latitude > 90 || q.Longitude < -180 || q.Longitude > 180:
+		return q, fmt.Errorf("%w: coordinates are out of range", ErrInvalidSearch)
+	case q.RadiusKm > maxSearchRadius:
+		return q, fmt.Errorf("%w: radius is larger than %.0f km", ErrInvalidSearch, maxSearchRadius)
+	}
+	return q, nil
+}
+
+// boxSize returns the width and height in km of a box centered on b that
+// covers all of b. The width is measured where b is widest, the latitude
+// closest to the equator.
+func boxSize(b BoundingBox) (width, height float64) {
+	widest := 0.0
+	if b.MinLat > 0 {
+		widest = b.MinLat
+	} else if b.MaxLat < 0 {
+		widest = b.MaxLat
+	}
+	width = (b.MaxLng - b.MinLng) * kmPerDegree * math.Cos(widest*math.Pi/180)
+	height = (b.MaxLat - b.MinLat) * kmPerDegree
+	return width, height
+}
+
+// SearchLocations returns the users matching q, nearest to the center first.
+// Like FindTopNearestUsers, it only finds drivers while they are online.
+func (s *LocationService) SearchLocations(ctx context.Context, q SearchQuery) ([]*CurrentLocation, error) {
+	q, err := normalizeSearchQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	search := redis.GeoSearchQuery{
+		Longitude: q.Longitude,
+		Latitude:  q.Latitude,
+		Sort:      "ASC",
+	}
+	if q.Box != nil {
+		// Redis can't stop at q.Limit: the nearest results may lie in the
+		// part of the searched box outside q.Box, so the limit is applied
+		// after filtering.
+		search.BoxWidth, search.BoxHeight = boxSize(*q.Box)
+		search.BoxUnit = "km"
+	} else {
+		search.Radius = q.RadiusKm
+		search.RadiusUnit = "km"
+		search.Count = q.Limit
+	}
+
+	geoKeys := []string{GeoKeyDrivers, GeoKeyPassengers}
+	if q.Role != "" {
+		geoKeys = []string{s.getGeoKey(q.Role)}
+	}
+	cmds := make([]*redis.GeoSearchLocationCmd, len(geoKeys))
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, geoKey := range geoKeys {
+			cmds[i] = pipe.GeoSearchLocation(ctx, geoKey, &redis.GeoSearchLocationQuery{
+				GeoSearchQuery: search,
+				WithCoord:      true,
+				WithDist:       true,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search locations: %w", err)
+	}
+
+	locations := make([]*CurrentLocation, 0)
+	for i, geoKey := range geoKeys {
+		results := cmds[i].Val()
+		if q.Box != nil {
+			// The box searched is a little larger than the one asked for
+			// away from the equator.
+			inside := results[:0]
+			for _, r := range results {
+				if q.Box.contains(r.Latitude, r.Longitude) {
+					inside = append(inside, r)
+				}
+			}
+			results = inside
+		}
+		found, err := s.withDetails(ctx, geoKey, results)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, found...)
+	}
+
+	sort.SliceStable(locations, func(i, j int) bool {
+		return locations[i].Distance < locations[j].Distance
+	})
+	if len(locations) > q.Limit {
+		locations = locations[:q.Limit]
+	}
+	return locations, nil
+}
@@ -0,0 +1,256 @@
+package location_service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/alicebob/miniredis/v2/server"
+	"github.com/redis/go-redis/v9"
+)
+
+// withGeoSearch adds the GEOSEARCH command miniredis lacks, for FROMLONLAT
+// searches in km. It runs a GEORADIUS around the point over its own
+// connection, which for BYBOX covers the box's corners, and keeps the
+// results inside the box the way Redis measures it: height along the
+// meridian, width along the member's parallel.
+func withGeoSearch(t *testing.T, mr *miniredis.Miniredis) {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	err := mr.Server().Register("GEOSEARCH", func(c *server.Peer, cmd string, args []string) {
+		fail := func(msg string) { c.WriteError("ERR " + msg) }
+		if len(args) < 4 || !strings.EqualFold(args[1], "FROMLONLAT") {
+			fail("only FROMLONLAT is supported")
+			return
+		}
+		key := args[0]
+		longitude, _ := strconv.ParseFloat(args[2], 64)
+		latitude, _ := strconv.ParseFloat(args[3], 64)
+		var radius, width, height float64
+		var byBox, withCoord, withDist bool
+		query := &redis.GeoRadiusQuery{WithCoord: true, WithDist: true, Unit: "km"}
+		for i := 4; i < len(args); i++ {
+			number := func(j int) float64 {
+				f, _ := strconv.ParseFloat(args[j], 64)
+				return f
+			}
+			switch strings.ToUpper(args[i]) {
+			case "BYRADIUS":
+				radius = number(i + 1)
+				if !strings.EqualFold(args[i+2], "km") {
+					fail("only km is supported")
+					return
+				}
+				i += 2
+			case "BYBOX":
+				byBox, width, height = true, number(i+1), number(i+2)
+				radius = math.Hypot(width, height) / 2
+				if !strings.EqualFold(args[i+3], "km") {
+					fail("only km is supported")
+					return
+				}
+				i += 3
+			case "ASC", "DESC":
+				query.Sort = strings.ToUpper(args[i])
+			case "COUNT":
+				query.Count = int(number(i + 1))
+				i++
+			case "WITHCOORD":
+				withCoord = true
+			case "WITHDIST":
+				withDist = true
+			default:
+				fail("unsupported GEOSEARCH option " + args[i])
+				return
+			}
+		}
+		count := query.Count
+		query.Radius, query.Count = radius, 0
+		results, err := client.GeoRadius(context.Background(), key, longitude, latitude, query).Result()
+		if err != nil {
+			fail(err.Error())
+			return
+		}
+
+		found := results[:0]
+		for _, r := range results {
+			if byBox {
+				dy := math.Abs(r.Latitude-latitude) * kmPerDegree
+				dx := math.Abs(r.Longitude-longitude) * kmPerDegree * math.Cos(r.Latitude*math.Pi/180)
+				if dy > height/2 || dx > width/2 {
+					continue
+				}
+			}
+			found = append(found, r)
+		}
+		if count > 0 && len(found) > count {
+			found = found[:count]
+		}
+
+		c.WriteLen(len(found))
+		for _, r := range found {
+			if !withCoord && !withDist {
+				c.WriteBulk(r.Name)
+				continue
+			}
+			fields := 1
+			if withDist {
+				fields++
+			}
+			if withCoord {
+				fields++
+			}
+			c.WriteLen(fields)
+			c.WriteBulk(r.Name)
+			if withDist {
+				c.WriteBulk(strconv.FormatFloat(r.Dist, 'f', 4, 64))
+			}
+			if withCoord {
+				c.WriteLen(2)
+				c.WriteBulk(strconv.FormatFloat(r.Longitude, 'f', -1, 64))
+				c.WriteBulk(strconv.FormatFloat(r.Latitude, 'f', -1, 64))
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func userIDs(locations []*CurrentLocation) []int {
+	ids := make([]int, len(locations))
+	for i, l := range locations {
+		ids[i] = l.UserID
+	}
+	return ids
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNormalizeSearchQuery(t *testing.T) {
+	box := &BoundingBox{MinLat: 10, MinLng: 106, MaxLat: 11, MaxLng: 107}
+	cases := []struct {
+		name    string
+		q       SearchQuery
+		want    SearchQuery
+		invalid bool
+	}{
+		{name: "defaults", q: SearchQuery{Latitude: 10.77, Longitude: 106.7},
+			want: SearchQuery{Latitude: 10.77, Longitude: 106.7, RadiusKm: defaultSearchRadius, Limit: defaultSearchLimit}},
+		{name: "limit capped", q: SearchQuery{RadiusKm: 5, Role: "driver", Limit: maxSearchLimit + 1},
+			want: SearchQuery{RadiusKm: 5, Role: "driver", Limit: maxSearchLimit}},
+		{name: "box centers the search", q: SearchQuery{Latitude: 50, Box: box, Role: "passenger"},
+			want: SearchQuery{Latitude: 10.5, Longitude: 106.5, Box: box, Role: "passenger", Limit: defaultSearchLimit}},
+		{name: "unknown role", q: SearchQuery{Role: "admin"}, invalid: true},
+		{name: "latitude out of range", q: SearchQuery{Latitude: 91}, invalid: true},
+		{name: "longitude out of range", q: SearchQuery{Longitude: -181}, invalid: true},
+		{name: "radius too large", q: SearchQuery{RadiusKm: maxSearchRadius + 1}, invalid: true},
+		{name: "box min above max", q: SearchQuery{Box: &BoundingBox{MinLat: 11, MinLng: 106, MaxLat: 10, MaxLng: 107}}, invalid: true},
+		{name: "empty box", q: SearchQuery{Box: &BoundingBox{MinLat: 10, MinLng: 106, MaxLat: 10, MaxLng: 106}}, invalid: true},
+		{name: "box outside coordinates", q: SearchQuery{Box: &BoundingBox{MinLat: 80, MinLng: 170, MaxLat: 91, MaxLng: 181}}, invalid: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := normalizeSearchQuery(c.q)
+			if c.invalid {
+				if !errors.Is(err, ErrInvalidSearch) {
+					t.Errorf("err = %v, want ErrInvalidSearch", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeSearchQuery: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestBoxSize(t *testing.T) {
+	cases := []struct {
+		name          string
+		box           BoundingBox
+		width, height float64
+	}{
+		{"equator", BoundingBox{MinLat: 0, MinLng: 0, MaxLat: 1, MaxLng: 1}, kmPerDegree, kmPerDegree},
+		{"across the equator", BoundingBox{MinLat: -1, MinLng: 0, MaxLat: 1, MaxLng: 2}, 2 * kmPerDegree, 2 * kmPerDegree},
+		{"north", BoundingBox{MinLat: 60, MinLng: 10, MaxLat: 62, MaxLng: 12}, kmPerDegree, 2 * kmPerDegree},
+		{"south", BoundingBox{MinLat: -62, MinLng: 10, MaxLat: -60, MaxLng: 12}, kmPerDegree, 2 * kmPerDegree},
+	}
+	for _, c := range cases {
+		width, height := boxSize(c.box)
+		if math.Abs(width-c.width) > 1e-9 || math.Abs(height-c.height) > 1e-9 {
+			t.Errorf("%s: boxSize = %v x %v km, want %v x %v", c.name, width, height, c.width, c.height)
+		}
+	}
+}
+
+func TestSearchLocationsRole(t *testing.T) {
+	s, mr := newTestService(t)
+	withGeoSearch(t, mr)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.771, 106.70)
+	setLocation(t, s, 2, "passenger", 10.772, 106.70)
+	setLocation(t, s, 3, "driver", 10.773, 106.70)
+	setLocation(t, s, 4, "driver", 10.774, 106.70)
+	if _, err := s.GoOffline(ctx, 4); err != nil {
+		t.Fatal(err)
+	}
+	// Outside the radius.
+	setLocation(t, s, 5, "passenger", 11.5, 106.70)
+
+	cases := []struct {
+		role string
+		want []int
+	}{
+		{"", []int{1, 2, 3}},
+		{"driver", []int{1, 3}},
+		{"passenger", []int{2}},
+	}
+	for _, c := range cases {
+		locations, err := s.SearchLocations(ctx, SearchQuery{Latitude: 10.77, Longitude: 106.70, RadiusKm: 5, Role: c.role})
+		if err != nil {
+			t.Fatalf("SearchLocations(%q): %v", c.role, err)
+		}
+		if got := userIDs(locations); !equalIDs(got, c.want) {
+			t.Errorf("role %q found %v, want %v", c.role, got, c.want)
+		}
+	}
+}
+
+func TestSearchLocationsBoxLimit(t *testing.T) {
+	s, mr := newTestService(t)
+	withGeoSearch(t, mr)
+	box := &BoundingBox{MinLat: 60, MinLng: 10, MaxLat: 62, MaxLng: 12}
+	// Just east of the box, in the part of the searched box outside it, and
+	// nearer the center than anyone inside.
+	setLocation(t, s, 1, "passenger", 61, 12.02)
+	setLocation(t, s, 2, "passenger", 60.05, 10.05)
+	setLocation(t, s, 3, "passenger", 61.9, 11.9)
+
+	locations, err := s.SearchLocations(context.Background(), SearchQuery{Box: box, Limit: 1})
+	if err != nil {
+		t.Fatalf("SearchLocations: %v", err)
+	}
+	if got := userIDs(locations); !equalIDs(got, []int{3}) {
+		t.Errorf("found %v, want the nearest inside the box, [3]", got)
+	}
+}
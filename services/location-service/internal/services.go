@@ -16,18 +16,36 @@ var TIMETOLIVE = env.Get("REDIS_TIME_TO_LIVE", "3600")
 const (
 	GeoKeyDrivers    = "geo:drivers"
 	GeoKeyPassengers = "geo:passengers"
+	// LastSeenKey scores every member of the geo indexes with the unix time
+	// of their last location update, so the sweeper can find stale ones.
+	LastSeenKey = "geo:last_seen"
 )
 
 type LocationService struct {
 	redisClient *redis.Client
+	history     HistoryPolicy
+	// defaultAvailability is the status of drivers with no recorded
+	// availability.
+	defaultAvailability string
 }
 
 func NewLocationService(redisClient *redis.Client) *LocationService {
 	return &LocationService{
-		redisClient: redisClient,
+		redisClient:         redisClient,
+		history:             LoadHistoryPolicy(),
+		defaultAvailability: loadDefaultAvailability(),
 	}
 }
 
+// locationTTL is how long a location is kept after its last update.
+func locationTTL() time.Duration {
+	ttl, err := strconv.Atoi(TIMETOLIVE)
+	if err != nil {
+		ttl = 3600
+	}
+	return time.Duration(ttl) * time.Second
+}
+
 // getGeoKey returns the appropriate geo key based on user role
 func (s *LocationService) getGeoKey(role string) string {
 	if role == "driver" {
@@ -42,19 +60,42 @@ func (s *LocationService) SetCurrentLocation(ctx context.Context, location *Curr
 		return fmt.Errorf("failed to marshal location: %w", err)
 	}
 
-	ttl, err := strconv.Atoi(TIMETOLIVE)
+	// Every update is also kept in the user's history stream.
+	_, err = s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		member := strconv.Itoa(location.UserID)
+		pipe.Set(ctx, member, data, locationTTL())
+		pipe.ZAdd(ctx, LastSeenKey, redis.Z{Score: float64(time.Now().Unix()), Member: member})
+		s.appendHistory(ctx, pipe, location)
+		return nil
+	})
 	if err != nil {
-		ttl = 3600
-	}
-
-	if err := s.redisClient.Set(ctx, strconv.Itoa(location.UserID), data, time.Duration(ttl)*time.Second).Err(); err != nil {
 		return fmt.Errorf("failed to set location in Redis: %w", err)
 	}
 
+	return s.indexLocation(ctx, location)
+}
+
+// indexLocation puts the user in the geo index for their role. Drivers are
+// only indexed while online, so matching never sees a driver who is offline
+// or already on a trip.
+func (s *LocationService) indexLocation(ctx context.Context, location *CurrentLocation) error {
+	member := strconv.Itoa(location.UserID)
 	// Use separate geo keys for drivers and passengers
 	geoKey := s.getGeoKey(location.Role)
+	if geoKey == GeoKeyDrivers {
+		available, err := s.isAvailable(ctx, location.UserID)
+		if err != nil {
+			return err
+		}
+		if !available {
+			if err := s.redisClient.ZRem(ctx, geoKey, member).Err(); err != nil {
+				return fmt.Errorf("failed to remove location from geo index: %w", err)
+			}
+			return nil
+		}
+	}
 	if err := s.redisClient.GeoAdd(ctx, geoKey, &redis.GeoLocation{
-		Name:      strconv.Itoa(location.UserID),
+		Name:      member,
 		Longitude: location.Longitude,
 		Latitude:  location.Latitude,
 	}).Err(); err != nil {
@@ -64,6 +105,15 @@ func (s *LocationService) SetCurrentLocation(ctx context.Context, location *Curr
 	return nil
 }
 
+// isAvailable reports whether the driver is online and can be offered a trip.
+func (s *LocationService) isAvailable(ctx context.Context, driverID int) (bool, error) {
+	availability, err := s.readAvailability(ctx, s.redisClient, driverID)
+	if err != nil {
+		return false, err
+	}
+	return availability.Status == AvailabilityOnline, nil
+}
+
 func (s *LocationService) GetCurrentLocation(ctx context.Context, userID int) (*CurrentLocation, error) {
 	data, err := s.redisClient.Get(ctx, strconv.Itoa(userID)).Result()
 	if err != nil {
@@ -72,6 +122,10 @@ func (s *LocationService) GetCurrentLocation(ctx context.Context, userID int) (*
 		}
 		return nil, fmt.Errorf("failed to get location from Redis: %w", err)
 	}
+	return decodeLocation(data)
+}
+
+func decodeLocation(data string) (*CurrentLocation, error) {
 	var location CurrentLocation
 	if err := json.Unmarshal([]byte(data), &location); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal location data: %w", err)
@@ -79,6 +133,37 @@ func (s *LocationService) GetCurrentLocation(ctx context.Context, userID int) (*
 	return &location, nil
 }
 
+// getLocations reads the locations of many users with a single MGET. Users
+// without a location are left out.
+func (s *LocationService) getLocations(ctx context.Context, members []string) (map[string]*CurrentLocation, error) {
+	if len(members) == 0 {
+		return map[string]*CurrentLocation{}, nil
+	}
+	values, err := s.redisClient.MGet(ctx, members...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locations from Redis: %w", err)
+	}
+	return decodeLocations(members, values), nil
+}
+
+// decodeLocations pairs the values an MGET of members returned with the
+// members, skipping missing and unreadable entries.
+func decodeLocations(members []string, values []interface{}) map[string]*CurrentLocation {
+	locations := make(map[string]*CurrentLocation, len(members))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		location, err := decodeLocation(data)
+		if err != nil {
+			continue
+		}
+		locations[members[i]] = location
+	}
+	return locations
+}
+
 func (s *LocationService) FindTopNearestUsers(ctx context.Context, userID int, topN int, radius float64) ([]*CurrentLocation, error) {
 	// Get the current user's position from passengers geo key
 	userPos, err := s.redisClient.GeoPos(ctx, GeoKeyPassengers, strconv.Itoa(userID)).Result()
@@ -108,24 +193,59 @@ func (s *LocationService) FindTopNearestUsers(ctx context.Context, userID int, t
 		return nil, fmt.Errorf("failed to search nearby drivers: %w", err)
 	}
 
-	// Convert GeoLocation results to CurrentLocation
-	locations := make([]*CurrentLocation, 0, len(results))
+	return s.withDetails(ctx, GeoKeyDrivers, results)
+}
+
+// withDetails returns the full location of each geo search result of geoKey,
+// in order and with its distance, in one round trip. Expired locations are
+// left out and so are drivers no longer online: the index follows
+// availability, but a transition can land between the search and here.
+func (s *LocationService) withDetails(ctx context.Context, geoKey string, results []redis.GeoLocation) ([]*CurrentLocation, error) {
+	members := make([]string, 0, len(results))
+	availabilityKeys := make([]string, 0, len(results))
+	distances := make([]float64, 0, len(results))
 	for _, geoLoc := range results {
-		tempGeoLoc, err := strconv.Atoi(geoLoc.Name)
+		userID, err := strconv.Atoi(geoLoc.Name)
 		if err != nil {
 			continue
 		}
-		// Get full location details from Redis
-		location, err := s.GetCurrentLocation(ctx, tempGeoLoc)
-		if err != nil {
-			// Skip if we can't get the location details
+		members = append(members, geoLoc.Name)
+		availabilityKeys = append(availabilityKeys, availabilityKey(userID))
+		distances = append(distances, geoLoc.Dist)
+	}
+	if len(members) == 0 {
+		return []*CurrentLocation{}, nil
+	}
+	checkOnline := geoKey == GeoKeyDrivers
+	var detailsCmd, availabilityCmd *redis.SliceCmd
+	_, err := s.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		detailsCmd = pipe.MGet(ctx, members...)
+		if checkOnline {
+			availabilityCmd = pipe.MGet(ctx, availabilityKeys...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nearby user details: %w", err)
+	}
+	details := decodeLocations(members, detailsCmd.Val())
+
+	// Convert GeoLocation results to CurrentLocation
+	locations := make([]*CurrentLocation, 0, len(members))
+	for i, member := range members {
+		location, ok := details[member]
+		if !ok {
+			// Location expired; the sweeper drops it from the index
 			continue
 		}
-		if location != nil {
-			// Add distance to the location
-			location.Distance = geoLoc.Dist
-			locations = append(locations, location)
+		if checkOnline {
+			if online, err := s.onlineStatus(availabilityCmd.Val()[i]); err != nil || !online {
+				continue
+			}
 		}
+		// Add distance to the location
+		location.Distance = distances[i]
+		locations = append(locations, location)
 	}
 
 	return locations, nil
@@ -144,24 +264,27 @@ func (s *LocationService) GetAllLocations(ctx context.Context) ([]*CurrentLocati
 			return nil, fmt.Errorf("failed to scan keys from Redis: %w", err)
 		}
 
-		// Process the keys in this batch
+		// Process the keys in this batch with a single MGET
+		members := make([]string, 0, len(keys))
 		for _, key := range keys {
 			// Skip geo index keys
-			if key == GeoKeyDrivers || key == GeoKeyPassengers {
+			if key == GeoKeyDrivers || key == GeoKeyPassengers || key == LastSeenKey {
 				continue
 			}
 
-			tempGeo, err := strconv.Atoi(key)
-			if err != nil {
+			if _, err := strconv.Atoi(key); err != nil {
 				// Skip non-numeric keys
 				continue
 			}
+			members = append(members, key)
+		}
 
-			location, err := s.GetCurrentLocation(ctx, tempGeo)
-			if err != nil {
-				continue
-			}
-			if location != nil {
+		batch, err := s.getLocations(ctx, members)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if location, ok := batch[member]; ok {
 				locations = append(locations, location)
 			}
 		}
@@ -191,6 +314,9 @@ func (s *LocationService) RemoveLocation(ctx context.Context, userID int, role s
 	if err := s.redisClient.ZRem(ctx, geoKey, userIDStr).Err(); err != nil {
 		return fmt.Errorf("failed to remove location from geo index: %w", err)
 	}
+	if err := s.redisClient.ZRem(ctx, LastSeenKey, userIDStr).Err(); err != nil {
+		return fmt.Errorf("failed to remove location from geo index: %w", err)
+	}
 
 	return nil
 }
@@ -215,28 +341,21 @@ func (s *LocationService) UpdateUserRole(ctx context.Context, userID int, oldRol
 	}
 
 	// Add to new geo index
-	newGeoKey := s.getGeoKey(newRole)
-	if err := s.redisClient.GeoAdd(ctx, newGeoKey, &redis.GeoLocation{
-		Name:      userIDStr,
-		Longitude: location.Longitude,
-		Latitude:  location.Latitude,
-	}).Err(); err != nil {
+	location.Role = newRole
+	if err := s.indexLocation(ctx, location); err != nil {
 		return fmt.Errorf("failed to add to new geo index: %w", err)
 	}
 
 	// Update the role in the location data
-	location.Role = newRole
 	data, err := json.Marshal(location)
 	if err != nil {
 		return fmt.Errorf("failed to marshal location: %w", err)
 	}
 
-	ttl, err := strconv.Atoi(TIMETOLIVE)
-	if err != nil {
-		ttl = 3600
+	if err := s.redisClient.Set(ctx, userIDStr, data, locationTTL()).Err(); err != nil {
+		return fmt.Errorf("failed to update location in Redis: %w", err)
 	}
-
-	if err := s.redisClient.Set(ctx, userIDStr, data, time.Duration(ttl)*time.Second).Err(); err != nil {
+	if err := s.redisClient.ZAdd(ctx, LastSeenKey, redis.Z{Score: float64(time.Now().Unix()), Member: userIDStr}).Err(); err != nil {
 		return fmt.Errorf("failed to update location in Redis: %w", err)
 	}
 
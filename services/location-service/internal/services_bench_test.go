@@ -0,0 +1,201 @@
+package location_service
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// benchRTT stands in for the network between location-service and Redis,
+// which an in-process miniredis doesn't have.
+const benchRTT = 100 * time.Microsecond
+
+// roundTripHook counts the round trips a client makes and delays each by
+// benchRTT. A pipeline is a single round trip.
+type roundTripHook struct {
+	count atomic.Int64
+}
+
+func (h *roundTripHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (h *roundTripHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.count.Add(1)
+		time.Sleep(benchRTT)
+		return next(ctx, cmd)
+	}
+}
+
+func (h *roundTripHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		h.count.Add(1)
+		time.Sleep(benchRTT)
+		return next(ctx, cmds)
+	}
+}
+
+// newBenchService returns a service on a fresh miniredis holding a passenger
+// and n online drivers around them.
+func newBenchService(b *testing.B, n int) (*LocationService, *roundTripHook) {
+	b.Helper()
+	mr := miniredis.RunT(b)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	b.Cleanup(func() { client.Close() })
+	s := NewLocationService(client)
+	ctx := context.Background()
+
+	if err := s.SetCurrentLocation(ctx, &CurrentLocation{UserID: 1, Role: "passenger", Latitude: 10.77, Longitude: 106.70}); err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		driverID := 1000 + i
+		availability, _ := json.Marshal(DriverAvailability{DriverID: driverID, Status: AvailabilityOnline})
+		if err := client.Set(ctx, availabilityKey(driverID), availability, 0).Err(); err != nil {
+			b.Fatal(err)
+		}
+		if err := s.SetCurrentLocation(ctx, &CurrentLocation{
+			UserID:    driverID,
+			Role:      "driver",
+			Latitude:  10.77 + float64(i%50)*0.001,
+			Longitude: 106.70 + float64(i/50)*0.001,
+		}); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	hook := &roundTripHook{}
+	client.AddHook(hook)
+	return s, hook
+}
+
+func reportRoundTrips(b *testing.B, hook *roundTripHook) {
+	b.ReportMetric(float64(hook.count.Load())/float64(b.N), "roundtrips/op")
+}
+
+// findNearestPerKey is FindTopNearestUsers as it was before its lookups were
+// pipelined: one GET for the location and one for the availability of each
+// result.
+func (s *LocationService) findNearestPerKey(ctx context.Context, userID int, topN int, radius float64) ([]*CurrentLocation, error) {
+	userPos, err := s.redisClient.GeoPos(ctx, GeoKeyPassengers, strconv.Itoa(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.redisClient.GeoRadius(ctx, GeoKeyDrivers, userPos[0].Longitude, userPos[0].Latitude, &redis.GeoRadiusQuery{
+		Radius: radius, Unit: "km", WithCoord: true, WithDist: true, Count: topN, Sort: "ASC",
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	locations := make([]*CurrentLocation, 0, len(results))
+	for _, geoLoc := range results {
+		id, err := strconv.Atoi(geoLoc.Name)
+		if err != nil {
+			continue
+		}
+		location, err := s.GetCurrentLocation(ctx, id)
+		if err != nil || location == nil {
+			continue
+		}
+		if available, err := s.isAvailable(ctx, id); err != nil || !available {
+			continue
+		}
+		location.Distance = geoLoc.Dist
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
+
+func BenchmarkFindTopNearestUsers(b *testing.B) {
+	const drivers, topN = 200, 50
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		find func(s *LocationService) ([]*CurrentLocation, error)
+	}{
+		{"pipelined", func(s *LocationService) ([]*CurrentLocation, error) {
+			return s.FindTopNearestUsers(ctx, 1, topN, 50)
+		}},
+		{"per_key", func(s *LocationService) ([]*CurrentLocation, error) {
+			return s.findNearestPerKey(ctx, 1, topN, 50)
+		}},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			s, hook := newBenchService(b, drivers)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				locations, err := c.find(s)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(locations) != topN {
+					b.Fatalf("found %d drivers, want %d", len(locations), topN)
+				}
+			}
+			reportRoundTrips(b, hook)
+		})
+	}
+}
+
+// getAllPerKey is GetAllLocations as it was before: one GET per scanned key.
+func (s *LocationService) getAllPerKey(ctx context.Context) ([]*CurrentLocation, error) {
+	var cursor uint64
+	locations := make([]*CurrentLocation, 0)
+	for {
+		keys, next, err := s.redisClient.Scan(ctx, cursor, "*", 100).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			id, err := strconv.Atoi(key)
+			if err != nil {
+				continue
+			}
+			if location, err := s.GetCurrentLocation(ctx, id); err == nil && location != nil {
+				locations = append(locations, location)
+			}
+		}
+		if cursor = next; cursor == 0 {
+			return locations, nil
+		}
+	}
+}
+
+func BenchmarkGetAllLocations(b *testing.B) {
+	const drivers = 500
+	ctx := context.Background()
+	cases := []struct {
+		name   string
+		getAll func(s *LocationService) ([]*CurrentLocation, error)
+	}{
+		{"mget", func(s *LocationService) ([]*CurrentLocation, error) { return s.GetAllLocations(ctx) }},
+		{"per_key", func(s *LocationService) ([]*CurrentLocation, error) { return s.getAllPerKey(ctx) }},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			s, hook := newBenchService(b, drivers)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				locations, err := c.getAll(s)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(locations) != drivers+1 {
+					b.Fatalf("got %d locations, want %d", len(locations), drivers+1)
+				}
+			}
+			reportRoundTrips(b, hook)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package location_service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/telemetry"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultSweepInterval = time.Minute
+	// sweepBatchSize is how many stale members a sweep checks per ZRANGE.
+	sweepBatchSize = 500
+)
+
+var (
+	sweepMetricsOnce sync.Once
+	geoEvicted       metric.Int64Counter
+)
+
+// initSweepMetrics creates the sweeper's counters once metrics are set up.
+func initSweepMetrics() {
+	sweepMetricsOnce.Do(func() {
+		if telemetry.Meter == nil {
+			// Metrics not initialized - skip
+			return
+		}
+		var err error
+		geoEvicted, err = telemetry.Meter.Int64Counter(
+			"location.geo_index.evicted",
+			metric.WithDescription("Geo index members removed because their location expired"),
+			metric.WithUnit("{member}"),
+		)
+		if err != nil {
+			logger.Error("Failed to create geo index eviction counter", "error", err)
+		}
+	})
+}
+
+// SweepInterval reads LOCATION_SWEEP_INTERVAL, how often stale members are
+// removed from the geo indexes.
+func SweepInterval() time.Duration {
+	d, err := time.ParseDuration(env.Get("LOCATION_SWEEP_INTERVAL", ""))
+	if err != nil || d <= 0 {
+		return defaultSweepInterval
+	}
+	return d
+}
+
+// RunSweeper removes members whose location expired from the geo indexes
+// every interval until ctx is cancelled.
+func (s *LocationService) RunSweeper(ctx context.Context, interval time.Duration) {
+	initSweepMetrics()
+	if err := s.seedLastSeen(ctx); err != nil {
+		logger.Error("Failed to seed geo index last seen times", "error", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			evicted, err := s.SweepStaleMembers(ctx, time.Now())
+			if err != nil {
+				logger.Error("Failed to sweep geo indexes", "error", err)
+			}
+			for geoKey, n := range evicted {
+				if geoEvicted != nil {
+					geoEvicted.Add(ctx, n, metric.WithAttributes(attribute.String("index", geoKey)))
+				}
+				logger.Info("Evicted stale geo index members", "index", geoKey, "evicted", n)
+			}
+		}
+	}
+}
+
+// seedLastSeen gives geo index members indexed before last seen times were
+// kept a time of zero, so the first sweep checks them.
+func (s *LocationService) seedLastSeen(ctx context.Context) error {
+	for _, geoKey := range []string{GeoKeyDrivers, GeoKeyPassengers} {
+		var cursor uint64
+		for {
+			page, next, err := s.redisClient.ZScan(ctx, geoKey, cursor, "*", sweepBatchSize).Result()
+			if err != nil {
+				return fmt.Errorf("failed to scan %s: %w", geoKey, err)
+			}
+			// ZSCAN returns members and scores interleaved.
+			members := make([]redis.Z, 0, len(page)/2)
+			for i := 0; i < len(page); i += 2 {
+				members = append(members, redis.Z{Score: 0, Member: page[i]})
+			}
+			if len(members) > 0 {
+				if err := s.redisClient.ZAddNX(ctx, LastSeenKey, members...).Err(); err != nil {
+					return fmt.Errorf("failed to seed last seen times: %w", err)
+				}
+			}
+			cursor = next
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// SweepStaleMembers removes the members not seen for longer than the location
+// TTL whose location has expired, and returns how many it removed from each
+// geo index.
+func (s *LocationService) SweepStaleMembers(ctx context.Context, now time.Time) (map[string]int64, error) {
+	evicted := make(map[string]int64)
+	cutoff := strconv.FormatInt(now.Add(-locationTTL()).Unix(), 10)
+	for {
+		members, err := s.redisClient.ZRangeByScore(ctx, LastSeenKey, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   cutoff,
+			Count: sweepBatchSize,
+		}).Result()
+		if err != nil {
+			return evicted, fmt.Errorf("failed to read last seen times: %w", err)
+		}
+		// Every member checked leaves the range, either removed or seen again
+		// now, so the next page starts where this one ended.
+		for _, member := range members {
+			if err := s.sweepMember(ctx, member, now, evicted); err != nil {
+				return evicted, err
+			}
+		}
+		if len(members) < sweepBatchSize {
+			return evicted, nil
+		}
+	}
+}
+
+// sweepMember removes member from the geo indexes unless their location is
+// still there. The location key is watched, so a SetLocation racing the sweep
+// wins and the member stays.
+func (s *LocationService) sweepMember(ctx context.Context, member string, now time.Time, evicted map[string]int64) error {
+	txf := func(tx *redis.Tx) error {
+		exists, err := tx.Exists(ctx, member).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check location of %s: %w", member, err)
+		}
+		if exists > 0 {
+			// The location was kept alive without going through SetLocation;
+			// look at it again a TTL from now.
+			return tx.ZAdd(ctx, LastSeenKey, redis.Z{Score: float64(now.Unix()), Member: member}).Err()
+		}
+
+		var drivers, passengers *redis.IntCmd
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			drivers = pipe.ZRem(ctx, GeoKeyDrivers, member)
+			passengers = pipe.ZRem(ctx, GeoKeyPassengers, member)
+			pipe.ZRem(ctx, LastSeenKey, member)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to evict %s from geo indexes: %w", member, err)
+		}
+		if n := drivers.Val(); n > 0 {
+			evicted[GeoKeyDrivers] += n
+		}
+		if n := passengers.Val(); n > 0 {
+			evicted[GeoKeyPassengers] += n
+		}
+		return nil
+	}
+
+	err := s.redisClient.Watch(ctx, txf, member)
+	if err == redis.TxFailedErr {
+		// The user sent a location meanwhile and is no longer stale.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package location_service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// newTestService returns a service on a fresh miniredis.
+func newTestService(t *testing.T) (*LocationService, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return NewLocationService(client), mr
+}
+
+// setLocation records a location for the user, putting drivers online first
+// so they are indexed.
+func setLocation(t *testing.T, s *LocationService, userID int, role string, latitude, longitude float64) {
+	t.Helper()
+	ctx := context.Background()
+	if role == "driver" {
+		if _, err := s.GoOnline(ctx, userID); err != nil {
+			t.Fatalf("GoOnline(%d): %v", userID, err)
+		}
+	}
+	if err := s.SetCurrentLocation(ctx, &CurrentLocation{UserID: userID, Role: role, Latitude: latitude, Longitude: longitude}); err != nil {
+		t.Fatalf("SetCurrentLocation(%d): %v", userID, err)
+	}
+}
+
+func inIndex(t *testing.T, s *LocationService, geoKey string, userID int) bool {
+	t.Helper()
+	_, err := s.redisClient.ZScore(context.Background(), geoKey, strconv.Itoa(userID)).Result()
+	if err != nil && err != redis.Nil {
+		t.Fatal(err)
+	}
+	return err == nil
+}
+
+func TestSweepEvictsExpiredMembers(t *testing.T) {
+	s, mr := newTestService(t)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.77, 106.70)
+	setLocation(t, s, 2, "driver", 10.78, 106.70)
+	setLocation(t, s, 3, "passenger", 10.77, 106.71)
+
+	mr.FastForward(locationTTL() + time.Second)
+	evicted, err := s.SweepStaleMembers(ctx, time.Now().Add(locationTTL()+time.Second))
+	if err != nil {
+		t.Fatalf("SweepStaleMembers: %v", err)
+	}
+	if evicted[GeoKeyDrivers] != 2 || evicted[GeoKeyPassengers] != 1 {
+		t.Errorf("evicted = %v, want 2 drivers and 1 passenger", evicted)
+	}
+	for _, id := range []int{1, 2} {
+		if inIndex(t, s, GeoKeyDrivers, id) {
+			t.Errorf("driver %d still indexed", id)
+		}
+	}
+	if inIndex(t, s, GeoKeyPassengers, 3) {
+		t.Error("passenger 3 still indexed")
+	}
+	if inIndex(t, s, LastSeenKey, 1) {
+		t.Error("evicted member kept a last seen time")
+	}
+}
+
+func TestSweepRescoresLiveMembers(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	setLocation(t, s, 1, "driver", 10.77, 106.70)
+
+	// The last seen time is a TTL old but the location key hasn't expired.
+	now := time.Now().Add(locationTTL() + time.Second)
+	evicted, err := s.SweepStaleMembers(ctx, now)
+	if err != nil {
+		t.Fatalf("SweepStaleMembers: %v", err)
+	}
+	if len(evicted) != 0 {
+		t.Errorf("evicted = %v, want none", evicted)
+	}
+	if !inIndex(t, s, GeoKeyDrivers, 1) {
+		t.Error("live driver removed from the index")
+	}
+	score, err := s.redisClient.ZScore(ctx, LastSeenKey, "1").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(score) != now.Unix() {
+		t.Errorf("last seen = %v, want %d", score, now.Unix())
+	}
+}
+
+func TestSweepSeededMembersOnFirstPass(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	// Indexed before last seen times were kept: no location key and no score.
+	if err := s.redisClient.GeoAdd(ctx, GeoKeyPassengers,
+		&redis.GeoLocation{Name: "7", Latitude: 10.77, Longitude: 106.70},
+		&redis.GeoLocation{Name: "8", Latitude: 10.78, Longitude: 106.70},
+	).Err(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.redisClient.Set(ctx, "8", `{"user_id":8,"role":"passenger"}`, locationTTL()).Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.seedLastSeen(ctx); err != nil {
+		t.Fatalf("seedLastSeen: %v", err)
+	}
+	evicted, err := s.SweepStaleMembers(ctx, time.Now())
+	if err != nil {
+		t.Fatalf("SweepStaleMembers: %v", err)
+	}
+	if evicted[GeoKeyPassengers] != 1 {
+		t.Errorf("evicted = %v, want 1 passenger", evicted)
+	}
+	if inIndex(t, s, GeoKeyPassengers, 7) {
+		t.Error("seeded member without a location still indexed")
+	}
+	if !inIndex(t, s, GeoKeyPassengers, 8) {
+		t.Error("seeded member with a location removed")
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/Azure/go-amqp"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 )
 
-// LogMessage represents the structure of messages received from RabbitMQ
+// LogMessage is the pre-envelope message format. It is still accepted so that
+// messages queued before publishers moved to events.Envelope are not lost.
 type LogMessage struct {
 	Name string `json:"name"`
 	Data string `json:"data"`
@@ -92,8 +94,7 @@ func (app *Config) ConsumeFromRabbitMQ(conn *amqp.Conn) error {
 		consecutiveErrors = 0
 
 		// Process the message
-		var logMsg LogMessage
-		err = json.Unmarshal(msg.GetData(), &logMsg)
+		logEntry, err := decodeLogEntry(msg.GetData())
 		if err != nil {
 			logger.Error("Failed to unmarshal log message", "error", err)
 			// Reject the message so it can be redelivered or dead-lettered
@@ -104,17 +105,11 @@ func (app *Config) ConsumeFromRabbitMQ(conn *amqp.Conn) error {
 		}
 
 		logger.Info("Received log message from RabbitMQ",
-			"name", logMsg.Name,
-			"data", logMsg.Data)
+			"name", logEntry.Name,
+			"event_id", logEntry.EventID,
+			"source", logEntry.Source)
 
 		// Write to MongoDB
-		logEntry := data.LogEntry{
-			Name:      logMsg.Name,
-			Data:      logMsg.Data,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		}
-
 		err = app.Models.LogEntry.Insert(logEntry)
 
 		if err != nil {
@@ -124,7 +119,7 @@ func (app *Config) ConsumeFromRabbitMQ(conn *amqp.Conn) error {
 				logger.Error("Failed to reject message", "error", err)
 			}
 		} else {
-			logger.Info("Successfully wrote log to MongoDB", "name", logMsg.Name)
+			logger.Info("Successfully wrote log to MongoDB", "name", logEntry.Name)
 
 			if err := receiver.AcceptMessage(ctx, msg); err != nil {
 				logger.Error("Failed to accept message", "error", err)
@@ -132,3 +127,32 @@ func (app *Config) ConsumeFromRabbitMQ(conn *amqp.Conn) error {
 		}
 	}
 }
+
+// decodeLogEntry converts a message body into a log entry. Bodies are expected
+// to be events.Envelope; the legacy LogMessage format is accepted with a warning.
+func decodeLogEntry(body []byte) (data.LogEntry, error) {
+	envelope, err := events.Decode(body)
+	if err == nil {
+		return data.LogEntry{
+			Name:       envelope.Type,
+			Data:       string(envelope.Payload),
+			EventID:    envelope.ID,
+			Version:    envelope.Version,
+			Source:     envelope.Source,
+			OccurredAt: envelope.OccurredAt,
+			Actor:      &envelope.Actor,
+			Subject:    &envelope.Subject,
+			Trace:      &envelope.Trace,
+		}, nil
+	}
+
+	var logMsg LogMessage
+	if jsonErr := json.Unmarshal(body, &logMsg); jsonErr != nil || logMsg.Name == "" {
+		return data.LogEntry{}, err
+	}
+	logger.Warn("Received legacy log message without event envelope", "name", logMsg.Name)
+	return data.LogEntry{
+		Name: logMsg.Name,
+		Data: logMsg.Data,
+	}, nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -23,23 +24,42 @@ type Models struct {
 	LogEntry LogEntry
 }
 
+// LogEntry is a stored log line. Entries written from event envelopes also
+// keep the envelope metadata; Name is the event type and Data its JSON payload.
 type LogEntry struct {
-	ID        string    `bson:"_id,omitempty" json:"id,omitempty"`
-	Name      string    `bson:"name" json:"name"`
-	Data      string    `bson:"data" json:"data"`
-	CreatedAt time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+	ID         string               `bson:"_id,omitempty" json:"id,omitempty"`
+	Name       string               `bson:"name" json:"name"`
+	Data       string               `bson:"data" json:"data"`
+	EventID    string               `bson:"event_id,omitempty" json:"event_id,omitempty"`
+	Version    int                  `bson:"version,omitempty" json:"version,omitempty"`
+	Source     string               `bson:"source,omitempty" json:"source,omitempty"`
+	OccurredAt time.Time            `bson:"occurred_at,omitempty" json:"occurred_at,omitempty"`
+	Actor      *events.Actor        `bson:"actor,omitempty" json:"actor,omitempty"`
+	Subject    *events.Subject      `bson:"subject,omitempty" json:"subject,omitempty"`
+	Trace      *events.TraceContext `bson:"trace,omitempty" json:"trace,omitempty"`
+	CreatedAt  time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time            `bson:"updated_at" json:"updated_at"`
 }
 
+// Insert stores entry. Entries with an EventID are upserted on it, so an
+// event redelivered by RabbitMQ is only stored once.
 func (l *LogEntry) Insert(entry LogEntry) error {
 	collection := client.Database("logs").Collection("logs")
 
-	_, err := collection.InsertOne(context.TODO(), LogEntry{
-		Name:      entry.Name,
-		Data:      entry.Data,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	})
+	entry.ID = ""
+	entry.CreatedAt = time.Now()
+	entry.UpdatedAt = entry.CreatedAt
+
+	var err error
+	if entry.EventID == "" {
+		_, err = collection.InsertOne(context.TODO(), entry)
+	} else {
+		_, err = collection.UpdateOne(context.TODO(),
+			bson.M{"event_id": entry.EventID},
+			bson.M{"$setOnInsert": entry},
+			options.Update().SetUpsert(true),
+		)
+	}
 	if err != nil {
 		log.Println("Error inserting into logs:", err)
 		return err
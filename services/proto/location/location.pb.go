@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.36.10
 // 	protoc        v5.29.3
 // source: location/location.proto
 
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -22,27 +23,24 @@ const (
 
 // Location represents a user's geographical location
 type Location struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Distance      float64                `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"` // Distance from reference point (in km)
+	Speed         float64                `protobuf:"fixed64,6,opt,name=speed,proto3" json:"speed,omitempty"`       // Speed in km/h
+	Heading       string                 `protobuf:"bytes,7,opt,name=heading,proto3" json:"heading,omitempty"`     // Direction (N, NE, E, SE, S, SW, W, NW)
+	Timestamp     string                 `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ISO 8601 timestamp
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int32   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role      string  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Distance  float64 `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"` // Distance from reference point (in km)
-	Speed     float64 `protobuf:"fixed64,6,opt,name=speed,proto3" json:"speed,omitempty"`       // Speed in km/h
-	Heading   string  `protobuf:"bytes,7,opt,name=heading,proto3" json:"heading,omitempty"`     // Direction (N, NE, E, SE, S, SW, W, NW)
-	Timestamp string  `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ISO 8601 timestamp
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Location) Reset() {
 	*x = Location{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Location) String() string {
@@ -53,7 +51,7 @@ func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -126,26 +124,23 @@ func (x *Location) GetTimestamp() string {
 
 // SetLocationRequest contains the location data to set
 type SetLocationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         float64                `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Heading       string                 `protobuf:"bytes,6,opt,name=heading,proto3" json:"heading,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	UserId    int32   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role      string  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Speed     float64 `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
-	Heading   string  `protobuf:"bytes,6,opt,name=heading,proto3" json:"heading,omitempty"`
-	Timestamp string  `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetLocationRequest) Reset() {
 	*x = SetLocationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetLocationRequest) String() string {
@@ -156,7 +151,7 @@ func (*SetLocationRequest) ProtoMessage() {}
 
 func (x *SetLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -222,22 +217,19 @@ func (x *SetLocationRequest) GetTimestamp() string {
 
 // SetLocationResponse indicates success or failure
 type SetLocationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Location *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetLocationResponse) Reset() {
 	*x = SetLocationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SetLocationResponse) String() string {
@@ -248,7 +240,7 @@ func (*SetLocationResponse) ProtoMessage() {}
 
 func (x *SetLocationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -286,20 +278,17 @@ func (x *SetLocationResponse) GetLocation() *Location {
 
 // GetLocationRequest specifies which user's location to retrieve
 type GetLocationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetLocationRequest) Reset() {
 	*x = GetLocationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetLocationRequest) String() string {
@@ -310,7 +299,7 @@ func (*GetLocationRequest) ProtoMessage() {}
 
 func (x *GetLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -334,22 +323,19 @@ func (x *GetLocationRequest) GetUserId() int32 {
 
 // GetLocationResponse contains the user's location
 type GetLocationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success  bool      `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message  string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Location *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetLocationResponse) Reset() {
 	*x = GetLocationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetLocationResponse) String() string {
@@ -360,7 +346,7 @@ func (*GetLocationResponse) ProtoMessage() {}
 
 func (x *GetLocationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -398,23 +384,20 @@ func (x *GetLocationResponse) GetLocation() *Location {
 
 // FindNearestUsersRequest specifies search parameters
 type FindNearestUsersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	TopN          int32                  `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"` // Number of nearest users to return
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`        // Search radius in km (default: 10)
 	unknownFields protoimpl.UnknownFields
-
-	UserId int32   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role   string  `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	TopN   int32   `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"` // Number of nearest users to return
-	Radius float64 `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`        // Search radius in km (default: 10)
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FindNearestUsersRequest) Reset() {
 	*x = FindNearestUsersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FindNearestUsersRequest) String() string {
@@ -425,7 +408,7 @@ func (*FindNearestUsersRequest) ProtoMessage() {}
 
 func (x *FindNearestUsersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -470,22 +453,19 @@ func (x *FindNearestUsersRequest) GetRadius() float64 {
 
 // FindNearestUsersResponse contains the list of nearest users
 type FindNearestUsersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Locations     []*Location            `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success   bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Locations []*Location `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FindNearestUsersResponse) Reset() {
 	*x = FindNearestUsersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FindNearestUsersResponse) String() string {
@@ -496,7 +476,7 @@ func (*FindNearestUsersResponse) ProtoMessage() {}
 
 func (x *FindNearestUsersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_location_location_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -532,20 +512,233 @@ func (x *FindNearestUsersResponse) GetLocations() []*Location {
 	return nil
 }
 
+// BoundingBox is an area between two latitudes and two longitudes
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLat        float64                `protobuf:"fixed64,1,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MinLng        float64                `protobuf:"fixed64,2,opt,name=min_lng,json=minLng,proto3" json:"min_lng,omitempty"`
+	MaxLat        float64                `protobuf:"fixed64,3,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	MaxLng        float64                `protobuf:"fixed64,4,opt,name=max_lng,json=maxLng,proto3" json:"max_lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_location_location_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BoundingBox) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLng() float64 {
+	if x != nil {
+		return x.MinLng
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLng() float64 {
+	if x != nil {
+		return x.MaxLng
+	}
+	return 0
+}
+
+// SearchLocationsRequest searches around a point, or inside box when it is set
+type SearchLocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"` // Search radius in km (default: 10, at most 100)
+	Box           *BoundingBox           `protobuf:"bytes,4,opt,name=box,proto3" json:"box,omitempty"`         // E.g. a map viewport; must not cross the antimeridian
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`       // driver, passenger or empty for both
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`    // Defaults to 50, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLocationsRequest) Reset() {
+	*x = SearchLocationsRequest{}
+	mi := &file_location_location_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLocationsRequest) ProtoMessage() {}
+
+func (x *SearchLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLocationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchLocationsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchLocationsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchLocationsRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SearchLocationsRequest) GetBox() *BoundingBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *SearchLocationsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SearchLocationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SearchLocationsResponse lists the users found, nearest to the center first
+type SearchLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Locations     []*Location            `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchLocationsResponse) Reset() {
+	*x = SearchLocationsResponse{}
+	mi := &file_location_location_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLocationsResponse) ProtoMessage() {}
+
+func (x *SearchLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLocationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchLocationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SearchLocationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchLocationsResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
 // GetAllLocationsRequest is empty (retrieves all)
 type GetAllLocationsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllLocationsRequest) Reset() {
 	*x = GetAllLocationsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetAllLocationsRequest) String() string {
@@ -555,8 +748,8 @@ func (x *GetAllLocationsRequest) String() string {
 func (*GetAllLocationsRequest) ProtoMessage() {}
 
 func (x *GetAllLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_location_location_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_location_location_proto_msgTypes[10]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -568,28 +761,25 @@ func (x *GetAllLocationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllLocationsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_location_location_proto_rawDescGZIP(), []int{7}
+	return file_location_location_proto_rawDescGZIP(), []int{10}
 }
 
 // GetAllLocationsResponse contains all locations
 type GetAllLocationsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Locations     []*Location            `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success    bool        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Locations  []*Location `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
-	TotalCount int32       `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllLocationsResponse) Reset() {
 	*x = GetAllLocationsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_location_location_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_location_location_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetAllLocationsResponse) String() string {
@@ -599,8 +789,8 @@ func (x *GetAllLocationsResponse) String() string {
 func (*GetAllLocationsResponse) ProtoMessage() {}
 
 func (x *GetAllLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_location_location_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	mi := &file_location_location_proto_msgTypes[11]
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -612,7 +802,7 @@ func (x *GetAllLocationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllLocationsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_location_location_proto_rawDescGZIP(), []int{8}
+	return file_location_location_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAllLocationsResponse) GetSuccess() bool {
@@ -643,278 +833,1297 @@ func (x *GetAllLocationsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-var File_location_location_proto protoreflect.FileDescriptor
+// RemoveLocationRequest specifies whose location to delete
+type RemoveLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_location_location_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
-	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
-	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x79, 0x0a,
-	0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x5f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64,
-	0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xdc, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53,
-	0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x65,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x72,
-	0x2f, 0x55, 0x49, 0x54, 0x2d, 0x47, 0x6f, 0x2d, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RemoveLocationRequest) Reset() {
+	*x = RemoveLocationRequest{}
+	mi := &file_location_location_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_location_location_proto_rawDescOnce sync.Once
-	file_location_location_proto_rawDescData = file_location_location_proto_rawDesc
-)
+func (x *RemoveLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_location_location_proto_rawDescGZIP() []byte {
-	file_location_location_proto_rawDescOnce.Do(func() {
-		file_location_location_proto_rawDescData = protoimpl.X.CompressGZIP(file_location_location_proto_rawDescData)
-	})
-	return file_location_location_proto_rawDescData
+func (*RemoveLocationRequest) ProtoMessage() {}
+
+func (x *RemoveLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_location_location_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_location_location_proto_goTypes = []interface{}{
-	(*Location)(nil),                 // 0: location.Location
-	(*SetLocationRequest)(nil),       // 1: location.SetLocationRequest
-	(*SetLocationResponse)(nil),      // 2: location.SetLocationResponse
-	(*GetLocationRequest)(nil),       // 3: location.GetLocationRequest
-	(*GetLocationResponse)(nil),      // 4: location.GetLocationResponse
-	(*FindNearestUsersRequest)(nil),  // 5: location.FindNearestUsersRequest
-	(*FindNearestUsersResponse)(nil), // 6: location.FindNearestUsersResponse
-	(*GetAllLocationsRequest)(nil),   // 7: location.GetAllLocationsRequest
-	(*GetAllLocationsResponse)(nil),  // 8: location.GetAllLocationsResponse
+// Deprecated: Use RemoveLocationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLocationRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{12}
 }
-var file_location_location_proto_depIdxs = []int32{
-	0, // 0: location.SetLocationResponse.location:type_name -> location.Location
-	0, // 1: location.GetLocationResponse.location:type_name -> location.Location
-	0, // 2: location.FindNearestUsersResponse.locations:type_name -> location.Location
-	0, // 3: location.GetAllLocationsResponse.locations:type_name -> location.Location
-	1, // 4: location.LocationService.SetLocation:input_type -> location.SetLocationRequest
-	3, // 5: location.LocationService.GetLocation:input_type -> location.GetLocationRequest
-	5, // 6: location.LocationService.FindNearestUsers:input_type -> location.FindNearestUsersRequest
-	7, // 7: location.LocationService.GetAllLocations:input_type -> location.GetAllLocationsRequest
-	2, // 8: location.LocationService.SetLocation:output_type -> location.SetLocationResponse
-	4, // 9: location.LocationService.GetLocation:output_type -> location.GetLocationResponse
-	6, // 10: location.LocationService.FindNearestUsers:output_type -> location.FindNearestUsersResponse
-	8, // 11: location.LocationService.GetAllLocations:output_type -> location.GetAllLocationsResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+func (x *RemoveLocationRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func init() { file_location_location_proto_init() }
-func file_location_location_proto_init() {
-	if File_location_location_proto != nil {
-		return
+func (x *RemoveLocationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_location_location_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Location); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLocationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLocationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindNearestUsersRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindNearestUsersResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllLocationsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_location_location_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllLocationsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	return ""
+}
+
+type RemoveLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLocationResponse) Reset() {
+	*x = RemoveLocationResponse{}
+	mi := &file_location_location_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLocationResponse) ProtoMessage() {}
+
+func (x *RemoveLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLocationResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLocationResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveLocationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveLocationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateUserRoleRequest moves a user between the driver and passenger indexes
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldRole       string                 `protobuf:"bytes,2,opt,name=old_role,json=oldRole,proto3" json:"old_role,omitempty"`
+	NewRole       string                 `protobuf:"bytes,3,opt,name=new_role,json=newRole,proto3" json:"new_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_location_location_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateUserRoleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserRoleRequest) GetOldRole() string {
+	if x != nil {
+		return x.OldRole
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetNewRole() string {
+	if x != nil {
+		return x.NewRole
+	}
+	return ""
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_location_location_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateUserRoleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateUserRoleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// DriverAvailability is a driver's matching state: offline, online or on_trip
+type DriverAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	TripId        int32                  `protobuf:"varint,3,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`         // Set while on_trip
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO 8601 timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverAvailability) Reset() {
+	*x = DriverAvailability{}
+	mi := &file_location_location_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverAvailability) ProtoMessage() {}
+
+func (x *DriverAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverAvailability.ProtoReflect.Descriptor instead.
+func (*DriverAvailability) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DriverAvailability) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverAvailability) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DriverAvailability) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *DriverAvailability) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type DriverAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverAvailabilityRequest) Reset() {
+	*x = DriverAvailabilityRequest{}
+	mi := &file_location_location_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverAvailabilityRequest) ProtoMessage() {}
+
+func (x *DriverAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*DriverAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DriverAvailabilityRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type DriverTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverTripRequest) Reset() {
+	*x = DriverTripRequest{}
+	mi := &file_location_location_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTripRequest) ProtoMessage() {}
+
+func (x *DriverTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverTripRequest.ProtoReflect.Descriptor instead.
+func (*DriverTripRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DriverTripRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverTripRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type DriverAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Availability  *DriverAvailability    `protobuf:"bytes,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverAvailabilityResponse) Reset() {
+	*x = DriverAvailabilityResponse{}
+	mi := &file_location_location_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverAvailabilityResponse) ProtoMessage() {}
+
+func (x *DriverAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*DriverAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DriverAvailabilityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DriverAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DriverAvailabilityResponse) GetAvailability() *DriverAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// LocationPoint is one location update kept in a user's history
+type LocationPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RecordedAt    string                 `protobuf:"bytes,2,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"` // ISO 8601 timestamp of when location-service received it
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         float64                `protobuf:"fixed64,6,opt,name=speed,proto3" json:"speed,omitempty"`
+	Heading       string                 `protobuf:"bytes,7,opt,name=heading,proto3" json:"heading,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // ISO 8601 timestamp reported by the device
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationPoint) Reset() {
+	*x = LocationPoint{}
+	mi := &file_location_location_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationPoint) ProtoMessage() {}
+
+func (x *LocationPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationPoint.ProtoReflect.Descriptor instead.
+func (*LocationPoint) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LocationPoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LocationPoint) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+func (x *LocationPoint) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *LocationPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LocationPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *LocationPoint) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *LocationPoint) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *LocationPoint) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type GetLocationHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From            string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                               // ISO 8601; defaults to an hour before to
+	To              string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                                   // ISO 8601, exclusive; defaults to now
+	IntervalSeconds int32                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // Keep the first point of each interval; 0 keeps all
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                            // Defaults to 1000, at most 10000
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetLocationHistoryRequest) Reset() {
+	*x = GetLocationHistoryRequest{}
+	mi := &file_location_location_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationHistoryRequest) ProtoMessage() {}
+
+func (x *GetLocationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLocationHistoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLocationHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetLocationHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetLocationHistoryRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *GetLocationHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLocationHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Points        []*LocationPoint       `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	Truncated     bool                   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"` // More points matched than limit allowed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationHistoryResponse) Reset() {
+	*x = GetLocationHistoryResponse{}
+	mi := &file_location_location_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationHistoryResponse) ProtoMessage() {}
+
+func (x *GetLocationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLocationHistoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetLocationHistoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetLocationHistoryResponse) GetPoints() []*LocationPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetLocationHistoryResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// LocationBatch is a group of location updates applied together, in order.
+// Sequence must grow within a stream; a batch not above the last acknowledged
+// one is taken as a resend and skipped.
+type LocationBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Locations     []*SetLocationRequest  `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"` // At most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationBatch) Reset() {
+	*x = LocationBatch{}
+	mi := &file_location_location_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationBatch) ProtoMessage() {}
+
+func (x *LocationBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationBatch.ProtoReflect.Descriptor instead.
+func (*LocationBatch) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LocationBatch) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LocationBatch) GetLocations() []*SetLocationRequest {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+// LocationAck acknowledges every batch up to sequence. The counts cover the
+// whole stream so far.
+type LocationAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Applied       int32                  `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Rejected      int32                  `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`     // Updates with an invalid user, role or coordinates
+	Duplicates    int32                  `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // Batches skipped as resends
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationAck) Reset() {
+	*x = LocationAck{}
+	mi := &file_location_location_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationAck) ProtoMessage() {}
+
+func (x *LocationAck) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationAck.ProtoReflect.Descriptor instead.
+func (*LocationAck) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LocationAck) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LocationAck) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *LocationAck) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *LocationAck) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type GetHeatmapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Box           *BoundingBox           `protobuf:"bytes,1,opt,name=box,proto3" json:"box,omitempty"`
+	Precision     int32                  `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"` // Geohash length, 1 to 9 (default: 6, cells of about 1.2 x 0.6 km)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHeatmapRequest) Reset() {
+	*x = GetHeatmapRequest{}
+	mi := &file_location_location_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeatmapRequest) ProtoMessage() {}
+
+func (x *GetHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*GetHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetHeatmapRequest) GetBox() *BoundingBox {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *GetHeatmapRequest) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+// HeatmapCell counts the users in one geohash cell; latitude/longitude is its center
+type HeatmapCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geohash       string                 `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Drivers       int32                  `protobuf:"varint,4,opt,name=drivers,proto3" json:"drivers,omitempty"` // Online drivers only
+	Passengers    int32                  `protobuf:"varint,5,opt,name=passengers,proto3" json:"passengers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	mi := &file_location_location_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HeatmapCell) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+func (x *HeatmapCell) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetDrivers() int32 {
+	if x != nil {
+		return x.Drivers
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetPassengers() int32 {
+	if x != nil {
+		return x.Passengers
+	}
+	return 0
+}
+
+type GetHeatmapResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cells           []*HeatmapCell         `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"` // Ordered by geohash
+	TotalDrivers    int32                  `protobuf:"varint,4,opt,name=total_drivers,json=totalDrivers,proto3" json:"total_drivers,omitempty"`
+	TotalPassengers int32                  `protobuf:"varint,5,opt,name=total_passengers,json=totalPassengers,proto3" json:"total_passengers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetHeatmapResponse) Reset() {
+	*x = GetHeatmapResponse{}
+	mi := &file_location_location_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHeatmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHeatmapResponse) ProtoMessage() {}
+
+func (x *GetHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_location_location_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*GetHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_location_location_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetHeatmapResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetHeatmapResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHeatmapResponse) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *GetHeatmapResponse) GetTotalDrivers() int32 {
+	if x != nil {
+		return x.TotalDrivers
+	}
+	return 0
+}
+
+func (x *GetHeatmapResponse) GetTotalPassengers() int32 {
+	if x != nil {
+		return x.TotalPassengers
+	}
+	return 0
+}
+
+var File_location_location_proto protoreflect.FileDescriptor
+
+const file_location_location_proto_rawDesc = "" +
+	"\n" +
+	"\x17location/location.proto\x12\blocation\"\xdb\x01\n" +
+	"\bLocation\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x01R\bdistance\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\a \x01(\tR\aheading\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\"\xc9\x01\n" +
+	"\x12SetLocationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05speed\x18\x05 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\x06 \x01(\tR\aheading\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\"y\n" +
+	"\x13SetLocationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.location.LocationR\blocation\"-\n" +
+	"\x12GetLocationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"y\n" +
+	"\x13GetLocationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.location.LocationR\blocation\"s\n" +
+	"\x17FindNearestUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x13\n" +
+	"\x05top_n\x18\x03 \x01(\x05R\x04topN\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\"\x80\x01\n" +
+	"\x18FindNearestUsersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\tlocations\x18\x03 \x03(\v2\x12.location.LocationR\tlocations\"q\n" +
+	"\vBoundingBox\x12\x17\n" +
+	"\amin_lat\x18\x01 \x01(\x01R\x06minLat\x12\x17\n" +
+	"\amin_lng\x18\x02 \x01(\x01R\x06minLng\x12\x17\n" +
+	"\amax_lat\x18\x03 \x01(\x01R\x06maxLat\x12\x17\n" +
+	"\amax_lng\x18\x04 \x01(\x01R\x06maxLng\"\xbd\x01\n" +
+	"\x16SearchLocationsRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12'\n" +
+	"\x03box\x18\x04 \x01(\v2\x15.location.BoundingBoxR\x03box\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x7f\n" +
+	"\x17SearchLocationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\tlocations\x18\x03 \x03(\v2\x12.location.LocationR\tlocations\"\x18\n" +
+	"\x16GetAllLocationsRequest\"\xa0\x01\n" +
+	"\x17GetAllLocationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\tlocations\x18\x03 \x03(\v2\x12.location.LocationR\tlocations\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"D\n" +
+	"\x15RemoveLocationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"L\n" +
+	"\x16RemoveLocationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"f\n" +
+	"\x15UpdateUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bold_role\x18\x02 \x01(\tR\aoldRole\x12\x19\n" +
+	"\bnew_role\x18\x03 \x01(\tR\anewRole\"|\n" +
+	"\x16UpdateUserRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\blocation\x18\x03 \x01(\v2\x12.location.LocationR\blocation\"\x81\x01\n" +
+	"\x12DriverAvailability\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
+	"\atrip_id\x18\x03 \x01(\x05R\x06tripId\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"8\n" +
+	"\x19DriverAvailabilityRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\"I\n" +
+	"\x11DriverTripRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\"\x92\x01\n" +
+	"\x1aDriverAvailabilityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12@\n" +
+	"\favailability\x18\x03 \x01(\v2\x1c.location.DriverAvailabilityR\favailability\"\xdc\x01\n" +
+	"\rLocationPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vrecorded_at\x18\x02 \x01(\tR\n" +
+	"recordedAt\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\a \x01(\tR\aheading\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\tR\ttimestamp\"\x99\x01\n" +
+	"\x19GetLocationHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x05R\x0fintervalSeconds\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x9f\x01\n" +
+	"\x1aGetLocationHistoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x06points\x18\x03 \x03(\v2\x17.location.LocationPointR\x06points\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\"g\n" +
+	"\rLocationBatch\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12:\n" +
+	"\tlocations\x18\x02 \x03(\v2\x1c.location.SetLocationRequestR\tlocations\"\x7f\n" +
+	"\vLocationAck\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\x05R\aapplied\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x05R\brejected\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x04 \x01(\x05R\n" +
+	"duplicates\"Z\n" +
+	"\x11GetHeatmapRequest\x12'\n" +
+	"\x03box\x18\x01 \x01(\v2\x15.location.BoundingBoxR\x03box\x12\x1c\n" +
+	"\tprecision\x18\x02 \x01(\x05R\tprecision\"\x9b\x01\n" +
+	"\vHeatmapCell\x12\x18\n" +
+	"\ageohash\x18\x01 \x01(\tR\ageohash\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\adrivers\x18\x04 \x01(\x05R\adrivers\x12\x1e\n" +
+	"\n" +
+	"passengers\x18\x05 \x01(\x05R\n" +
+	"passengers\"\xc5\x01\n" +
+	"\x12GetHeatmapResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x05cells\x18\x03 \x03(\v2\x15.location.HeatmapCellR\x05cells\x12#\n" +
+	"\rtotal_drivers\x18\x04 \x01(\x05R\ftotalDrivers\x12)\n" +
+	"\x10total_passengers\x18\x05 \x01(\x05R\x0ftotalPassengers2\x8d\n" +
+	"\n" +
+	"\x0fLocationService\x12J\n" +
+	"\vSetLocation\x12\x1c.location.SetLocationRequest\x1a\x1d.location.SetLocationResponse\x12J\n" +
+	"\vGetLocation\x12\x1c.location.GetLocationRequest\x1a\x1d.location.GetLocationResponse\x12Y\n" +
+	"\x10FindNearestUsers\x12!.location.FindNearestUsersRequest\x1a\".location.FindNearestUsersResponse\x12V\n" +
+	"\x0fGetAllLocations\x12 .location.GetAllLocationsRequest\x1a!.location.GetAllLocationsResponse\x12S\n" +
+	"\x0eRemoveLocation\x12\x1f.location.RemoveLocationRequest\x1a .location.RemoveLocationResponse\x12S\n" +
+	"\x0eUpdateUserRole\x12\x1f.location.UpdateUserRoleRequest\x1a .location.UpdateUserRoleResponse\x12U\n" +
+	"\bGoOnline\x12#.location.DriverAvailabilityRequest\x1a$.location.DriverAvailabilityResponse\x12V\n" +
+	"\tGoOffline\x12#.location.DriverAvailabilityRequest\x1a$.location.DriverAvailabilityResponse\x12U\n" +
+	"\x10MarkDriverOnTrip\x12\x1b.location.DriverTripRequest\x1a$.location.DriverAvailabilityResponse\x12R\n" +
+	"\rReleaseDriver\x12\x1b.location.DriverTripRequest\x1a$.location.DriverAvailabilityResponse\x12b\n" +
+	"\x15GetDriverAvailability\x12#.location.DriverAvailabilityRequest\x1a$.location.DriverAvailabilityResponse\x12_\n" +
+	"\x12GetLocationHistory\x12#.location.GetLocationHistoryRequest\x1a$.location.GetLocationHistoryResponse\x12V\n" +
+	"\x0fSearchLocations\x12 .location.SearchLocationsRequest\x1a!.location.SearchLocationsResponse\x12G\n" +
+	"\n" +
+	"GetHeatmap\x12\x1b.location.GetHeatmapRequest\x1a\x1c.location.GetHeatmapResponse\x12E\n" +
+	"\x0fStreamLocations\x12\x17.location.LocationBatch\x1a\x15.location.LocationAck(\x010\x01B6Z4github.com/OneKeyCoder/UIT-Go-Backend/proto/locationb\x06proto3"
+
+var (
+	file_location_location_proto_rawDescOnce sync.Once
+	file_location_location_proto_rawDescData []byte
+)
+
+func file_location_location_proto_rawDescGZIP() []byte {
+	file_location_location_proto_rawDescOnce.Do(func() {
+		file_location_location_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_location_location_proto_rawDesc), len(file_location_location_proto_rawDesc)))
+	})
+	return file_location_location_proto_rawDescData
+}
+
+var file_location_location_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_location_location_proto_goTypes = []any{
+	(*Location)(nil),                   // 0: location.Location
+	(*SetLocationRequest)(nil),         // 1: location.SetLocationRequest
+	(*SetLocationResponse)(nil),        // 2: location.SetLocationResponse
+	(*GetLocationRequest)(nil),         // 3: location.GetLocationRequest
+	(*GetLocationResponse)(nil),        // 4: location.GetLocationResponse
+	(*FindNearestUsersRequest)(nil),    // 5: location.FindNearestUsersRequest
+	(*FindNearestUsersResponse)(nil),   // 6: location.FindNearestUsersResponse
+	(*BoundingBox)(nil),                // 7: location.BoundingBox
+	(*SearchLocationsRequest)(nil),     // 8: location.SearchLocationsRequest
+	(*SearchLocationsResponse)(nil),    // 9: location.SearchLocationsResponse
+	(*GetAllLocationsRequest)(nil),     // 10: location.GetAllLocationsRequest
+	(*GetAllLocationsResponse)(nil),    // 11: location.GetAllLocationsResponse
+	(*RemoveLocationRequest)(nil),      // 12: location.RemoveLocationRequest
+	(*RemoveLocationResponse)(nil),     // 13: location.RemoveLocationResponse
+	(*UpdateUserRoleRequest)(nil),      // 14: location.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),     // 15: location.UpdateUserRoleResponse
+	(*DriverAvailability)(nil),         // 16: location.DriverAvailability
+	(*DriverAvailabilityRequest)(nil),  // 17: location.DriverAvailabilityRequest
+	(*DriverTripRequest)(nil),          // 18: location.DriverTripRequest
+	(*DriverAvailabilityResponse)(nil), // 19: location.DriverAvailabilityResponse
+	(*LocationPoint)(nil),              // 20: location.LocationPoint
+	(*GetLocationHistoryRequest)(nil),  // 21: location.GetLocationHistoryRequest
+	(*GetLocationHistoryResponse)(nil), // 22: location.GetLocationHistoryResponse
+	(*LocationBatch)(nil),              // 23: location.LocationBatch
+	(*LocationAck)(nil),                // 24: location.LocationAck
+	(*GetHeatmapRequest)(nil),          // 25: location.GetHeatmapRequest
+	(*HeatmapCell)(nil),                // 26: location.HeatmapCell
+	(*GetHeatmapResponse)(nil),         // 27: location.GetHeatmapResponse
+}
+var file_location_location_proto_depIdxs = []int32{
+	0,  // 0: location.SetLocationResponse.location:type_name -> location.Location
+	0,  // 1: location.GetLocationResponse.location:type_name -> location.Location
+	0,  // 2: location.FindNearestUsersResponse.locations:type_name -> location.Location
+	7,  // 3: location.SearchLocationsRequest.box:type_name -> location.BoundingBox
+	0,  // 4: location.SearchLocationsResponse.locations:type_name -> location.Location
+	0,  // 5: location.GetAllLocationsResponse.locations:type_name -> location.Location
+	0,  // 6: location.UpdateUserRoleResponse.location:type_name -> location.Location
+	16, // 7: location.DriverAvailabilityResponse.availability:type_name -> location.DriverAvailability
+	20, // 8: location.GetLocationHistoryResponse.points:type_name -> location.LocationPoint
+	1,  // 9: location.LocationBatch.locations:type_name -> location.SetLocationRequest
+	7,  // 10: location.GetHeatmapRequest.box:type_name -> location.BoundingBox
+	26, // 11: location.GetHeatmapResponse.cells:type_name -> location.HeatmapCell
+	1,  // 12: location.LocationService.SetLocation:input_type -> location.SetLocationRequest
+	3,  // 13: location.LocationService.GetLocation:input_type -> location.GetLocationRequest
+	5,  // 14: location.LocationService.FindNearestUsers:input_type -> location.FindNearestUsersRequest
+	10, // 15: location.LocationService.GetAllLocations:input_type -> location.GetAllLocationsRequest
+	12, // 16: location.LocationService.RemoveLocation:input_type -> location.RemoveLocationRequest
+	14, // 17: location.LocationService.UpdateUserRole:input_type -> location.UpdateUserRoleRequest
+	17, // 18: location.LocationService.GoOnline:input_type -> location.DriverAvailabilityRequest
+	17, // 19: location.LocationService.GoOffline:input_type -> location.DriverAvailabilityRequest
+	18, // 20: location.LocationService.MarkDriverOnTrip:input_type -> location.DriverTripRequest
+	18, // 21: location.LocationService.ReleaseDriver:input_type -> location.DriverTripRequest
+	17, // 22: location.LocationService.GetDriverAvailability:input_type -> location.DriverAvailabilityRequest
+	21, // 23: location.LocationService.GetLocationHistory:input_type -> location.GetLocationHistoryRequest
+	8,  // 24: location.LocationService.SearchLocations:input_type -> location.SearchLocationsRequest
+	25, // 25: location.LocationService.GetHeatmap:input_type -> location.GetHeatmapRequest
+	23, // 26: location.LocationService.StreamLocations:input_type -> location.LocationBatch
+	2,  // 27: location.LocationService.SetLocation:output_type -> location.SetLocationResponse
+	4,  // 28: location.LocationService.GetLocation:output_type -> location.GetLocationResponse
+	6,  // 29: location.LocationService.FindNearestUsers:output_type -> location.FindNearestUsersResponse
+	11, // 30: location.LocationService.GetAllLocations:output_type -> location.GetAllLocationsResponse
+	13, // 31: location.LocationService.RemoveLocation:output_type -> location.RemoveLocationResponse
+	15, // 32: location.LocationService.UpdateUserRole:output_type -> location.UpdateUserRoleResponse
+	19, // 33: location.LocationService.GoOnline:output_type -> location.DriverAvailabilityResponse
+	19, // 34: location.LocationService.GoOffline:output_type -> location.DriverAvailabilityResponse
+	19, // 35: location.LocationService.MarkDriverOnTrip:output_type -> location.DriverAvailabilityResponse
+	19, // 36: location.LocationService.ReleaseDriver:output_type -> location.DriverAvailabilityResponse
+	19, // 37: location.LocationService.GetDriverAvailability:output_type -> location.DriverAvailabilityResponse
+	22, // 38: location.LocationService.GetLocationHistory:output_type -> location.GetLocationHistoryResponse
+	9,  // 39: location.LocationService.SearchLocations:output_type -> location.SearchLocationsResponse
+	27, // 40: location.LocationService.GetHeatmap:output_type -> location.GetHeatmapResponse
+	24, // 41: location.LocationService.StreamLocations:output_type -> location.LocationAck
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_location_location_proto_init() }
+func file_location_location_proto_init() {
+	if File_location_location_proto != nil {
+		return
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_location_location_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_location_location_proto_rawDesc), len(file_location_location_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -923,7 +2132,6 @@ func file_location_location_proto_init() {
 		MessageInfos:      file_location_location_proto_msgTypes,
 	}.Build()
 	File_location_location_proto = out.File
-	file_location_location_proto_rawDesc = nil
 	file_location_location_proto_goTypes = nil
 	file_location_location_proto_depIdxs = nil
 }
@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LocationService_SetLocation_FullMethodName      = "/location.LocationService/SetLocation"
-	LocationService_GetLocation_FullMethodName      = "/location.LocationService/GetLocation"
-	LocationService_FindNearestUsers_FullMethodName = "/location.LocationService/FindNearestUsers"
-	LocationService_GetAllLocations_FullMethodName  = "/location.LocationService/GetAllLocations"
+	LocationService_SetLocation_FullMethodName           = "/location.LocationService/SetLocation"
+	LocationService_GetLocation_FullMethodName           = "/location.LocationService/GetLocation"
+	LocationService_FindNearestUsers_FullMethodName      = "/location.LocationService/FindNearestUsers"
+	LocationService_GetAllLocations_FullMethodName       = "/location.LocationService/GetAllLocations"
+	LocationService_RemoveLocation_FullMethodName        = "/location.LocationService/RemoveLocation"
+	LocationService_UpdateUserRole_FullMethodName        = "/location.LocationService/UpdateUserRole"
+	LocationService_GoOnline_FullMethodName              = "/location.LocationService/GoOnline"
+	LocationService_GoOffline_FullMethodName             = "/location.LocationService/GoOffline"
+	LocationService_MarkDriverOnTrip_FullMethodName      = "/location.LocationService/MarkDriverOnTrip"
+	LocationService_ReleaseDriver_FullMethodName         = "/location.LocationService/ReleaseDriver"
+	LocationService_GetDriverAvailability_FullMethodName = "/location.LocationService/GetDriverAvailability"
+	LocationService_GetLocationHistory_FullMethodName    = "/location.LocationService/GetLocationHistory"
+	LocationService_SearchLocations_FullMethodName       = "/location.LocationService/SearchLocations"
+	LocationService_GetHeatmap_FullMethodName            = "/location.LocationService/GetHeatmap"
+	LocationService_StreamLocations_FullMethodName       = "/location.LocationService/StreamLocations"
 )
 
 // LocationServiceClient is the client API for LocationService service.
@@ -39,6 +50,31 @@ type LocationServiceClient interface {
 	FindNearestUsers(ctx context.Context, in *FindNearestUsersRequest, opts ...grpc.CallOption) (*FindNearestUsersResponse, error)
 	// GetAllLocations retrieves all stored locations
 	GetAllLocations(ctx context.Context, in *GetAllLocationsRequest, opts ...grpc.CallOption) (*GetAllLocationsResponse, error)
+	// RemoveLocation deletes a user's location and takes them out of the geo index
+	RemoveLocation(ctx context.Context, in *RemoveLocationRequest, opts ...grpc.CallOption) (*RemoveLocationResponse, error)
+	// UpdateUserRole moves a user's location to the geo index of their new role
+	UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*UpdateUserRoleResponse, error)
+	// Driver availability. FindNearestUsers only returns online drivers.
+	// Drivers go online/offline themselves; trip-service marks them on_trip
+	// when they accept a trip and releases them when it ends.
+	GoOnline(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error)
+	GoOffline(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error)
+	MarkDriverOnTrip(ctx context.Context, in *DriverTripRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error)
+	ReleaseDriver(ctx context.Context, in *DriverTripRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error)
+	GetDriverAvailability(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error)
+	// GetLocationHistory returns the locations a user sent in a time range,
+	// oldest first, optionally downsampled to one point per interval
+	GetLocationHistory(ctx context.Context, in *GetLocationHistoryRequest, opts ...grpc.CallOption) (*GetLocationHistoryResponse, error)
+	// SearchLocations finds users around a point or inside a bounding box,
+	// nearest to the center first. Drivers are only found while online.
+	SearchLocations(ctx context.Context, in *SearchLocationsRequest, opts ...grpc.CallOption) (*SearchLocationsResponse, error)
+	// GetHeatmap counts the drivers and passengers inside a bounding box per
+	// geohash cell, for density maps
+	GetHeatmap(ctx context.Context, in *GetHeatmapRequest, opts ...grpc.CallOption) (*GetHeatmapResponse, error)
+	// StreamLocations applies ordered batches of location updates sent over one
+	// stream. The server only acknowledges: every few batches, and once more
+	// when the client closes its side.
+	StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LocationBatch, LocationAck], error)
 }
 
 type locationServiceClient struct {
@@ -89,6 +125,119 @@ func (c *locationServiceClient) GetAllLocations(ctx context.Context, in *GetAllL
 	return out, nil
 }
 
+func (c *locationServiceClient) RemoveLocation(ctx context.Context, in *RemoveLocationRequest, opts ...grpc.CallOption) (*RemoveLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveLocationResponse)
+	err := c.cc.Invoke(ctx, LocationService_RemoveLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*UpdateUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserRoleResponse)
+	err := c.cc.Invoke(ctx, LocationService_UpdateUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) GoOnline(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverAvailabilityResponse)
+	err := c.cc.Invoke(ctx, LocationService_GoOnline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) GoOffline(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverAvailabilityResponse)
+	err := c.cc.Invoke(ctx, LocationService_GoOffline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) MarkDriverOnTrip(ctx context.Context, in *DriverTripRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverAvailabilityResponse)
+	err := c.cc.Invoke(ctx, LocationService_MarkDriverOnTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) ReleaseDriver(ctx context.Context, in *DriverTripRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverAvailabilityResponse)
+	err := c.cc.Invoke(ctx, LocationService_ReleaseDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) GetDriverAvailability(ctx context.Context, in *DriverAvailabilityRequest, opts ...grpc.CallOption) (*DriverAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverAvailabilityResponse)
+	err := c.cc.Invoke(ctx, LocationService_GetDriverAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) GetLocationHistory(ctx context.Context, in *GetLocationHistoryRequest, opts ...grpc.CallOption) (*GetLocationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationHistoryResponse)
+	err := c.cc.Invoke(ctx, LocationService_GetLocationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) SearchLocations(ctx context.Context, in *SearchLocationsRequest, opts ...grpc.CallOption) (*SearchLocationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchLocationsResponse)
+	err := c.cc.Invoke(ctx, LocationService_SearchLocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) GetHeatmap(ctx context.Context, in *GetHeatmapRequest, opts ...grpc.CallOption) (*GetHeatmapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHeatmapResponse)
+	err := c.cc.Invoke(ctx, LocationService_GetHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) StreamLocations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[LocationBatch, LocationAck], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LocationService_ServiceDesc.Streams[0], LocationService_StreamLocations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LocationBatch, LocationAck]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LocationService_StreamLocationsClient = grpc.BidiStreamingClient[LocationBatch, LocationAck]
+
 // LocationServiceServer is the server API for LocationService service.
 // All implementations must embed UnimplementedLocationServiceServer
 // for forward compatibility.
@@ -103,6 +252,31 @@ type LocationServiceServer interface {
 	FindNearestUsers(context.Context, *FindNearestUsersRequest) (*FindNearestUsersResponse, error)
 	// GetAllLocations retrieves all stored locations
 	GetAllLocations(context.Context, *GetAllLocationsRequest) (*GetAllLocationsResponse, error)
+	// RemoveLocation deletes a user's location and takes them out of the geo index
+	RemoveLocation(context.Context, *RemoveLocationRequest) (*RemoveLocationResponse, error)
+	// UpdateUserRole moves a user's location to the geo index of their new role
+	UpdateUserRole(context.Context, *UpdateUserRoleRequest) (*UpdateUserRoleResponse, error)
+	// Driver availability. FindNearestUsers only returns online drivers.
+	// Drivers go online/offline themselves; trip-service marks them on_trip
+	// when they accept a trip and releases them when it ends.
+	GoOnline(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error)
+	GoOffline(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error)
+	MarkDriverOnTrip(context.Context, *DriverTripRequest) (*DriverAvailabilityResponse, error)
+	ReleaseDriver(context.Context, *DriverTripRequest) (*DriverAvailabilityResponse, error)
+	GetDriverAvailability(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error)
+	// GetLocationHistory returns the locations a user sent in a time range,
+	// oldest first, optionally downsampled to one point per interval
+	GetLocationHistory(context.Context, *GetLocationHistoryRequest) (*GetLocationHistoryResponse, error)
+	// SearchLocations finds users around a point or inside a bounding box,
+	// nearest to the center first. Drivers are only found while online.
+	SearchLocations(context.Context, *SearchLocationsRequest) (*SearchLocationsResponse, error)
+	// GetHeatmap counts the drivers and passengers inside a bounding box per
+	// geohash cell, for density maps
+	GetHeatmap(context.Context, *GetHeatmapRequest) (*GetHeatmapResponse, error)
+	// StreamLocations applies ordered batches of location updates sent over one
+	// stream. The server only acknowledges: every few batches, and once more
+	// when the client closes its side.
+	StreamLocations(grpc.BidiStreamingServer[LocationBatch, LocationAck]) error
 	mustEmbedUnimplementedLocationServiceServer()
 }
 
@@ -125,6 +299,39 @@ func (UnimplementedLocationServiceServer) FindNearestUsers(context.Context, *Fin
 func (UnimplementedLocationServiceServer) GetAllLocations(context.Context, *GetAllLocationsRequest) (*GetAllLocationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllLocations not implemented")
 }
+func (UnimplementedLocationServiceServer) RemoveLocation(context.Context, *RemoveLocationRequest) (*RemoveLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLocation not implemented")
+}
+func (UnimplementedLocationServiceServer) UpdateUserRole(context.Context, *UpdateUserRoleRequest) (*UpdateUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserRole not implemented")
+}
+func (UnimplementedLocationServiceServer) GoOnline(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOnline not implemented")
+}
+func (UnimplementedLocationServiceServer) GoOffline(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOffline not implemented")
+}
+func (UnimplementedLocationServiceServer) MarkDriverOnTrip(context.Context, *DriverTripRequest) (*DriverAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDriverOnTrip not implemented")
+}
+func (UnimplementedLocationServiceServer) ReleaseDriver(context.Context, *DriverTripRequest) (*DriverAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDriver not implemented")
+}
+func (UnimplementedLocationServiceServer) GetDriverAvailability(context.Context, *DriverAvailabilityRequest) (*DriverAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverAvailability not implemented")
+}
+func (UnimplementedLocationServiceServer) GetLocationHistory(context.Context, *GetLocationHistoryRequest) (*GetLocationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationHistory not implemented")
+}
+func (UnimplementedLocationServiceServer) SearchLocations(context.Context, *SearchLocationsRequest) (*SearchLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLocations not implemented")
+}
+func (UnimplementedLocationServiceServer) GetHeatmap(context.Context, *GetHeatmapRequest) (*GetHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeatmap not implemented")
+}
+func (UnimplementedLocationServiceServer) StreamLocations(grpc.BidiStreamingServer[LocationBatch, LocationAck]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLocations not implemented")
+}
 func (UnimplementedLocationServiceServer) mustEmbedUnimplementedLocationServiceServer() {}
 func (UnimplementedLocationServiceServer) testEmbeddedByValue()                         {}
 
@@ -218,6 +425,193 @@ func _LocationService_GetAllLocations_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocationService_RemoveLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).RemoveLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_RemoveLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).RemoveLocation(ctx, req.(*RemoveLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_UpdateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).UpdateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_UpdateUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).UpdateUserRole(ctx, req.(*UpdateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_GoOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GoOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GoOnline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GoOnline(ctx, req.(*DriverAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_GoOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GoOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GoOffline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GoOffline(ctx, req.(*DriverAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_MarkDriverOnTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).MarkDriverOnTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_MarkDriverOnTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).MarkDriverOnTrip(ctx, req.(*DriverTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_ReleaseDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).ReleaseDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_ReleaseDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).ReleaseDriver(ctx, req.(*DriverTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_GetDriverAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetDriverAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetDriverAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetDriverAvailability(ctx, req.(*DriverAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_GetLocationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetLocationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetLocationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetLocationHistory(ctx, req.(*GetLocationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_SearchLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).SearchLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_SearchLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).SearchLocations(ctx, req.(*SearchLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_GetHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetHeatmap(ctx, req.(*GetHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_StreamLocations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LocationServiceServer).StreamLocations(&grpc.GenericServerStream[LocationBatch, LocationAck]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LocationService_StreamLocationsServer = grpc.BidiStreamingServer[LocationBatch, LocationAck]
+
 // LocationService_ServiceDesc is the grpc.ServiceDesc for LocationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,7 +635,54 @@ var LocationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllLocations",
 			Handler:    _LocationService_GetAllLocations_Handler,
 		},
+		{
+			MethodName: "RemoveLocation",
+			Handler:    _LocationService_RemoveLocation_Handler,
+		},
+		{
+			MethodName: "UpdateUserRole",
+			Handler:    _LocationService_UpdateUserRole_Handler,
+		},
+		{
+			MethodName: "GoOnline",
+			Handler:    _LocationService_GoOnline_Handler,
+		},
+		{
+			MethodName: "GoOffline",
+			Handler:    _LocationService_GoOffline_Handler,
+		},
+		{
+			MethodName: "MarkDriverOnTrip",
+			Handler:    _LocationService_MarkDriverOnTrip_Handler,
+		},
+		{
+			MethodName: "ReleaseDriver",
+			Handler:    _LocationService_ReleaseDriver_Handler,
+		},
+		{
+			MethodName: "GetDriverAvailability",
+			Handler:    _LocationService_GetDriverAvailability_Handler,
+		},
+		{
+			MethodName: "GetLocationHistory",
+			Handler:    _LocationService_GetLocationHistory_Handler,
+		},
+		{
+			MethodName: "SearchLocations",
+			Handler:    _LocationService_SearchLocations_Handler,
+		},
+		{
+			MethodName: "GetHeatmap",
+			Handler:    _LocationService_GetHeatmap_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocations",
+			Handler:       _LocationService_StreamLocations_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "location/location.proto",
 }
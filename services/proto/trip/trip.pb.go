@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.36.10
 // 	protoc        v5.29.3
 // source: trip/trip.proto
 
@@ -12,6 +12,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -79,11 +80,106 @@ func (TripStatus) EnumDescriptor() ([]byte, []int) {
 	return file_trip_trip_proto_rawDescGZIP(), []int{0}
 }
 
-type Trip struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ReportInterval int32
+
+const (
+	ReportInterval_REPORT_INTERVAL_UNSPECIFIED ReportInterval = 0 // treated as DAY
+	ReportInterval_REPORT_INTERVAL_HOUR        ReportInterval = 1
+	ReportInterval_REPORT_INTERVAL_DAY         ReportInterval = 2
+)
+
+// Enum value maps for ReportInterval.
+var (
+	ReportInterval_name = map[int32]string{
+		0: "REPORT_INTERVAL_UNSPECIFIED",
+		1: "REPORT_INTERVAL_HOUR",
+		2: "REPORT_INTERVAL_DAY",
+	}
+	ReportInterval_value = map[string]int32{
+		"REPORT_INTERVAL_UNSPECIFIED": 0,
+		"REPORT_INTERVAL_HOUR":        1,
+		"REPORT_INTERVAL_DAY":         2,
+	}
+)
+
+func (x ReportInterval) Enum() *ReportInterval {
+	p := new(ReportInterval)
+	*p = x
+	return p
+}
+
+func (x ReportInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_trip_trip_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportInterval) Type() protoreflect.EnumType {
+	return &file_trip_trip_proto_enumTypes[1]
+}
+
+func (x ReportInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportInterval.Descriptor instead.
+func (ReportInterval) EnumDescriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{1}
+}
+
+type ChatReceiptKind int32
+
+const (
+	ChatReceiptKind_RECEIPT_UNKNOWN ChatReceiptKind = 0
+	ChatReceiptKind_DELIVERED       ChatReceiptKind = 1
+	ChatReceiptKind_READ            ChatReceiptKind = 2
+)
 
+// Enum value maps for ChatReceiptKind.
+var (
+	ChatReceiptKind_name = map[int32]string{
+		0: "RECEIPT_UNKNOWN",
+		1: "DELIVERED",
+		2: "READ",
+	}
+	ChatReceiptKind_value = map[string]int32{
+		"RECEIPT_UNKNOWN": 0,
+		"DELIVERED":       1,
+		"READ":            2,
+	}
+)
+
+func (x ChatReceiptKind) Enum() *ChatReceiptKind {
+	p := new(ChatReceiptKind)
+	*p = x
+	return p
+}
+
+func (x ChatReceiptKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatReceiptKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_trip_trip_proto_enumTypes[2].Descriptor()
+}
+
+func (ChatReceiptKind) Type() protoreflect.EnumType {
+	return &file_trip_trip_proto_enumTypes[2]
+}
+
+func (x ChatReceiptKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatReceiptKind.Descriptor instead.
+func (ChatReceiptKind) EnumDescriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{2}
+}
+
+type Trip struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	PassengerId    int32                  `protobuf:"varint,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
 	DriverId       int32                  `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
@@ -103,15 +199,18 @@ type Trip struct {
 	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
 	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
 	CancelByUserId int32                  `protobuf:"varint,19,opt,name=cancel_by_user_id,json=cancelByUserId,proto3" json:"cancel_by_user_id,omitempty"`
+	AcceptedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	// motorbike, car_4, car_7 or premium.
+	VehicleClass  string `protobuf:"bytes,21,opt,name=vehicle_class,json=vehicleClass,proto3" json:"vehicle_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Trip) Reset() {
 	*x = Trip{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Trip) String() string {
@@ -122,7 +221,7 @@ func (*Trip) ProtoMessage() {}
 
 func (x *Trip) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -270,26 +369,46 @@ func (x *Trip) GetCancelByUserId() int32 {
 	return 0
 }
 
+func (x *Trip) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetVehicleClass() string {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return ""
+}
+
 type CreateTripRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PassengerId   int32                  `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	OriginLat     float64                `protobuf:"fixed64,2,opt,name=origin_lat,json=originLat,proto3" json:"origin_lat,omitempty"`
+	OriginLng     float64                `protobuf:"fixed64,3,opt,name=origin_lng,json=originLng,proto3" json:"origin_lng,omitempty"`
+	DestLat       float64                `protobuf:"fixed64,4,opt,name=dest_lat,json=destLat,proto3" json:"dest_lat,omitempty"`
+	DestLng       float64                `protobuf:"fixed64,5,opt,name=dest_lng,json=destLng,proto3" json:"dest_lng,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Client-supplied key; retries with the same key replay the stored response.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Saved places of the passenger (user-service). When set they replace the
+	// matching coordinates above.
+	OriginPlaceId int32 `protobuf:"varint,8,opt,name=origin_place_id,json=originPlaceId,proto3" json:"origin_place_id,omitempty"`
+	DestPlaceId   int32 `protobuf:"varint,9,opt,name=dest_place_id,json=destPlaceId,proto3" json:"dest_place_id,omitempty"`
+	// motorbike, car_4, car_7 or premium; empty means car_4. Only drivers with
+	// an active, verified vehicle of the class are offered the trip.
+	VehicleClass  string `protobuf:"bytes,10,opt,name=vehicle_class,json=vehicleClass,proto3" json:"vehicle_class,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	PassengerId   int32   `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
-	OriginLat     float64 `protobuf:"fixed64,2,opt,name=origin_lat,json=originLat,proto3" json:"origin_lat,omitempty"`
-	OriginLng     float64 `protobuf:"fixed64,3,opt,name=origin_lng,json=originLng,proto3" json:"origin_lng,omitempty"`
-	DestLat       float64 `protobuf:"fixed64,4,opt,name=dest_lat,json=destLat,proto3" json:"dest_lat,omitempty"`
-	DestLng       float64 `protobuf:"fixed64,5,opt,name=dest_lng,json=destLng,proto3" json:"dest_lng,omitempty"`
-	PaymentMethod string  `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTripRequest) Reset() {
 	*x = CreateTripRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateTripRequest) String() string {
@@ -300,7 +419,7 @@ func (*CreateTripRequest) ProtoMessage() {}
 
 func (x *CreateTripRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -357,22 +476,47 @@ func (x *CreateTripRequest) GetPaymentMethod() string {
 	return ""
 }
 
+func (x *CreateTripRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateTripRequest) GetOriginPlaceId() int32 {
+	if x != nil {
+		return x.OriginPlaceId
+	}
+	return 0
+}
+
+func (x *CreateTripRequest) GetDestPlaceId() int32 {
+	if x != nil {
+		return x.DestPlaceId
+	}
+	return 0
+}
+
+func (x *CreateTripRequest) GetVehicleClass() string {
+	if x != nil {
+		return x.VehicleClass
+	}
+	return ""
+}
+
 type CreateTripResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	Duration      float32                `protobuf:"fixed32,2,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Trip     *Trip   `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
-	Duration float32 `protobuf:"fixed32,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTripResponse) Reset() {
 	*x = CreateTripResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateTripResponse) String() string {
@@ -383,7 +527,7 @@ func (*CreateTripResponse) ProtoMessage() {}
 
 func (x *CreateTripResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -413,21 +557,19 @@ func (x *CreateTripResponse) GetDuration() float32 {
 }
 
 type AcceptTripRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DriverId int32 `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	TripId   int32 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DriverId       int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	TripId         int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AcceptTripRequest) Reset() {
 	*x = AcceptTripRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *AcceptTripRequest) String() string {
@@ -438,7 +580,7 @@ func (*AcceptTripRequest) ProtoMessage() {}
 
 func (x *AcceptTripRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -467,23 +609,28 @@ func (x *AcceptTripRequest) GetTripId() int32 {
 	return 0
 }
 
-type RejectTripRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AcceptTripRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
 
-	PassengerId int32 `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
-	DriverId    int32 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	TripId      int32 `protobuf:"varint,3,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+type RejectTripRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PassengerId    int32                  `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DriverId       int32                  `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	TripId         int32                  `protobuf:"varint,3,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RejectTripRequest) Reset() {
 	*x = RejectTripRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RejectTripRequest) String() string {
@@ -494,7 +641,7 @@ func (*RejectTripRequest) ProtoMessage() {}
 
 func (x *RejectTripRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -530,22 +677,26 @@ func (x *RejectTripRequest) GetTripId() int32 {
 	return 0
 }
 
+func (x *RejectTripRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type TripIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PassengerId   int32                  `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	PassengerId int32 `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
-	TripId      int32 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TripIDRequest) Reset() {
 	*x = TripIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TripIDRequest) String() string {
@@ -556,7 +707,7 @@ func (*TripIDRequest) ProtoMessage() {}
 
 func (x *TripIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -586,21 +737,18 @@ func (x *TripIDRequest) GetTripId() int32 {
 }
 
 type GetTripRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TripId int32 `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTripRequest) Reset() {
 	*x = GetTripRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetTripRequest) String() string {
@@ -611,7 +759,7 @@ func (*GetTripRequest) ProtoMessage() {}
 
 func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -641,20 +789,17 @@ func (x *GetTripRequest) GetTripId() int32 {
 }
 
 type GetSuggestedDriverResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	DriverId int32 `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSuggestedDriverResponse) Reset() {
 	*x = GetSuggestedDriverResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetSuggestedDriverResponse) String() string {
@@ -665,7 +810,7 @@ func (*GetSuggestedDriverResponse) ProtoMessage() {}
 
 func (x *GetSuggestedDriverResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -688,20 +833,17 @@ func (x *GetSuggestedDriverResponse) GetDriverId() int32 {
 }
 
 type GetTripDetailResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trip          *Trip                  `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Trip *Trip `protobuf:"bytes,1,opt,name=trip,proto3" json:"trip,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTripDetailResponse) Reset() {
 	*x = GetTripDetailResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetTripDetailResponse) String() string {
@@ -712,7 +854,7 @@ func (*GetTripDetailResponse) ProtoMessage() {}
 
 func (x *GetTripDetailResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -735,20 +877,17 @@ func (x *GetTripDetailResponse) GetTrip() *Trip {
 }
 
 type GetTripsByUserIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTripsByUserIDRequest) Reset() {
 	*x = GetTripsByUserIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetTripsByUserIDRequest) String() string {
@@ -759,7 +898,7 @@ func (*GetTripsByUserIDRequest) ProtoMessage() {}
 
 func (x *GetTripsByUserIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -782,20 +921,17 @@ func (x *GetTripsByUserIDRequest) GetUserId() int32 {
 }
 
 type TripsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Trips []*Trip `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TripsResponse) Reset() {
 	*x = TripsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TripsResponse) String() string {
@@ -806,7 +942,7 @@ func (*TripsResponse) ProtoMessage() {}
 
 func (x *TripsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -829,21 +965,18 @@ func (x *TripsResponse) GetTrips() []*Trip {
 }
 
 type GetAllTripsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Page  int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAllTripsRequest) Reset() {
 	*x = GetAllTripsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetAllTripsRequest) String() string {
@@ -854,7 +987,7 @@ func (*GetAllTripsRequest) ProtoMessage() {}
 
 func (x *GetAllTripsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -884,22 +1017,20 @@ func (x *GetAllTripsRequest) GetLimit() int32 {
 }
 
 type UpdateTripStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TripId   int32      `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	DriverId int32      `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	Status   TripStatus `protobuf:"varint,3,opt,name=status,proto3,enum=trip.TripStatus" json:"status,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	DriverId       int32                  `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Status         TripStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=trip.TripStatus" json:"status,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateTripStatusRequest) Reset() {
 	*x = UpdateTripStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *UpdateTripStatusRequest) String() string {
@@ -910,7 +1041,7 @@ func (*UpdateTripStatusRequest) ProtoMessage() {}
 
 func (x *UpdateTripStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -946,22 +1077,27 @@ func (x *UpdateTripStatusRequest) GetStatus() TripStatus {
 	return TripStatus_STATUS_UNKNOWN
 }
 
-type CancelTripRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UpdateTripStatusRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
 
-	TripId int32 `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	UserId int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type CancelTripRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TripId         int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CancelTripRequest) Reset() {
 	*x = CancelTripRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CancelTripRequest) String() string {
@@ -972,7 +1108,7 @@ func (*CancelTripRequest) ProtoMessage() {}
 
 func (x *CancelTripRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1001,22 +1137,26 @@ func (x *CancelTripRequest) GetUserId() int32 {
 	return 0
 }
 
+func (x *CancelTripRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type Review struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        int32                  `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Rating  int32  `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Review) Reset() {
 	*x = Review{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Review) String() string {
@@ -1027,7 +1167,7 @@ func (*Review) ProtoMessage() {}
 
 func (x *Review) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1057,22 +1197,19 @@ func (x *Review) GetComment() string {
 }
 
 type SubmitReviewRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Review        *Review                `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	TripId int32   `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
-	UserId int32   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Review *Review `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitReviewRequest) Reset() {
 	*x = SubmitReviewRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SubmitReviewRequest) String() string {
@@ -1083,7 +1220,7 @@ func (*SubmitReviewRequest) ProtoMessage() {}
 
 func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1120,20 +1257,17 @@ func (x *SubmitReviewRequest) GetReview() *Review {
 }
 
 type GetTripReviewResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Review *Review `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTripReviewResponse) Reset() {
 	*x = GetTripReviewResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetTripReviewResponse) String() string {
@@ -1144,7 +1278,7 @@ func (*GetTripReviewResponse) ProtoMessage() {}
 
 func (x *GetTripReviewResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1167,21 +1301,18 @@ func (x *GetTripReviewResponse) GetReview() *Review {
 }
 
 type MessageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageResponse) Reset() {
 	*x = MessageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MessageResponse) String() string {
@@ -1192,7 +1323,7 @@ func (*MessageResponse) ProtoMessage() {}
 
 func (x *MessageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1222,22 +1353,19 @@ func (x *MessageResponse) GetMessage() string {
 }
 
 type PageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Trips []*Trip `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
-	Page  int32   `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PageResponse) Reset() {
 	*x = PageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_trip_trip_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_trip_trip_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *PageResponse) String() string {
@@ -1248,7 +1376,7 @@ func (*PageResponse) ProtoMessage() {}
 
 func (x *PageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_trip_trip_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1284,540 +1412,3851 @@ func (x *PageResponse) GetLimit() int32 {
 	return 0
 }
 
-var File_trip_trip_proto protoreflect.FileDescriptor
-
-var file_trip_trip_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x74, 0x72, 0x69, 0x70, 0x2f, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x04, 0x74, 0x72, 0x69, 0x70, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x05, 0x0a, 0x04, 0x54, 0x72, 0x69,
-	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x61, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x6e, 0x67, 0x12,
-	0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65,
-	0x73, 0x74, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65,
-	0x73, 0x74, 0x4c, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66,
-	0x61, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x61, 0x72, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
-	0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0e, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xd1, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61,
-	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x4c, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f,
-	0x6c, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4c,
-	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x6e, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4c, 0x6e, 0x67, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x22, 0x50, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x72,
-	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
-	0x54, 0x72, 0x69, 0x70, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49,
-	0x64, 0x22, 0x6c, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e,
-	0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61,
-	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22,
-	0x4b, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64,
-	0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x04,
-	0x74, 0x72, 0x69, 0x70, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0d, 0x54, 0x72, 0x69, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x72, 0x69,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
-	0x54, 0x72, 0x69, 0x70, 0x52, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x22, 0x3e, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x79, 0x0a, 0x17, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74,
-	0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x45, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72,
-	0x69, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a,
-	0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x13, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x3d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x45, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a,
-	0x0a, 0x0c, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2a, 0x68, 0x0a, 0x0a, 0x54, 0x72,
-	0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x41,
-	0x52, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
-	0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
-	0x45, 0x44, 0x10, 0x05, 0x32, 0xb7, 0x06, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x70, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x69, 0x70, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72,
-	0x69, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54,
-	0x72, 0x69, 0x70, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74,
-	0x72, 0x69, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x72, 0x69,
-	0x70, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54,
-	0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x69,
-	0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54,
-	0x72, 0x69, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74,
-	0x72, 0x69, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
-	0x13, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x69, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x42, 0x79, 0x50,
-	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54,
-	0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x42, 0x79, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x12, 0x1d, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73,
-	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x13, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72,
-	0x69, 0x70, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x74, 0x72, 0x69, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x48, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x69, 0x70, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x70,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x69, 0x70,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x13, 0x2e, 0x74,
-	0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32,
-	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x6e, 0x65,
-	0x4b, 0x65, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x72, 0x2f, 0x55, 0x49, 0x54, 0x2d, 0x47, 0x6f, 0x2d,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72,
-	0x69, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Area in degrees; a trip belongs to it when its origin is inside.
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinLat        float64                `protobuf:"fixed64,1,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MinLng        float64                `protobuf:"fixed64,2,opt,name=min_lng,json=minLng,proto3" json:"min_lng,omitempty"`
+	MaxLat        float64                `protobuf:"fixed64,3,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	MaxLng        float64                `protobuf:"fixed64,4,opt,name=max_lng,json=maxLng,proto3" json:"max_lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var (
-	file_trip_trip_proto_rawDescOnce sync.Once
-	file_trip_trip_proto_rawDescData = file_trip_trip_proto_rawDesc
-)
-
-func file_trip_trip_proto_rawDescGZIP() []byte {
-	file_trip_trip_proto_rawDescOnce.Do(func() {
-		file_trip_trip_proto_rawDescData = protoimpl.X.CompressGZIP(file_trip_trip_proto_rawDescData)
-	})
-	return file_trip_trip_proto_rawDescData
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_trip_trip_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_trip_trip_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_trip_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_trip_trip_proto_goTypes = []interface{}{
-	(TripStatus)(0),                    // 0: trip.TripStatus
-	(*Trip)(nil),                       // 1: trip.Trip
-	(*CreateTripRequest)(nil),          // 2: trip.CreateTripRequest
-	(*CreateTripResponse)(nil),         // 3: trip.CreateTripResponse
-	(*AcceptTripRequest)(nil),          // 4: trip.AcceptTripRequest
-	(*RejectTripRequest)(nil),          // 5: trip.RejectTripRequest
-	(*TripIDRequest)(nil),              // 6: trip.TripIDRequest
-	(*GetTripRequest)(nil),             // 7: trip.GetTripRequest
-	(*GetSuggestedDriverResponse)(nil), // 8: trip.GetSuggestedDriverResponse
-	(*GetTripDetailResponse)(nil),      // 9: trip.GetTripDetailResponse
-	(*GetTripsByUserIDRequest)(nil),    // 10: trip.GetTripsByUserIDRequest
-	(*TripsResponse)(nil),              // 11: trip.TripsResponse
-	(*GetAllTripsRequest)(nil),         // 12: trip.GetAllTripsRequest
-	(*UpdateTripStatusRequest)(nil),    // 13: trip.UpdateTripStatusRequest
-	(*CancelTripRequest)(nil),          // 14: trip.CancelTripRequest
-	(*Review)(nil),                     // 15: trip.Review
-	(*SubmitReviewRequest)(nil),        // 16: trip.SubmitReviewRequest
-	(*GetTripReviewResponse)(nil),      // 17: trip.GetTripReviewResponse
-	(*MessageResponse)(nil),            // 18: trip.MessageResponse
-	(*PageResponse)(nil),               // 19: trip.PageResponse
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
-}
-var file_trip_trip_proto_depIdxs = []int32{
-	0,  // 0: trip.Trip.status:type_name -> trip.TripStatus
-	20, // 1: trip.Trip.created_at:type_name -> google.protobuf.Timestamp
-	20, // 2: trip.Trip.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 3: trip.Trip.started_at:type_name -> google.protobuf.Timestamp
-	20, // 4: trip.Trip.completed_at:type_name -> google.protobuf.Timestamp
-	20, // 5: trip.Trip.cancelled_at:type_name -> google.protobuf.Timestamp
-	1,  // 6: trip.CreateTripResponse.trip:type_name -> trip.Trip
-	1,  // 7: trip.GetTripDetailResponse.trip:type_name -> trip.Trip
-	1,  // 8: trip.TripsResponse.trips:type_name -> trip.Trip
-	0,  // 9: trip.UpdateTripStatusRequest.status:type_name -> trip.TripStatus
-	15, // 10: trip.SubmitReviewRequest.review:type_name -> trip.Review
-	15, // 11: trip.GetTripReviewResponse.review:type_name -> trip.Review
-	1,  // 12: trip.PageResponse.trips:type_name -> trip.Trip
-	2,  // 13: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
-	4,  // 14: trip.TripService.AcceptTrip:input_type -> trip.AcceptTripRequest
-	5,  // 15: trip.TripService.RejectTrip:input_type -> trip.RejectTripRequest
-	6,  // 16: trip.TripService.GetSuggestedDriver:input_type -> trip.TripIDRequest
-	6,  // 17: trip.TripService.GetTripDetail:input_type -> trip.TripIDRequest
-	10, // 18: trip.TripService.GetTripsByPassenger:input_type -> trip.GetTripsByUserIDRequest
-	10, // 19: trip.TripService.GetTripsByDriver:input_type -> trip.GetTripsByUserIDRequest
-	12, // 20: trip.TripService.GetAllTrips:input_type -> trip.GetAllTripsRequest
-	13, // 21: trip.TripService.UpdateTripStatus:input_type -> trip.UpdateTripStatusRequest
-	14, // 22: trip.TripService.CancelTrip:input_type -> trip.CancelTripRequest
-	16, // 23: trip.TripService.SubmitReview:input_type -> trip.SubmitReviewRequest
-	6,  // 24: trip.TripService.GetTripReview:input_type -> trip.TripIDRequest
-	3,  // 25: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
-	18, // 26: trip.TripService.AcceptTrip:output_type -> trip.MessageResponse
-	18, // 27: trip.TripService.RejectTrip:output_type -> trip.MessageResponse
-	8,  // 28: trip.TripService.GetSuggestedDriver:output_type -> trip.GetSuggestedDriverResponse
-	9,  // 29: trip.TripService.GetTripDetail:output_type -> trip.GetTripDetailResponse
-	11, // 30: trip.TripService.GetTripsByPassenger:output_type -> trip.TripsResponse
-	11, // 31: trip.TripService.GetTripsByDriver:output_type -> trip.TripsResponse
-	19, // 32: trip.TripService.GetAllTrips:output_type -> trip.PageResponse
-	18, // 33: trip.TripService.UpdateTripStatus:output_type -> trip.MessageResponse
-	18, // 34: trip.TripService.CancelTrip:output_type -> trip.MessageResponse
-	18, // 35: trip.TripService.SubmitReview:output_type -> trip.MessageResponse
-	17, // 36: trip.TripService.GetTripReview:output_type -> trip.GetTripReviewResponse
-	25, // [25:37] is the sub-list for method output_type
-	13, // [13:25] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_trip_trip_proto_init() }
-func file_trip_trip_proto_init() {
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BoundingBox) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMinLng() float64 {
+	if x != nil {
+		return x.MinLng
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *BoundingBox) GetMaxLng() float64 {
+	if x != nil {
+		return x.MaxLng
+	}
+	return 0
+}
+
+type TripReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Trips created in [from, to). Defaults to the 7 days before now.
+	From     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Interval ReportInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=trip.ReportInterval" json:"interval,omitempty"`
+	// Optional: only count trips starting inside this zone.
+	Zone *BoundingBox `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	// Optional: when > 0, also break the report down by square grid cells of
+	// this size in degrees.
+	ZoneCellSize  float64 `protobuf:"fixed64,5,opt,name=zone_cell_size,json=zoneCellSize,proto3" json:"zone_cell_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripReportRequest) Reset() {
+	*x = TripReportRequest{}
+	mi := &file_trip_trip_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripReportRequest) ProtoMessage() {}
+
+func (x *TripReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripReportRequest.ProtoReflect.Descriptor instead.
+func (*TripReportRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TripReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TripReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TripReportRequest) GetInterval() ReportInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+}
+
+func (x *TripReportRequest) GetZone() *BoundingBox {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *TripReportRequest) GetZoneCellSize() float64 {
+	if x != nil {
+		return x.ZoneCellSize
+	}
+	return 0
+}
+
+type TripStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Trips            int64                  `protobuf:"varint,1,opt,name=trips,proto3" json:"trips,omitempty"`
+	Completed        int64                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Cancelled        int64                  `protobuf:"varint,3,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	CompletionRate   float64                `protobuf:"fixed64,4,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	CancellationRate float64                `protobuf:"fixed64,5,opt,name=cancellation_rate,json=cancellationRate,proto3" json:"cancellation_rate,omitempty"`
+	// Time from request to acceptance, over accepted trips.
+	AvgWaitToAcceptSeconds float64 `protobuf:"fixed64,6,opt,name=avg_wait_to_accept_seconds,json=avgWaitToAcceptSeconds,proto3" json:"avg_wait_to_accept_seconds,omitempty"`
+	// Fare, distance and revenue count completed trips only.
+	AvgFare       float64 `protobuf:"fixed64,7,opt,name=avg_fare,json=avgFare,proto3" json:"avg_fare,omitempty"`
+	AvgDistance   float64 `protobuf:"fixed64,8,opt,name=avg_distance,json=avgDistance,proto3" json:"avg_distance,omitempty"`
+	Revenue       float64 `protobuf:"fixed64,9,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripStats) Reset() {
+	*x = TripStats{}
+	mi := &file_trip_trip_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripStats) ProtoMessage() {}
+
+func (x *TripStats) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripStats.ProtoReflect.Descriptor instead.
+func (*TripStats) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TripStats) GetTrips() int64 {
+	if x != nil {
+		return x.Trips
+	}
+	return 0
+}
+
+func (x *TripStats) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *TripStats) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *TripStats) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *TripStats) GetCancellationRate() float64 {
+	if x != nil {
+		return x.CancellationRate
+	}
+	return 0
+}
+
+func (x *TripStats) GetAvgWaitToAcceptSeconds() float64 {
+	if x != nil {
+		return x.AvgWaitToAcceptSeconds
+	}
+	return 0
+}
+
+func (x *TripStats) GetAvgFare() float64 {
+	if x != nil {
+		return x.AvgFare
+	}
+	return 0
+}
+
+func (x *TripStats) GetAvgDistance() float64 {
+	if x != nil {
+		return x.AvgDistance
+	}
+	return 0
+}
+
+func (x *TripStats) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type TripReportBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Stats         *TripStats             `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripReportBucket) Reset() {
+	*x = TripReportBucket{}
+	mi := &file_trip_trip_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripReportBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripReportBucket) ProtoMessage() {}
+
+func (x *TripReportBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripReportBucket.ProtoReflect.Descriptor instead.
+func (*TripReportBucket) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TripReportBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TripReportBucket) GetStats() *TripStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ZoneStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cell          *BoundingBox           `protobuf:"bytes,1,opt,name=cell,proto3" json:"cell,omitempty"`
+	Stats         *TripStats             `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneStats) Reset() {
+	*x = ZoneStats{}
+	mi := &file_trip_trip_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneStats) ProtoMessage() {}
+
+func (x *ZoneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneStats.ProtoReflect.Descriptor instead.
+func (*ZoneStats) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ZoneStats) GetCell() *BoundingBox {
+	if x != nil {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *ZoneStats) GetStats() *TripStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PaymentMethodRevenue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethod string                 `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Trips         int64                  `protobuf:"varint,2,opt,name=trips,proto3" json:"trips,omitempty"`
+	Revenue       float64                `protobuf:"fixed64,3,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethodRevenue) Reset() {
+	*x = PaymentMethodRevenue{}
+	mi := &file_trip_trip_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodRevenue) ProtoMessage() {}
+
+func (x *PaymentMethodRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodRevenue.ProtoReflect.Descriptor instead.
+func (*PaymentMethodRevenue) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PaymentMethodRevenue) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PaymentMethodRevenue) GetTrips() int64 {
+	if x != nil {
+		return x.Trips
+	}
+	return 0
+}
+
+func (x *PaymentMethodRevenue) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type TripReportResponse struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	From                   *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                     *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Interval               ReportInterval          `protobuf:"varint,3,opt,name=interval,proto3,enum=trip.ReportInterval" json:"interval,omitempty"`
+	Summary                *TripStats              `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Buckets                []*TripReportBucket     `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Zones                  []*ZoneStats            `protobuf:"bytes,6,rep,name=zones,proto3" json:"zones,omitempty"`
+	RevenueByPaymentMethod []*PaymentMethodRevenue `protobuf:"bytes,7,rep,name=revenue_by_payment_method,json=revenueByPaymentMethod,proto3" json:"revenue_by_payment_method,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TripReportResponse) Reset() {
+	*x = TripReportResponse{}
+	mi := &file_trip_trip_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripReportResponse) ProtoMessage() {}
+
+func (x *TripReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripReportResponse.ProtoReflect.Descriptor instead.
+func (*TripReportResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TripReportResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TripReportResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TripReportResponse) GetInterval() ReportInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return ReportInterval_REPORT_INTERVAL_UNSPECIFIED
+}
+
+func (x *TripReportResponse) GetSummary() *TripStats {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *TripReportResponse) GetBuckets() []*TripReportBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *TripReportResponse) GetZones() []*ZoneStats {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *TripReportResponse) GetRevenueByPaymentMethod() []*PaymentMethodRevenue {
+	if x != nil {
+		return x.RevenueByPaymentMethod
+	}
+	return nil
+}
+
+// Filters are combined with AND; unset fields don't filter.
+type ExportTripsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Trips created in [from, to).
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Statuses      []TripStatus           `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=trip.TripStatus" json:"statuses,omitempty"`
+	PassengerId   int32                  `protobuf:"varint,4,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DriverId      int32                  `protobuf:"varint,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Zone          *BoundingBox           `protobuf:"bytes,7,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTripsRequest) Reset() {
+	*x = ExportTripsRequest{}
+	mi := &file_trip_trip_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTripsRequest) ProtoMessage() {}
+
+func (x *ExportTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTripsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTripsRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportTripsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportTripsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportTripsRequest) GetStatuses() []TripStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ExportTripsRequest) GetPassengerId() int32 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *ExportTripsRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *ExportTripsRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *ExportTripsRequest) GetZone() *BoundingBox {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	SenderId      int32                  `protobuf:"varint,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId   int32                  `protobuf:"varint,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	QuickReply    string                 `protobuf:"bytes,6,opt,name=quick_reply,json=quickReply,proto3" json:"quick_reply,omitempty"` // key of the template used, if any
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_trip_trip_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChatMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetSenderId() int32 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetRecipientId() int32 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetQuickReply() string {
+	if x != nil {
+		return x.QuickReply
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+// Exactly one of body and quick_reply must be set.
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	SenderId      int32                  `protobuf:"varint,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	QuickReply    string                 `protobuf:"bytes,4,opt,name=quick_reply,json=quickReply,proto3" json:"quick_reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_trip_trip_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SendChatMessageRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *SendChatMessageRequest) GetSenderId() int32 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *SendChatMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetQuickReply() string {
+	if x != nil {
+		return x.QuickReply
+	}
+	return ""
+}
+
+type ChatHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AfterId       int64                  `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryRequest) Reset() {
+	*x = ChatHistoryRequest{}
+	mi := &file_trip_trip_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryRequest) ProtoMessage() {}
+
+func (x *ChatHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ChatHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChatHistoryRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ChatHistoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChatHistoryRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ChatHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ChatHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatHistoryResponse) Reset() {
+	*x = ChatHistoryResponse{}
+	mi := &file_trip_trip_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatHistoryResponse) ProtoMessage() {}
+
+func (x *ChatHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ChatHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChatHistoryResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type MarkChatReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UpToId        int64                  `protobuf:"varint,3,opt,name=up_to_id,json=upToId,proto3" json:"up_to_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChatReadRequest) Reset() {
+	*x = MarkChatReadRequest{}
+	mi := &file_trip_trip_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChatReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChatReadRequest) ProtoMessage() {}
+
+func (x *MarkChatReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChatReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkChatReadRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MarkChatReadRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *MarkChatReadRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkChatReadRequest) GetUpToId() int64 {
+	if x != nil {
+		return x.UpToId
+	}
+	return 0
+}
+
+type MarkChatReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       int64                  `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChatReadResponse) Reset() {
+	*x = MarkChatReadResponse{}
+	mi := &file_trip_trip_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChatReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChatReadResponse) ProtoMessage() {}
+
+func (x *MarkChatReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChatReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkChatReadResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MarkChatReadResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type QuickRepliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` // passenger or driver
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickRepliesRequest) Reset() {
+	*x = QuickRepliesRequest{}
+	mi := &file_trip_trip_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickRepliesRequest) ProtoMessage() {}
+
+func (x *QuickRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickRepliesRequest.ProtoReflect.Descriptor instead.
+func (*QuickRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuickRepliesRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type QuickReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickReply) Reset() {
+	*x = QuickReply{}
+	mi := &file_trip_trip_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickReply) ProtoMessage() {}
+
+func (x *QuickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickReply.ProtoReflect.Descriptor instead.
+func (*QuickReply) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuickReply) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *QuickReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type QuickRepliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replies       []*QuickReply          `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickRepliesResponse) Reset() {
+	*x = QuickRepliesResponse{}
+	mi := &file_trip_trip_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickRepliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickRepliesResponse) ProtoMessage() {}
+
+func (x *QuickRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickRepliesResponse.ProtoReflect.Descriptor instead.
+func (*QuickRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QuickRepliesResponse) GetReplies() []*QuickReply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type SubscribeTripChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeTripChatRequest) Reset() {
+	*x = SubscribeTripChatRequest{}
+	mi := &file_trip_trip_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeTripChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTripChatRequest) ProtoMessage() {}
+
+func (x *SubscribeTripChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTripChatRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTripChatRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubscribeTripChatRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *SubscribeTripChatRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ChatReceipt says that recipient_id has received (or read) every message
+// addressed to them up to up_to_id.
+type ChatReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	RecipientId   int32                  `protobuf:"varint,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	UpToId        int64                  `protobuf:"varint,3,opt,name=up_to_id,json=upToId,proto3" json:"up_to_id,omitempty"`
+	Kind          ChatReceiptKind        `protobuf:"varint,4,opt,name=kind,proto3,enum=trip.ChatReceiptKind" json:"kind,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatReceipt) Reset() {
+	*x = ChatReceipt{}
+	mi := &file_trip_trip_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatReceipt) ProtoMessage() {}
+
+func (x *ChatReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatReceipt.ProtoReflect.Descriptor instead.
+func (*ChatReceipt) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ChatReceipt) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ChatReceipt) GetRecipientId() int32 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *ChatReceipt) GetUpToId() int64 {
+	if x != nil {
+		return x.UpToId
+	}
+	return 0
+}
+
+func (x *ChatReceipt) GetKind() ChatReceiptKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ChatReceiptKind_RECEIPT_UNKNOWN
+}
+
+func (x *ChatReceipt) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type ChatEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ChatEvent_Message
+	//	*ChatEvent_Receipt
+	Event         isChatEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_trip_trip_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChatEvent) GetEvent() isChatEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessage() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Message); ok {
+			return x.Message
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReceipt() *ChatReceipt {
+	if x != nil {
+		if x, ok := x.Event.(*ChatEvent_Receipt); ok {
+			return x.Receipt
+		}
+	}
+	return nil
+}
+
+type isChatEvent_Event interface {
+	isChatEvent_Event()
+}
+
+type ChatEvent_Message struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=message,proto3,oneof"`
+}
+
+type ChatEvent_Receipt struct {
+	Receipt *ChatReceipt `protobuf:"bytes,2,opt,name=receipt,proto3,oneof"`
+}
+
+func (*ChatEvent_Message) isChatEvent_Event() {}
+
+func (*ChatEvent_Receipt) isChatEvent_Event() {}
+
+type RaiseSOSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaiseSOSRequest) Reset() {
+	*x = RaiseSOSRequest{}
+	mi := &file_trip_trip_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaiseSOSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseSOSRequest) ProtoMessage() {}
+
+func (x *RaiseSOSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseSOSRequest.ProtoReflect.Descriptor instead.
+func (*RaiseSOSRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RaiseSOSRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *RaiseSOSRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RaiseSOSRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// created is false when the trip already had an open incident, which is
+// returned instead.
+type RaiseSOSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RaiseSOSResponse) Reset() {
+	*x = RaiseSOSResponse{}
+	mi := &file_trip_trip_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RaiseSOSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaiseSOSResponse) ProtoMessage() {}
+
+func (x *RaiseSOSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaiseSOSResponse.ProtoReflect.Descriptor instead.
+func (*RaiseSOSResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RaiseSOSResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+func (x *RaiseSOSResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// PartyLocation is the last position location-service had for a participant
+// when the incident was raised. known is false if there was none.
+type PartyLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Known         bool                   `protobuf:"varint,3,opt,name=known,proto3" json:"known,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         float64                `protobuf:"fixed64,6,opt,name=speed,proto3" json:"speed,omitempty"`
+	Heading       string                 `protobuf:"bytes,7,opt,name=heading,proto3" json:"heading,omitempty"`
+	ReportedAt    string                 `protobuf:"bytes,8,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"` // ISO 8601, as reported by the device
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyLocation) Reset() {
+	*x = PartyLocation{}
+	mi := &file_trip_trip_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyLocation) ProtoMessage() {}
+
+func (x *PartyLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyLocation.ProtoReflect.Descriptor instead.
+func (*PartyLocation) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PartyLocation) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PartyLocation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PartyLocation) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *PartyLocation) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *PartyLocation) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *PartyLocation) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *PartyLocation) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *PartyLocation) GetReportedAt() string {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return ""
+}
+
+type IncidentVehicle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     int32                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	LicensePlate  string                 `protobuf:"bytes,2,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	VehicleType   string                 `protobuf:"bytes,3,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	Seats         int32                  `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentVehicle) Reset() {
+	*x = IncidentVehicle{}
+	mi := &file_trip_trip_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentVehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentVehicle) ProtoMessage() {}
+
+func (x *IncidentVehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentVehicle.ProtoReflect.Descriptor instead.
+func (*IncidentVehicle) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *IncidentVehicle) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *IncidentVehicle) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *IncidentVehicle) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *IncidentVehicle) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+type Incident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	RaisedBy      int32                  `protobuf:"varint,3,opt,name=raised_by,json=raisedBy,proto3" json:"raised_by,omitempty"`
+	RaisedByRole  string                 `protobuf:"bytes,4,opt,name=raised_by_role,json=raisedByRole,proto3" json:"raised_by_role,omitempty"` // passenger or driver
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // OPEN or CLOSED
+	Trip          *Trip                  `protobuf:"bytes,7,opt,name=trip,proto3" json:"trip,omitempty"`     // the trip as it was when SOS was raised
+	Locations     []*PartyLocation       `protobuf:"bytes,8,rep,name=locations,proto3" json:"locations,omitempty"`
+	Vehicle       *IncidentVehicle       `protobuf:"bytes,9,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedBy      int32                  `protobuf:"varint,11,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Resolution    string                 `protobuf:"bytes,12,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	mi := &file_trip_trip_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Incident) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Incident) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *Incident) GetRaisedBy() int32 {
+	if x != nil {
+		return x.RaisedBy
+	}
+	return 0
+}
+
+func (x *Incident) GetRaisedByRole() string {
+	if x != nil {
+		return x.RaisedByRole
+	}
+	return ""
+}
+
+func (x *Incident) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Incident) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Incident) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+func (x *Incident) GetLocations() []*PartyLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *Incident) GetVehicle() *IncidentVehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *Incident) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Incident) GetClosedBy() int32 {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return 0
+}
+
+func (x *Incident) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *Incident) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type IncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncidentId    int64                  `protobuf:"varint,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentRequest) Reset() {
+	*x = IncidentRequest{}
+	mi := &file_trip_trip_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentRequest) ProtoMessage() {}
+
+func (x *IncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentRequest.ProtoReflect.Descriptor instead.
+func (*IncidentRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *IncidentRequest) GetIncidentId() int64 {
+	if x != nil {
+		return x.IncidentId
+	}
+	return 0
+}
+
+type ListIncidentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // OPEN, CLOSED or empty for both
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
+	mi := &file_trip_trip_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsRequest) ProtoMessage() {}
+
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListIncidentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListIncidentsRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incidents     []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	mi := &file_trip_trip_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+type IncidentTrackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncidentId    int64                  `protobuf:"varint,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	AfterId       int64                  `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentTrackRequest) Reset() {
+	*x = IncidentTrackRequest{}
+	mi := &file_trip_trip_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentTrackRequest) ProtoMessage() {}
+
+func (x *IncidentTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentTrackRequest.ProtoReflect.Descriptor instead.
+func (*IncidentTrackRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IncidentTrackRequest) GetIncidentId() int64 {
+	if x != nil {
+		return x.IncidentId
+	}
+	return 0
+}
+
+func (x *IncidentTrackRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *IncidentTrackRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TrackPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Speed         float64                `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	Heading       string                 `protobuf:"bytes,6,opt,name=heading,proto3" json:"heading,omitempty"`
+	ReportedAt    string                 `protobuf:"bytes,7,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackPoint) Reset() {
+	*x = TrackPoint{}
+	mi := &file_trip_trip_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackPoint) ProtoMessage() {}
+
+func (x *TrackPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackPoint.ProtoReflect.Descriptor instead.
+func (*TrackPoint) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TrackPoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *TrackPoint) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *TrackPoint) GetReportedAt() string {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return ""
+}
+
+func (x *TrackPoint) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type IncidentTrackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*TrackPoint          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncidentTrackResponse) Reset() {
+	*x = IncidentTrackResponse{}
+	mi := &file_trip_trip_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncidentTrackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncidentTrackResponse) ProtoMessage() {}
+
+func (x *IncidentTrackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncidentTrackResponse.ProtoReflect.Descriptor instead.
+func (*IncidentTrackResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IncidentTrackResponse) GetPoints() []*TrackPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type CloseIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncidentId    int64                  `protobuf:"varint,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	OperatorId    int32                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Resolution    string                 `protobuf:"bytes,3,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseIncidentRequest) Reset() {
+	*x = CloseIncidentRequest{}
+	mi := &file_trip_trip_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseIncidentRequest) ProtoMessage() {}
+
+func (x *CloseIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseIncidentRequest.ProtoReflect.Descriptor instead.
+func (*CloseIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CloseIncidentRequest) GetIncidentId() int64 {
+	if x != nil {
+		return x.IncidentId
+	}
+	return 0
+}
+
+func (x *CloseIncidentRequest) GetOperatorId() int32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CloseIncidentRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type CreateShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 means the default; capped server-side
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_trip_trip_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateShareLinkRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *CreateShareLinkRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateShareLinkRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// ShareLink is a revocable token for the read-only trip view. The token is
+// only returned when the link is created; the server keeps just its hash.
+type ShareLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_trip_trip_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ShareLink) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShareLink) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ShareLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+type ListShareLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksRequest) Reset() {
+	*x = ListShareLinksRequest{}
+	mi := &file_trip_trip_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksRequest) ProtoMessage() {}
+
+func (x *ListShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListShareLinksRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *ListShareLinksRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListShareLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Links         []*ShareLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShareLinksResponse) Reset() {
+	*x = ListShareLinksResponse{}
+	mi := &file_trip_trip_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShareLinksResponse) ProtoMessage() {}
+
+func (x *ListShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListShareLinksResponse) GetLinks() []*ShareLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type RevokeShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ShareId       int64                  `protobuf:"varint,3,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareLinkRequest) Reset() {
+	*x = RevokeShareLinkRequest{}
+	mi := &file_trip_trip_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareLinkRequest) ProtoMessage() {}
+
+func (x *RevokeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevokeShareLinkRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *RevokeShareLinkRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeShareLinkRequest) GetShareId() int64 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
+type SharedTripRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedTripRequest) Reset() {
+	*x = SharedTripRequest{}
+	mi := &file_trip_trip_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedTripRequest) ProtoMessage() {}
+
+func (x *SharedTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedTripRequest.ProtoReflect.Descriptor instead.
+func (*SharedTripRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SharedTripRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SharedPosition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Heading       string                 `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	ReportedAt    string                 `protobuf:"bytes,4,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedPosition) Reset() {
+	*x = SharedPosition{}
+	mi := &file_trip_trip_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedPosition) ProtoMessage() {}
+
+func (x *SharedPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedPosition.ProtoReflect.Descriptor instead.
+func (*SharedPosition) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SharedPosition) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SharedPosition) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SharedPosition) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *SharedPosition) GetReportedAt() string {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return ""
+}
+
+// SharedTripView is everything a share link reveals. It deliberately leaves
+// out ids, contact details and the fare.
+type SharedTripView struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          TripStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=trip.TripStatus" json:"status,omitempty"`
+	DriverFirstName string                 `protobuf:"bytes,2,opt,name=driver_first_name,json=driverFirstName,proto3" json:"driver_first_name,omitempty"`
+	LicensePlate    string                 `protobuf:"bytes,3,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	VehicleType     string                 `protobuf:"bytes,4,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	DriverPosition  *SharedPosition        `protobuf:"bytes,5,opt,name=driver_position,json=driverPosition,proto3" json:"driver_position,omitempty"`
+	EtaSeconds      int32                  `protobuf:"varint,6,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"` // 0 when unknown
+	EtaTarget       string                 `protobuf:"bytes,7,opt,name=eta_target,json=etaTarget,proto3" json:"eta_target,omitempty"`     // pickup or destination
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SharedTripView) Reset() {
+	*x = SharedTripView{}
+	mi := &file_trip_trip_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedTripView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedTripView) ProtoMessage() {}
+
+func (x *SharedTripView) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedTripView.ProtoReflect.Descriptor instead.
+func (*SharedTripView) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SharedTripView) GetStatus() TripStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TripStatus_STATUS_UNKNOWN
+}
+
+func (x *SharedTripView) GetDriverFirstName() string {
+	if x != nil {
+		return x.DriverFirstName
+	}
+	return ""
+}
+
+func (x *SharedTripView) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *SharedTripView) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *SharedTripView) GetDriverPosition() *SharedPosition {
+	if x != nil {
+		return x.DriverPosition
+	}
+	return nil
+}
+
+func (x *SharedTripView) GetEtaSeconds() int32 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+func (x *SharedTripView) GetEtaTarget() string {
+	if x != nil {
+		return x.EtaTarget
+	}
+	return ""
+}
+
+func (x *SharedTripView) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type AddTipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PassengerId   int32                  `protobuf:"varint,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTipRequest) Reset() {
+	*x = AddTipRequest{}
+	mi := &file_trip_trip_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTipRequest) ProtoMessage() {}
+
+func (x *AddTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTipRequest.ProtoReflect.Descriptor instead.
+func (*AddTipRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddTipRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *AddTipRequest) GetPassengerId() int32 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *AddTipRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TripReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripReceiptRequest) Reset() {
+	*x = TripReceiptRequest{}
+	mi := &file_trip_trip_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripReceiptRequest) ProtoMessage() {}
+
+func (x *TripReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripReceiptRequest.ProtoReflect.Descriptor instead.
+func (*TripReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TripReceiptRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripReceiptRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TripReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PassengerId   int32                  `protobuf:"varint,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DriverId      int32                  `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Distance      float64                `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	Fare          float64                `protobuf:"fixed64,6,opt,name=fare,proto3" json:"fare,omitempty"`
+	Tip           float64                `protobuf:"fixed64,7,opt,name=tip,proto3" json:"tip,omitempty"`
+	Total         float64                `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TippedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=tipped_at,json=tippedAt,proto3" json:"tipped_at,omitempty"`
+	// Set while the passenger can still add a tip.
+	TipDeadline   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=tip_deadline,json=tipDeadline,proto3" json:"tip_deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripReceipt) Reset() {
+	*x = TripReceipt{}
+	mi := &file_trip_trip_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripReceipt) ProtoMessage() {}
+
+func (x *TripReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripReceipt.ProtoReflect.Descriptor instead.
+func (*TripReceipt) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TripReceipt) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetPassengerId() int32 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *TripReceipt) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetTip() float64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TripReceipt) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *TripReceipt) GetTippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TippedAt
+	}
+	return nil
+}
+
+func (x *TripReceipt) GetTipDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TipDeadline
+	}
+	return nil
+}
+
+type DriverOfferReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Offers decided in [from, to). Defaults to the offer rate window before now.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Optional: only this driver.
+	DriverId int32 `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	// Drivers with fewer offers are left out of the list, not the summary.
+	MinOffers int32 `protobuf:"varint,4,opt,name=min_offers,json=minOffers,proto3" json:"min_offers,omitempty"`
+	// Defaults to 50, at most 500.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverOfferReportRequest) Reset() {
+	*x = DriverOfferReportRequest{}
+	mi := &file_trip_trip_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOfferReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOfferReportRequest) ProtoMessage() {}
+
+func (x *DriverOfferReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOfferReportRequest.ProtoReflect.Descriptor instead.
+func (*DriverOfferReportRequest) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DriverOfferReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DriverOfferReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DriverOfferReportRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverOfferReportRequest) GetMinOffers() int32 {
+	if x != nil {
+		return x.MinOffers
+	}
+	return 0
+}
+
+func (x *DriverOfferReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DriverOfferStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DriverId int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	// Offers accepted, rejected or left to time out.
+	Offers   int64 `protobuf:"varint,2,opt,name=offers,proto3" json:"offers,omitempty"`
+	Accepted int64 `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected int64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	TimedOut int64 `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	// Accepted trips the driver cancelled afterwards.
+	Cancelled        int64   `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	AcceptanceRate   float64 `protobuf:"fixed64,7,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`
+	CancellationRate float64 `protobuf:"fixed64,8,opt,name=cancellation_rate,json=cancellationRate,proto3" json:"cancellation_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DriverOfferStats) Reset() {
+	*x = DriverOfferStats{}
+	mi := &file_trip_trip_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOfferStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOfferStats) ProtoMessage() {}
+
+func (x *DriverOfferStats) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOfferStats.ProtoReflect.Descriptor instead.
+func (*DriverOfferStats) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DriverOfferStats) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetOffers() int64 {
+	if x != nil {
+		return x.Offers
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetAccepted() int64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetTimedOut() int64 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetAcceptanceRate() float64 {
+	if x != nil {
+		return x.AcceptanceRate
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetCancellationRate() float64 {
+	if x != nil {
+		return x.CancellationRate
+	}
+	return 0
+}
+
+type DriverOfferReportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	From    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Summary *DriverOfferStats      `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Lowest acceptance rate first.
+	Drivers       []*DriverOfferStats `protobuf:"bytes,4,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverOfferReportResponse) Reset() {
+	*x = DriverOfferReportResponse{}
+	mi := &file_trip_trip_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOfferReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOfferReportResponse) ProtoMessage() {}
+
+func (x *DriverOfferReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_trip_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOfferReportResponse.ProtoReflect.Descriptor instead.
+func (*DriverOfferReportResponse) Descriptor() ([]byte, []int) {
+	return file_trip_trip_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DriverOfferReportResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DriverOfferReportResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DriverOfferReportResponse) GetSummary() *DriverOfferStats {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *DriverOfferReportResponse) GetDrivers() []*DriverOfferStats {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+var File_trip_trip_proto protoreflect.FileDescriptor
+
+const file_trip_trip_proto_rawDesc = "" +
+	"\n" +
+	"\x0ftrip/trip.proto\x12\x04trip\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x06\n" +
+	"\x04Trip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\fpassenger_id\x18\x02 \x01(\x05R\vpassengerId\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\x05R\bdriverId\x12\x1d\n" +
+	"\n" +
+	"origin_lat\x18\x04 \x01(\x01R\toriginLat\x12\x1d\n" +
+	"\n" +
+	"origin_lng\x18\x05 \x01(\x01R\toriginLng\x12\x19\n" +
+	"\bdest_lat\x18\x06 \x01(\x01R\adestLat\x12\x19\n" +
+	"\bdest_lng\x18\a \x01(\x01R\adestLng\x12(\n" +
+	"\x06status\x18\b \x01(\x0e2\x10.trip.TripStatusR\x06status\x12\x1a\n" +
+	"\bdistance\x18\t \x01(\x01R\bdistance\x12\x12\n" +
+	"\x04fare\x18\n" +
+	" \x01(\x01R\x04fare\x12%\n" +
+	"\x0epayment_method\x18\v \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06rating\x18\f \x01(\x05R\x06rating\x12\x16\n" +
+	"\x06review\x18\r \x01(\tR\x06review\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12=\n" +
+	"\fcancelled_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12)\n" +
+	"\x11cancel_by_user_id\x18\x13 \x01(\x05R\x0ecancelByUserId\x12;\n" +
+	"\vaccepted_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAt\x12#\n" +
+	"\rvehicle_class\x18\x15 \x01(\tR\fvehicleClass\"\xeb\x02\n" +
+	"\x11CreateTripRequest\x12!\n" +
+	"\fpassenger_id\x18\x01 \x01(\x05R\vpassengerId\x12\x1d\n" +
+	"\n" +
+	"origin_lat\x18\x02 \x01(\x01R\toriginLat\x12\x1d\n" +
+	"\n" +
+	"origin_lng\x18\x03 \x01(\x01R\toriginLng\x12\x19\n" +
+	"\bdest_lat\x18\x04 \x01(\x01R\adestLat\x12\x19\n" +
+	"\bdest_lng\x18\x05 \x01(\x01R\adestLng\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12&\n" +
+	"\x0forigin_place_id\x18\b \x01(\x05R\roriginPlaceId\x12\"\n" +
+	"\rdest_place_id\x18\t \x01(\x05R\vdestPlaceId\x12#\n" +
+	"\rvehicle_class\x18\n" +
+	" \x01(\tR\fvehicleClass\"P\n" +
+	"\x12CreateTripResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x02R\bduration\"r\n" +
+	"\x11AcceptTripRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\x95\x01\n" +
+	"\x11RejectTripRequest\x12!\n" +
+	"\fpassenger_id\x18\x01 \x01(\x05R\vpassengerId\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\x05R\bdriverId\x12\x17\n" +
+	"\atrip_id\x18\x03 \x01(\x05R\x06tripId\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"K\n" +
+	"\rTripIDRequest\x12!\n" +
+	"\fpassenger_id\x18\x01 \x01(\x05R\vpassengerId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\"B\n" +
+	"\x0eGetTripRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\"9\n" +
+	"\x1aGetSuggestedDriverResponse\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\"7\n" +
+	"\x15GetTripDetailResponse\x12\x1e\n" +
+	"\x04trip\x18\x01 \x01(\v2\n" +
+	".trip.TripR\x04trip\"2\n" +
+	"\x17GetTripsByUserIDRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"1\n" +
+	"\rTripsResponse\x12 \n" +
+	"\x05trips\x18\x01 \x03(\v2\n" +
+	".trip.TripR\x05trips\">\n" +
+	"\x12GetAllTripsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\xa2\x01\n" +
+	"\x17UpdateTripStatusRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\x05R\bdriverId\x12(\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x10.trip.TripStatusR\x06status\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"n\n" +
+	"\x11CancelTripRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\":\n" +
+	"\x06Review\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"m\n" +
+	"\x13SubmitReviewRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12$\n" +
+	"\x06review\x18\x03 \x01(\v2\f.trip.ReviewR\x06review\"=\n" +
+	"\x15GetTripReviewResponse\x12$\n" +
+	"\x06review\x18\x01 \x01(\v2\f.trip.ReviewR\x06review\"E\n" +
+	"\x0fMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Z\n" +
+	"\fPageResponse\x12 \n" +
+	"\x05trips\x18\x01 \x03(\v2\n" +
+	".trip.TripR\x05trips\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"q\n" +
+	"\vBoundingBox\x12\x17\n" +
+	"\amin_lat\x18\x01 \x01(\x01R\x06minLat\x12\x17\n" +
+	"\amin_lng\x18\x02 \x01(\x01R\x06minLng\x12\x17\n" +
+	"\amax_lat\x18\x03 \x01(\x01R\x06maxLat\x12\x17\n" +
+	"\amax_lng\x18\x04 \x01(\x01R\x06maxLng\"\xee\x01\n" +
+	"\x11TripReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x120\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x14.trip.ReportIntervalR\binterval\x12%\n" +
+	"\x04zone\x18\x04 \x01(\v2\x11.trip.BoundingBoxR\x04zone\x12$\n" +
+	"\x0ezone_cell_size\x18\x05 \x01(\x01R\fzoneCellSize\"\xc7\x02\n" +
+	"\tTripStats\x12\x14\n" +
+	"\x05trips\x18\x01 \x01(\x03R\x05trips\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x03R\tcompleted\x12\x1c\n" +
+	"\tcancelled\x18\x03 \x01(\x03R\tcancelled\x12'\n" +
+	"\x0fcompletion_rate\x18\x04 \x01(\x01R\x0ecompletionRate\x12+\n" +
+	"\x11cancellation_rate\x18\x05 \x01(\x01R\x10cancellationRate\x12:\n" +
+	"\x1aavg_wait_to_accept_seconds\x18\x06 \x01(\x01R\x16avgWaitToAcceptSeconds\x12\x19\n" +
+	"\bavg_fare\x18\a \x01(\x01R\aavgFare\x12!\n" +
+	"\favg_distance\x18\b \x01(\x01R\vavgDistance\x12\x18\n" +
+	"\arevenue\x18\t \x01(\x01R\arevenue\"k\n" +
+	"\x10TripReportBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12%\n" +
+	"\x05stats\x18\x02 \x01(\v2\x0f.trip.TripStatsR\x05stats\"Y\n" +
+	"\tZoneStats\x12%\n" +
+	"\x04cell\x18\x01 \x01(\v2\x11.trip.BoundingBoxR\x04cell\x12%\n" +
+	"\x05stats\x18\x02 \x01(\v2\x0f.trip.TripStatsR\x05stats\"m\n" +
+	"\x14PaymentMethodRevenue\x12%\n" +
+	"\x0epayment_method\x18\x01 \x01(\tR\rpaymentMethod\x12\x14\n" +
+	"\x05trips\x18\x02 \x01(\x03R\x05trips\x12\x18\n" +
+	"\arevenue\x18\x03 \x01(\x01R\arevenue\"\xfd\x02\n" +
+	"\x12TripReportResponse\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x120\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x14.trip.ReportIntervalR\binterval\x12)\n" +
+	"\asummary\x18\x04 \x01(\v2\x0f.trip.TripStatsR\asummary\x120\n" +
+	"\abuckets\x18\x05 \x03(\v2\x16.trip.TripReportBucketR\abuckets\x12%\n" +
+	"\x05zones\x18\x06 \x03(\v2\x0f.trip.ZoneStatsR\x05zones\x12U\n" +
+	"\x19revenue_by_payment_method\x18\a \x03(\v2\x1a.trip.PaymentMethodRevenueR\x16revenueByPaymentMethod\"\xac\x02\n" +
+	"\x12ExportTripsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12,\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x10.trip.TripStatusR\bstatuses\x12!\n" +
+	"\fpassenger_id\x18\x04 \x01(\x05R\vpassengerId\x12\x1b\n" +
+	"\tdriver_id\x18\x05 \x01(\x05R\bdriverId\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12%\n" +
+	"\x04zone\x18\a \x01(\v2\x11.trip.BoundingBoxR\x04zone\"\xda\x02\n" +
+	"\vChatMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12\x1b\n" +
+	"\tsender_id\x18\x03 \x01(\x05R\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x04 \x01(\x05R\vrecipientId\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1f\n" +
+	"\vquick_reply\x18\x06 \x01(\tR\n" +
+	"quickReply\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x123\n" +
+	"\aread_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\x83\x01\n" +
+	"\x16SendChatMessageRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\x05R\bsenderId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1f\n" +
+	"\vquick_reply\x18\x04 \x01(\tR\n" +
+	"quickReply\"w\n" +
+	"\x12ChatHistoryRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"D\n" +
+	"\x13ChatHistoryResponse\x12-\n" +
+	"\bmessages\x18\x01 \x03(\v2\x11.trip.ChatMessageR\bmessages\"a\n" +
+	"\x13MarkChatReadRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
+	"\bup_to_id\x18\x03 \x01(\x03R\x06upToId\"0\n" +
+	"\x14MarkChatReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\")\n" +
+	"\x13QuickRepliesRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"2\n" +
+	"\n" +
+	"QuickReply\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"B\n" +
+	"\x14QuickRepliesResponse\x12*\n" +
+	"\areplies\x18\x01 \x03(\v2\x10.trip.QuickReplyR\areplies\"L\n" +
+	"\x18SubscribeTripChatRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xba\x01\n" +
+	"\vChatReceipt\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\x05R\vrecipientId\x12\x18\n" +
+	"\bup_to_id\x18\x03 \x01(\x03R\x06upToId\x12)\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x15.trip.ChatReceiptKindR\x04kind\x12*\n" +
+	"\x02at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"r\n" +
+	"\tChatEvent\x12-\n" +
+	"\amessage\x18\x01 \x01(\v2\x11.trip.ChatMessageH\x00R\amessage\x12-\n" +
+	"\areceipt\x18\x02 \x01(\v2\x11.trip.ChatReceiptH\x00R\areceiptB\a\n" +
+	"\x05event\"W\n" +
+	"\x0fRaiseSOSRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"X\n" +
+	"\x10RaiseSOSResponse\x12*\n" +
+	"\bincident\x18\x01 \x01(\v2\x0e.trip.IncidentR\bincident\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"\xdd\x01\n" +
+	"\rPartyLocation\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x14\n" +
+	"\x05known\x18\x03 \x01(\bR\x05known\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\a \x01(\tR\aheading\x12\x1f\n" +
+	"\vreported_at\x18\b \x01(\tR\n" +
+	"reportedAt\"\x8e\x01\n" +
+	"\x0fIncidentVehicle\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\x05R\tvehicleId\x12#\n" +
+	"\rlicense_plate\x18\x02 \x01(\tR\flicensePlate\x12!\n" +
+	"\fvehicle_type\x18\x03 \x01(\tR\vvehicleType\x12\x14\n" +
+	"\x05seats\x18\x04 \x01(\x05R\x05seats\"\xd7\x03\n" +
+	"\bIncident\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12\x1b\n" +
+	"\traised_by\x18\x03 \x01(\x05R\braisedBy\x12$\n" +
+	"\x0eraised_by_role\x18\x04 \x01(\tR\fraisedByRole\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1e\n" +
+	"\x04trip\x18\a \x01(\v2\n" +
+	".trip.TripR\x04trip\x121\n" +
+	"\tlocations\x18\b \x03(\v2\x13.trip.PartyLocationR\tlocations\x12/\n" +
+	"\avehicle\x18\t \x01(\v2\x15.trip.IncidentVehicleR\avehicle\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tclosed_by\x18\v \x01(\x05R\bclosedBy\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\f \x01(\tR\n" +
+	"resolution\x127\n" +
+	"\tclosed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"2\n" +
+	"\x0fIncidentRequest\x12\x1f\n" +
+	"\vincident_id\x18\x01 \x01(\x03R\n" +
+	"incidentId\"G\n" +
+	"\x14ListIncidentsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\"E\n" +
+	"\x15ListIncidentsResponse\x12,\n" +
+	"\tincidents\x18\x01 \x03(\v2\x0e.trip.IncidentR\tincidents\"h\n" +
+	"\x14IncidentTrackRequest\x12\x1f\n" +
+	"\vincident_id\x18\x01 \x01(\x03R\n" +
+	"incidentId\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xfd\x01\n" +
+	"\n" +
+	"TrackPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05speed\x18\x05 \x01(\x01R\x05speed\x12\x18\n" +
+	"\aheading\x18\x06 \x01(\tR\aheading\x12\x1f\n" +
+	"\vreported_at\x18\a \x01(\tR\n" +
+	"reportedAt\x12;\n" +
+	"\vrecorded_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"A\n" +
+	"\x15IncidentTrackResponse\x12(\n" +
+	"\x06points\x18\x01 \x03(\v2\x10.trip.TrackPointR\x06points\"x\n" +
+	"\x14CloseIncidentRequest\x12\x1f\n" +
+	"\vincident_id\x18\x01 \x01(\x03R\n" +
+	"incidentId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x05R\n" +
+	"operatorId\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\tR\n" +
+	"resolution\"k\n" +
+	"\x16CreateShareLinkRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"\xfb\x01\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"I\n" +
+	"\x15ListShareLinksRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"?\n" +
+	"\x16ListShareLinksResponse\x12%\n" +
+	"\x05links\x18\x01 \x03(\v2\x0f.trip.ShareLinkR\x05links\"e\n" +
+	"\x16RevokeShareLinkRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bshare_id\x18\x03 \x01(\x03R\ashareId\")\n" +
+	"\x11SharedTripRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x85\x01\n" +
+	"\x0eSharedPosition\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\aheading\x18\x03 \x01(\tR\aheading\x12\x1f\n" +
+	"\vreported_at\x18\x04 \x01(\tR\n" +
+	"reportedAt\"\xe8\x02\n" +
+	"\x0eSharedTripView\x12(\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x10.trip.TripStatusR\x06status\x12*\n" +
+	"\x11driver_first_name\x18\x02 \x01(\tR\x0fdriverFirstName\x12#\n" +
+	"\rlicense_plate\x18\x03 \x01(\tR\flicensePlate\x12!\n" +
+	"\fvehicle_type\x18\x04 \x01(\tR\vvehicleType\x12=\n" +
+	"\x0fdriver_position\x18\x05 \x01(\v2\x14.trip.SharedPositionR\x0edriverPosition\x12\x1f\n" +
+	"\veta_seconds\x18\x06 \x01(\x05R\n" +
+	"etaSeconds\x12\x1d\n" +
+	"\n" +
+	"eta_target\x18\a \x01(\tR\tetaTarget\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"c\n" +
+	"\rAddTipRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12!\n" +
+	"\fpassenger_id\x18\x02 \x01(\x05R\vpassengerId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"F\n" +
+	"\x12TripReceiptRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x9c\x03\n" +
+	"\vTripReceipt\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12!\n" +
+	"\fpassenger_id\x18\x02 \x01(\x05R\vpassengerId\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\x05R\bdriverId\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x01R\bdistance\x12\x12\n" +
+	"\x04fare\x18\x06 \x01(\x01R\x04fare\x12\x10\n" +
+	"\x03tip\x18\a \x01(\x01R\x03tip\x12\x14\n" +
+	"\x05total\x18\b \x01(\x01R\x05total\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x127\n" +
+	"\ttipped_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\btippedAt\x12=\n" +
+	"\ftip_deadline\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vtipDeadline\"\xc8\x01\n" +
+	"\x18DriverOfferReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tdriver_id\x18\x03 \x01(\x05R\bdriverId\x12\x1d\n" +
+	"\n" +
+	"min_offers\x18\x04 \x01(\x05R\tminOffers\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x90\x02\n" +
+	"\x10DriverOfferStats\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x16\n" +
+	"\x06offers\x18\x02 \x01(\x03R\x06offers\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\x03R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x03R\brejected\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\x03R\btimedOut\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\x03R\tcancelled\x12'\n" +
+	"\x0facceptance_rate\x18\a \x01(\x01R\x0eacceptanceRate\x12+\n" +
+	"\x11cancellation_rate\x18\b \x01(\x01R\x10cancellationRate\"\xdb\x01\n" +
+	"\x19DriverOfferReportResponse\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x120\n" +
+	"\asummary\x18\x03 \x01(\v2\x16.trip.DriverOfferStatsR\asummary\x120\n" +
+	"\adrivers\x18\x04 \x03(\v2\x16.trip.DriverOfferStatsR\adrivers*h\n" +
+	"\n" +
+	"TripStatus\x12\x12\n" +
+	"\x0eSTATUS_UNKNOWN\x10\x00\x12\r\n" +
+	"\tREQUESTED\x10\x01\x12\f\n" +
+	"\bACCEPTED\x10\x02\x12\v\n" +
+	"\aSTARTED\x10\x03\x12\r\n" +
+	"\tCOMPLETED\x10\x04\x12\r\n" +
+	"\tCANCELLED\x10\x05*d\n" +
+	"\x0eReportInterval\x12\x1f\n" +
+	"\x1bREPORT_INTERVAL_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REPORT_INTERVAL_HOUR\x10\x01\x12\x17\n" +
+	"\x13REPORT_INTERVAL_DAY\x10\x02*?\n" +
+	"\x0fChatReceiptKind\x12\x13\n" +
+	"\x0fRECEIPT_UNKNOWN\x10\x00\x12\r\n" +
+	"\tDELIVERED\x10\x01\x12\b\n" +
+	"\x04READ\x10\x022\xbd\x10\n" +
+	"\vTripService\x12?\n" +
+	"\n" +
+	"CreateTrip\x12\x17.trip.CreateTripRequest\x1a\x18.trip.CreateTripResponse\x12<\n" +
+	"\n" +
+	"AcceptTrip\x12\x17.trip.AcceptTripRequest\x1a\x15.trip.MessageResponse\x12<\n" +
+	"\n" +
+	"RejectTrip\x12\x17.trip.RejectTripRequest\x1a\x15.trip.MessageResponse\x12K\n" +
+	"\x12GetSuggestedDriver\x12\x13.trip.TripIDRequest\x1a .trip.GetSuggestedDriverResponse\x12A\n" +
+	"\rGetTripDetail\x12\x13.trip.TripIDRequest\x1a\x1b.trip.GetTripDetailResponse\x12I\n" +
+	"\x13GetTripsByPassenger\x12\x1d.trip.GetTripsByUserIDRequest\x1a\x13.trip.TripsResponse\x12F\n" +
+	"\x10GetTripsByDriver\x12\x1d.trip.GetTripsByUserIDRequest\x1a\x13.trip.TripsResponse\x12;\n" +
+	"\vGetAllTrips\x12\x18.trip.GetAllTripsRequest\x1a\x12.trip.PageResponse\x12H\n" +
+	"\x10UpdateTripStatus\x12\x1d.trip.UpdateTripStatusRequest\x1a\x15.trip.MessageResponse\x12<\n" +
+	"\n" +
+	"CancelTrip\x12\x17.trip.CancelTripRequest\x1a\x15.trip.MessageResponse\x12@\n" +
+	"\fSubmitReview\x12\x19.trip.SubmitReviewRequest\x1a\x15.trip.MessageResponse\x12A\n" +
+	"\rGetTripReview\x12\x13.trip.TripIDRequest\x1a\x1b.trip.GetTripReviewResponse\x12B\n" +
+	"\rGetTripReport\x12\x17.trip.TripReportRequest\x1a\x18.trip.TripReportResponse\x125\n" +
+	"\vExportTrips\x12\x18.trip.ExportTripsRequest\x1a\n" +
+	".trip.Trip0\x01\x12W\n" +
+	"\x14GetDriverOfferReport\x12\x1e.trip.DriverOfferReportRequest\x1a\x1f.trip.DriverOfferReportResponse\x12B\n" +
+	"\x0fSendChatMessage\x12\x1c.trip.SendChatMessageRequest\x1a\x11.trip.ChatMessage\x12E\n" +
+	"\x0eGetChatHistory\x12\x18.trip.ChatHistoryRequest\x1a\x19.trip.ChatHistoryResponse\x12E\n" +
+	"\fMarkChatRead\x12\x19.trip.MarkChatReadRequest\x1a\x1a.trip.MarkChatReadResponse\x12I\n" +
+	"\x10ListQuickReplies\x12\x19.trip.QuickRepliesRequest\x1a\x1a.trip.QuickRepliesResponse\x12F\n" +
+	"\x11SubscribeTripChat\x12\x1e.trip.SubscribeTripChatRequest\x1a\x0f.trip.ChatEvent0\x01\x129\n" +
+	"\bRaiseSOS\x12\x15.trip.RaiseSOSRequest\x1a\x16.trip.RaiseSOSResponse\x124\n" +
+	"\vGetIncident\x12\x15.trip.IncidentRequest\x1a\x0e.trip.Incident\x12H\n" +
+	"\rListIncidents\x12\x1a.trip.ListIncidentsRequest\x1a\x1b.trip.ListIncidentsResponse\x12K\n" +
+	"\x10GetIncidentTrack\x12\x1a.trip.IncidentTrackRequest\x1a\x1b.trip.IncidentTrackResponse\x12;\n" +
+	"\rCloseIncident\x12\x1a.trip.CloseIncidentRequest\x1a\x0e.trip.Incident\x12@\n" +
+	"\x0fCreateShareLink\x12\x1c.trip.CreateShareLinkRequest\x1a\x0f.trip.ShareLink\x12K\n" +
+	"\x0eListShareLinks\x12\x1b.trip.ListShareLinksRequest\x1a\x1c.trip.ListShareLinksResponse\x12F\n" +
+	"\x0fRevokeShareLink\x12\x1c.trip.RevokeShareLinkRequest\x1a\x15.trip.MessageResponse\x12>\n" +
+	"\rGetSharedTrip\x12\x17.trip.SharedTripRequest\x1a\x14.trip.SharedTripView\x120\n" +
+	"\x06AddTip\x12\x13.trip.AddTipRequest\x1a\x11.trip.TripReceipt\x12=\n" +
+	"\x0eGetTripReceipt\x12\x18.trip.TripReceiptRequest\x1a\x11.trip.TripReceiptB2Z0github.com/OneKeyCoder/UIT-Go-Backend/proto/tripb\x06proto3"
+
+var (
+	file_trip_trip_proto_rawDescOnce sync.Once
+	file_trip_trip_proto_rawDescData []byte
+)
+
+func file_trip_trip_proto_rawDescGZIP() []byte {
+	file_trip_trip_proto_rawDescOnce.Do(func() {
+		file_trip_trip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_trip_trip_proto_rawDesc), len(file_trip_trip_proto_rawDesc)))
+	})
+	return file_trip_trip_proto_rawDescData
+}
+
+var file_trip_trip_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_trip_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_trip_trip_proto_goTypes = []any{
+	(TripStatus)(0),                    // 0: trip.TripStatus
+	(ReportInterval)(0),                // 1: trip.ReportInterval
+	(ChatReceiptKind)(0),               // 2: trip.ChatReceiptKind
+	(*Trip)(nil),                       // 3: trip.Trip
+	(*CreateTripRequest)(nil),          // 4: trip.CreateTripRequest
+	(*CreateTripResponse)(nil),         // 5: trip.CreateTripResponse
+	(*AcceptTripRequest)(nil),          // 6: trip.AcceptTripRequest
+	(*RejectTripRequest)(nil),          // 7: trip.RejectTripRequest
+	(*TripIDRequest)(nil),              // 8: trip.TripIDRequest
+	(*GetTripRequest)(nil),             // 9: trip.GetTripRequest
+	(*GetSuggestedDriverResponse)(nil), // 10: trip.GetSuggestedDriverResponse
+	(*GetTripDetailResponse)(nil),      // 11: trip.GetTripDetailResponse
+	(*GetTripsByUserIDRequest)(nil),    // 12: trip.GetTripsByUserIDRequest
+	(*TripsResponse)(nil),              // 13: trip.TripsResponse
+	(*GetAllTripsRequest)(nil),         // 14: trip.GetAllTripsRequest
+	(*UpdateTripStatusRequest)(nil),    // 15: trip.UpdateTripStatusRequest
+	(*CancelTripRequest)(nil),          // 16: trip.CancelTripRequest
+	(*Review)(nil),                     // 17: trip.Review
+	(*SubmitReviewRequest)(nil),        // 18: trip.SubmitReviewRequest
+	(*GetTripReviewResponse)(nil),      // 19: trip.GetTripReviewResponse
+	(*MessageResponse)(nil),            // 20: trip.MessageResponse
+	(*PageResponse)(nil),               // 21: trip.PageResponse
+	(*BoundingBox)(nil),                // 22: trip.BoundingBox
+	(*TripReportRequest)(nil),          // 23: trip.TripReportRequest
+	(*TripStats)(nil),                  // 24: trip.TripStats
+	(*TripReportBucket)(nil),           // 25: trip.TripReportBucket
+	(*ZoneStats)(nil),                  // 26: trip.ZoneStats
+	(*PaymentMethodRevenue)(nil),       // 27: trip.PaymentMethodRevenue
+	(*TripReportResponse)(nil),         // 28: trip.TripReportResponse
+	(*ExportTripsRequest)(nil),         // 29: trip.ExportTripsRequest
+	(*ChatMessage)(nil),                // 30: trip.ChatMessage
+	(*SendChatMessageRequest)(nil),     // 31: trip.SendChatMessageRequest
+	(*ChatHistoryRequest)(nil),         // 32: trip.ChatHistoryRequest
+	(*ChatHistoryResponse)(nil),        // 33: trip.ChatHistoryResponse
+	(*MarkChatReadRequest)(nil),        // 34: trip.MarkChatReadRequest
+	(*MarkChatReadResponse)(nil),       // 35: trip.MarkChatReadResponse
+	(*QuickRepliesRequest)(nil),        // 36: trip.QuickRepliesRequest
+	(*QuickReply)(nil),                 // 37: trip.QuickReply
+	(*QuickRepliesResponse)(nil),       // 38: trip.QuickRepliesResponse
+	(*SubscribeTripChatRequest)(nil),   // 39: trip.SubscribeTripChatRequest
+	(*ChatReceipt)(nil),                // 40: trip.ChatReceipt
+	(*ChatEvent)(nil),                  // 41: trip.ChatEvent
+	(*RaiseSOSRequest)(nil),            // 42: trip.RaiseSOSRequest
+	(*RaiseSOSResponse)(nil),           // 43: trip.RaiseSOSResponse
+	(*PartyLocation)(nil),              // 44: trip.PartyLocation
+	(*IncidentVehicle)(nil),            // 45: trip.IncidentVehicle
+	(*Incident)(nil),                   // 46: trip.Incident
+	(*IncidentRequest)(nil),            // 47: trip.IncidentRequest
+	(*ListIncidentsRequest)(nil),       // 48: trip.ListIncidentsRequest
+	(*ListIncidentsResponse)(nil),      // 49: trip.ListIncidentsResponse
+	(*IncidentTrackRequest)(nil),       // 50: trip.IncidentTrackRequest
+	(*TrackPoint)(nil),                 // 51: trip.TrackPoint
+	(*IncidentTrackResponse)(nil),      // 52: trip.IncidentTrackResponse
+	(*CloseIncidentRequest)(nil),       // 53: trip.CloseIncidentRequest
+	(*CreateShareLinkRequest)(nil),     // 54: trip.CreateShareLinkRequest
+	(*ShareLink)(nil),                  // 55: trip.ShareLink
+	(*ListShareLinksRequest)(nil),      // 56: trip.ListShareLinksRequest
+	(*ListShareLinksResponse)(nil),     // 57: trip.ListShareLinksResponse
+	(*RevokeShareLinkRequest)(nil),     // 58: trip.RevokeShareLinkRequest
+	(*SharedTripRequest)(nil),          // 59: trip.SharedTripRequest
+	(*SharedPosition)(nil),             // 60: trip.SharedPosition
+	(*SharedTripView)(nil),             // 61: trip.SharedTripView
+	(*AddTipRequest)(nil),              // 62: trip.AddTipRequest
+	(*TripReceiptRequest)(nil),         // 63: trip.TripReceiptRequest
+	(*TripReceipt)(nil),                // 64: trip.TripReceipt
+	(*DriverOfferReportRequest)(nil),   // 65: trip.DriverOfferReportRequest
+	(*DriverOfferStats)(nil),           // 66: trip.DriverOfferStats
+	(*DriverOfferReportResponse)(nil),  // 67: trip.DriverOfferReportResponse
+	(*timestamppb.Timestamp)(nil),      // 68: google.protobuf.Timestamp
+}
+var file_trip_trip_proto_depIdxs = []int32{
+	0,  // 0: trip.Trip.status:type_name -> trip.TripStatus
+	68, // 1: trip.Trip.created_at:type_name -> google.protobuf.Timestamp
+	68, // 2: trip.Trip.updated_at:type_name -> google.protobuf.Timestamp
+	68, // 3: trip.Trip.started_at:type_name -> google.protobuf.Timestamp
+	68, // 4: trip.Trip.completed_at:type_name -> google.protobuf.Timestamp
+	68, // 5: trip.Trip.cancelled_at:type_name -> google.protobuf.Timestamp
+	68, // 6: trip.Trip.accepted_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: trip.CreateTripResponse.trip:type_name -> trip.Trip
+	3,  // 8: trip.GetTripDetailResponse.trip:type_name -> trip.Trip
+	3,  // 9: trip.TripsResponse.trips:type_name -> trip.Trip
+	0,  // 10: trip.UpdateTripStatusRequest.status:type_name -> trip.TripStatus
+	17, // 11: trip.SubmitReviewRequest.review:type_name -> trip.Review
+	17, // 12: trip.GetTripReviewResponse.review:type_name -> trip.Review
+	3,  // 13: trip.PageResponse.trips:type_name -> trip.Trip
+	68, // 14: trip.TripReportRequest.from:type_name -> google.protobuf.Timestamp
+	68, // 15: trip.TripReportRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 16: trip.TripReportRequest.interval:type_name -> trip.ReportInterval
+	22, // 17: trip.TripReportRequest.zone:type_name -> trip.BoundingBox
+	68, // 18: trip.TripReportBucket.start:type_name -> google.protobuf.Timestamp
+	24, // 19: trip.TripReportBucket.stats:type_name -> trip.TripStats
+	22, // 20: trip.ZoneStats.cell:type_name -> trip.BoundingBox
+	24, // 21: trip.ZoneStats.stats:type_name -> trip.TripStats
+	68, // 22: trip.TripReportResponse.from:type_name -> google.protobuf.Timestamp
+	68, // 23: trip.TripReportResponse.to:type_name -> google.protobuf.Timestamp
+	1,  // 24: trip.TripReportResponse.interval:type_name -> trip.ReportInterval
+	24, // 25: trip.TripReportResponse.summary:type_name -> trip.TripStats
+	25, // 26: trip.TripReportResponse.buckets:type_name -> trip.TripReportBucket
+	26, // 27: trip.TripReportResponse.zones:type_name -> trip.ZoneStats
+	27, // 28: trip.TripReportResponse.revenue_by_payment_method:type_name -> trip.PaymentMethodRevenue
+	68, // 29: trip.ExportTripsRequest.from:type_name -> google.protobuf.Timestamp
+	68, // 30: trip.ExportTripsRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 31: trip.ExportTripsRequest.statuses:type_name -> trip.TripStatus
+	22, // 32: trip.ExportTripsRequest.zone:type_name -> trip.BoundingBox
+	68, // 33: trip.ChatMessage.created_at:type_name -> google.protobuf.Timestamp
+	68, // 34: trip.ChatMessage.delivered_at:type_name -> google.protobuf.Timestamp
+	68, // 35: trip.ChatMessage.read_at:type_name -> google.protobuf.Timestamp
+	30, // 36: trip.ChatHistoryResponse.messages:type_name -> trip.ChatMessage
+	37, // 37: trip.QuickRepliesResponse.replies:type_name -> trip.QuickReply
+	2,  // 38: trip.ChatReceipt.kind:type_name -> trip.ChatReceiptKind
+	68, // 39: trip.ChatReceipt.at:type_name -> google.protobuf.Timestamp
+	30, // 40: trip.ChatEvent.message:type_name -> trip.ChatMessage
+	40, // 41: trip.ChatEvent.receipt:type_name -> trip.ChatReceipt
+	46, // 42: trip.RaiseSOSResponse.incident:type_name -> trip.Incident
+	3,  // 43: trip.Incident.trip:type_name -> trip.Trip
+	44, // 44: trip.Incident.locations:type_name -> trip.PartyLocation
+	45, // 45: trip.Incident.vehicle:type_name -> trip.IncidentVehicle
+	68, // 46: trip.Incident.created_at:type_name -> google.protobuf.Timestamp
+	68, // 47: trip.Incident.closed_at:type_name -> google.protobuf.Timestamp
+	46, // 48: trip.ListIncidentsResponse.incidents:type_name -> trip.Incident
+	68, // 49: trip.TrackPoint.recorded_at:type_name -> google.protobuf.Timestamp
+	51, // 50: trip.IncidentTrackResponse.points:type_name -> trip.TrackPoint
+	68, // 51: trip.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	68, // 52: trip.ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	68, // 53: trip.ShareLink.revoked_at:type_name -> google.protobuf.Timestamp
+	55, // 54: trip.ListShareLinksResponse.links:type_name -> trip.ShareLink
+	0,  // 55: trip.SharedTripView.status:type_name -> trip.TripStatus
+	60, // 56: trip.SharedTripView.driver_position:type_name -> trip.SharedPosition
+	68, // 57: trip.SharedTripView.expires_at:type_name -> google.protobuf.Timestamp
+	68, // 58: trip.TripReceipt.completed_at:type_name -> google.protobuf.Timestamp
+	68, // 59: trip.TripReceipt.tipped_at:type_name -> google.protobuf.Timestamp
+	68, // 60: trip.TripReceipt.tip_deadline:type_name -> google.protobuf.Timestamp
+	68, // 61: trip.DriverOfferReportRequest.from:type_name -> google.protobuf.Timestamp
+	68, // 62: trip.DriverOfferReportRequest.to:type_name -> google.protobuf.Timestamp
+	68, // 63: trip.DriverOfferReportResponse.from:type_name -> google.protobuf.Timestamp
+	68, // 64: trip.DriverOfferReportResponse.to:type_name -> google.protobuf.Timestamp
+	66, // 65: trip.DriverOfferReportResponse.summary:type_name -> trip.DriverOfferStats
+	66, // 66: trip.DriverOfferReportResponse.drivers:type_name -> trip.DriverOfferStats
+	4,  // 67: trip.TripService.CreateTrip:input_type -> trip.CreateTripRequest
+	6,  // 68: trip.TripService.AcceptTrip:input_type -> trip.AcceptTripRequest
+	7,  // 69: trip.TripService.RejectTrip:input_type -> trip.RejectTripRequest
+	8,  // 70: trip.TripService.GetSuggestedDriver:input_type -> trip.TripIDRequest
+	8,  // 71: trip.TripService.GetTripDetail:input_type -> trip.TripIDRequest
+	12, // 72: trip.TripService.GetTripsByPassenger:input_type -> trip.GetTripsByUserIDRequest
+	12, // 73: trip.TripService.GetTripsByDriver:input_type -> trip.GetTripsByUserIDRequest
+	14, // 74: trip.TripService.GetAllTrips:input_type -> trip.GetAllTripsRequest
+	15, // 75: trip.TripService.UpdateTripStatus:input_type -> trip.UpdateTripStatusRequest
+	16, // 76: trip.TripService.CancelTrip:input_type -> trip.CancelTripRequest
+	18, // 77: trip.TripService.SubmitReview:input_type -> trip.SubmitReviewRequest
+	8,  // 78: trip.TripService.GetTripReview:input_type -> trip.TripIDRequest
+	23, // 79: trip.TripService.GetTripReport:input_type -> trip.TripReportRequest
+	29, // 80: trip.TripService.ExportTrips:input_type -> trip.ExportTripsRequest
+	65, // 81: trip.TripService.GetDriverOfferReport:input_type -> trip.DriverOfferReportRequest
+	31, // 82: trip.TripService.SendChatMessage:input_type -> trip.SendChatMessageRequest
+	32, // 83: trip.TripService.GetChatHistory:input_type -> trip.ChatHistoryRequest
+	34, // 84: trip.TripService.MarkChatRead:input_type -> trip.MarkChatReadRequest
+	36, // 85: trip.TripService.ListQuickReplies:input_type -> trip.QuickRepliesRequest
+	39, // 86: trip.TripService.SubscribeTripChat:input_type -> trip.SubscribeTripChatRequest
+	42, // 87: trip.TripService.RaiseSOS:input_type -> trip.RaiseSOSRequest
+	47, // 88: trip.TripService.GetIncident:input_type -> trip.IncidentRequest
+	48, // 89: trip.TripService.ListIncidents:input_type -> trip.ListIncidentsRequest
+	50, // 90: trip.TripService.GetIncidentTrack:input_type -> trip.IncidentTrackRequest
+	53, // 91: trip.TripService.CloseIncident:input_type -> trip.CloseIncidentRequest
+	54, // 92: trip.TripService.CreateShareLink:input_type -> trip.CreateShareLinkRequest
+	56, // 93: trip.TripService.ListShareLinks:input_type -> trip.ListShareLinksRequest
+	58, // 94: trip.TripService.RevokeShareLink:input_type -> trip.RevokeShareLinkRequest
+	59, // 95: trip.TripService.GetSharedTrip:input_type -> trip.SharedTripRequest
+	62, // 96: trip.TripService.AddTip:input_type -> trip.AddTipRequest
+	63, // 97: trip.TripService.GetTripReceipt:input_type -> trip.TripReceiptRequest
+	5,  // 98: trip.TripService.CreateTrip:output_type -> trip.CreateTripResponse
+	20, // 99: trip.TripService.AcceptTrip:output_type -> trip.MessageResponse
+	20, // 100: trip.TripService.RejectTrip:output_type -> trip.MessageResponse
+	10, // 101: trip.TripService.GetSuggestedDriver:output_type -> trip.GetSuggestedDriverResponse
+	11, // 102: trip.TripService.GetTripDetail:output_type -> trip.GetTripDetailResponse
+	13, // 103: trip.TripService.GetTripsByPassenger:output_type -> trip.TripsResponse
+	13, // 104: trip.TripService.GetTripsByDriver:output_type -> trip.TripsResponse
+	21, // 105: trip.TripService.GetAllTrips:output_type -> trip.PageResponse
+	20, // 106: trip.TripService.UpdateTripStatus:output_type -> trip.MessageResponse
+	20, // 107: trip.TripService.CancelTrip:output_type -> trip.MessageResponse
+	20, // 108: trip.TripService.SubmitReview:output_type -> trip.MessageResponse
+	19, // 109: trip.TripService.GetTripReview:output_type -> trip.GetTripReviewResponse
+	28, // 110: trip.TripService.GetTripReport:output_type -> trip.TripReportResponse
+	3,  // 111: trip.TripService.ExportTrips:output_type -> trip.Trip
+	67, // 112: trip.TripService.GetDriverOfferReport:output_type -> trip.DriverOfferReportResponse
+	30, // 113: trip.TripService.SendChatMessage:output_type -> trip.ChatMessage
+	33, // 114: trip.TripService.GetChatHistory:output_type -> trip.ChatHistoryResponse
+	35, // 115: trip.TripService.MarkChatRead:output_type -> trip.MarkChatReadResponse
+	38, // 116: trip.TripService.ListQuickReplies:output_type -> trip.QuickRepliesResponse
+	41, // 117: trip.TripService.SubscribeTripChat:output_type -> trip.ChatEvent
+	43, // 118: trip.TripService.RaiseSOS:output_type -> trip.RaiseSOSResponse
+	46, // 119: trip.TripService.GetIncident:output_type -> trip.Incident
+	49, // 120: trip.TripService.ListIncidents:output_type -> trip.ListIncidentsResponse
+	52, // 121: trip.TripService.GetIncidentTrack:output_type -> trip.IncidentTrackResponse
+	46, // 122: trip.TripService.CloseIncident:output_type -> trip.Incident
+	55, // 123: trip.TripService.CreateShareLink:output_type -> trip.ShareLink
+	57, // 124: trip.TripService.ListShareLinks:output_type -> trip.ListShareLinksResponse
+	20, // 125: trip.TripService.RevokeShareLink:output_type -> trip.MessageResponse
+	61, // 126: trip.TripService.GetSharedTrip:output_type -> trip.SharedTripView
+	64, // 127: trip.TripService.AddTip:output_type -> trip.TripReceipt
+	64, // 128: trip.TripService.GetTripReceipt:output_type -> trip.TripReceipt
+	98, // [98:129] is the sub-list for method output_type
+	67, // [67:98] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
+}
+
+func init() { file_trip_trip_proto_init() }
+func file_trip_trip_proto_init() {
 	if File_trip_trip_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_trip_trip_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trip); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTripRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTripResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptTripRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RejectTripRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TripIDRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTripRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSuggestedDriverResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTripDetailResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTripsByUserIDRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TripsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTripsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTripStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelTripRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Review); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitReviewRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTripReviewResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_trip_trip_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PageResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	file_trip_trip_proto_msgTypes[38].OneofWrappers = []any{
+		(*ChatEvent_Message)(nil),
+		(*ChatEvent_Receipt)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_trip_trip_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   19,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_trip_trip_proto_rawDesc), len(file_trip_trip_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1827,7 +5266,6 @@ func file_trip_trip_proto_init() {
 		MessageInfos:      file_trip_trip_proto_msgTypes,
 	}.Build()
 	File_trip_trip_proto = out.File
-	file_trip_trip_proto_rawDesc = nil
 	file_trip_trip_proto_goTypes = nil
 	file_trip_trip_proto_depIdxs = nil
 }
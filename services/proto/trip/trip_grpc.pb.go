@@ -19,18 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TripService_CreateTrip_FullMethodName          = "/trip.TripService/CreateTrip"
-	TripService_AcceptTrip_FullMethodName          = "/trip.TripService/AcceptTrip"
-	TripService_RejectTrip_FullMethodName          = "/trip.TripService/RejectTrip"
-	TripService_GetSuggestedDriver_FullMethodName  = "/trip.TripService/GetSuggestedDriver"
-	TripService_GetTripDetail_FullMethodName       = "/trip.TripService/GetTripDetail"
-	TripService_GetTripsByPassenger_FullMethodName = "/trip.TripService/GetTripsByPassenger"
-	TripService_GetTripsByDriver_FullMethodName    = "/trip.TripService/GetTripsByDriver"
-	TripService_GetAllTrips_FullMethodName         = "/trip.TripService/GetAllTrips"
-	TripService_UpdateTripStatus_FullMethodName    = "/trip.TripService/UpdateTripStatus"
-	TripService_CancelTrip_FullMethodName          = "/trip.TripService/CancelTrip"
-	TripService_SubmitReview_FullMethodName        = "/trip.TripService/SubmitReview"
-	TripService_GetTripReview_FullMethodName       = "/trip.TripService/GetTripReview"
+	TripService_CreateTrip_FullMethodName           = "/trip.TripService/CreateTrip"
+	TripService_AcceptTrip_FullMethodName           = "/trip.TripService/AcceptTrip"
+	TripService_RejectTrip_FullMethodName           = "/trip.TripService/RejectTrip"
+	TripService_GetSuggestedDriver_FullMethodName   = "/trip.TripService/GetSuggestedDriver"
+	TripService_GetTripDetail_FullMethodName        = "/trip.TripService/GetTripDetail"
+	TripService_GetTripsByPassenger_FullMethodName  = "/trip.TripService/GetTripsByPassenger"
+	TripService_GetTripsByDriver_FullMethodName     = "/trip.TripService/GetTripsByDriver"
+	TripService_GetAllTrips_FullMethodName          = "/trip.TripService/GetAllTrips"
+	TripService_UpdateTripStatus_FullMethodName     = "/trip.TripService/UpdateTripStatus"
+	TripService_CancelTrip_FullMethodName           = "/trip.TripService/CancelTrip"
+	TripService_SubmitReview_FullMethodName         = "/trip.TripService/SubmitReview"
+	TripService_GetTripReview_FullMethodName        = "/trip.TripService/GetTripReview"
+	TripService_GetTripReport_FullMethodName        = "/trip.TripService/GetTripReport"
+	TripService_ExportTrips_FullMethodName          = "/trip.TripService/ExportTrips"
+	TripService_GetDriverOfferReport_FullMethodName = "/trip.TripService/GetDriverOfferReport"
+	TripService_SendChatMessage_FullMethodName      = "/trip.TripService/SendChatMessage"
+	TripService_GetChatHistory_FullMethodName       = "/trip.TripService/GetChatHistory"
+	TripService_MarkChatRead_FullMethodName         = "/trip.TripService/MarkChatRead"
+	TripService_ListQuickReplies_FullMethodName     = "/trip.TripService/ListQuickReplies"
+	TripService_SubscribeTripChat_FullMethodName    = "/trip.TripService/SubscribeTripChat"
+	TripService_RaiseSOS_FullMethodName             = "/trip.TripService/RaiseSOS"
+	TripService_GetIncident_FullMethodName          = "/trip.TripService/GetIncident"
+	TripService_ListIncidents_FullMethodName        = "/trip.TripService/ListIncidents"
+	TripService_GetIncidentTrack_FullMethodName     = "/trip.TripService/GetIncidentTrack"
+	TripService_CloseIncident_FullMethodName        = "/trip.TripService/CloseIncident"
+	TripService_CreateShareLink_FullMethodName      = "/trip.TripService/CreateShareLink"
+	TripService_ListShareLinks_FullMethodName       = "/trip.TripService/ListShareLinks"
+	TripService_RevokeShareLink_FullMethodName      = "/trip.TripService/RevokeShareLink"
+	TripService_GetSharedTrip_FullMethodName        = "/trip.TripService/GetSharedTrip"
+	TripService_AddTip_FullMethodName               = "/trip.TripService/AddTip"
+	TripService_GetTripReceipt_FullMethodName       = "/trip.TripService/GetTripReceipt"
 )
 
 // TripServiceClient is the client API for TripService service.
@@ -49,6 +68,36 @@ type TripServiceClient interface {
 	CancelTrip(ctx context.Context, in *CancelTripRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	GetTripReview(ctx context.Context, in *TripIDRequest, opts ...grpc.CallOption) (*GetTripReviewResponse, error)
+	// Aggregated trip statistics for operations dashboards (admin only).
+	GetTripReport(ctx context.Context, in *TripReportRequest, opts ...grpc.CallOption) (*TripReportResponse, error)
+	// Streams every trip matching the filter, ordered by id (admin only).
+	ExportTrips(ctx context.Context, in *ExportTripsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Trip], error)
+	// Per-driver offer outcomes and acceptance/cancellation rates (admin only).
+	GetDriverOfferReport(ctx context.Context, in *DriverOfferReportRequest, opts ...grpc.CallOption) (*DriverOfferReportResponse, error)
+	// In-trip chat between the passenger and the driver.
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessage, error)
+	GetChatHistory(ctx context.Context, in *ChatHistoryRequest, opts ...grpc.CallOption) (*ChatHistoryResponse, error)
+	MarkChatRead(ctx context.Context, in *MarkChatReadRequest, opts ...grpc.CallOption) (*MarkChatReadResponse, error)
+	ListQuickReplies(ctx context.Context, in *QuickRepliesRequest, opts ...grpc.CallOption) (*QuickRepliesResponse, error)
+	// Pushes new messages and receipts until the trip ends or the caller leaves.
+	SubscribeTripChat(ctx context.Context, in *SubscribeTripChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error)
+	// Safety incidents. RaiseSOS is called by a participant during an active
+	// trip; the rest are for safety operators.
+	RaiseSOS(ctx context.Context, in *RaiseSOSRequest, opts ...grpc.CallOption) (*RaiseSOSResponse, error)
+	GetIncident(ctx context.Context, in *IncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	GetIncidentTrack(ctx context.Context, in *IncidentTrackRequest, opts ...grpc.CallOption) (*IncidentTrackResponse, error)
+	CloseIncident(ctx context.Context, in *CloseIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+	// Shareable live trip links. The passenger mints and revokes tokens;
+	// GetSharedTrip serves whoever holds one and checks nothing else.
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	GetSharedTrip(ctx context.Context, in *SharedTripRequest, opts ...grpc.CallOption) (*SharedTripView, error)
+	// Post-trip tipping and receipts. A tip is charged to the trip's payment
+	// method and credited in full to the driver.
+	AddTip(ctx context.Context, in *AddTipRequest, opts ...grpc.CallOption) (*TripReceipt, error)
+	GetTripReceipt(ctx context.Context, in *TripReceiptRequest, opts ...grpc.CallOption) (*TripReceipt, error)
 }
 
 type tripServiceClient struct {
@@ -179,6 +228,214 @@ func (c *tripServiceClient) GetTripReview(ctx context.Context, in *TripIDRequest
 	return out, nil
 }
 
+func (c *tripServiceClient) GetTripReport(ctx context.Context, in *TripReportRequest, opts ...grpc.CallOption) (*TripReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripReportResponse)
+	err := c.cc.Invoke(ctx, TripService_GetTripReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ExportTrips(ctx context.Context, in *ExportTripsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Trip], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TripService_ServiceDesc.Streams[0], TripService_ExportTrips_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTripsRequest, Trip]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_ExportTripsClient = grpc.ServerStreamingClient[Trip]
+
+func (c *tripServiceClient) GetDriverOfferReport(ctx context.Context, in *DriverOfferReportRequest, opts ...grpc.CallOption) (*DriverOfferReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverOfferReportResponse)
+	err := c.cc.Invoke(ctx, TripService_GetDriverOfferReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*ChatMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatMessage)
+	err := c.cc.Invoke(ctx, TripService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetChatHistory(ctx context.Context, in *ChatHistoryRequest, opts ...grpc.CallOption) (*ChatHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatHistoryResponse)
+	err := c.cc.Invoke(ctx, TripService_GetChatHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) MarkChatRead(ctx context.Context, in *MarkChatReadRequest, opts ...grpc.CallOption) (*MarkChatReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkChatReadResponse)
+	err := c.cc.Invoke(ctx, TripService_MarkChatRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListQuickReplies(ctx context.Context, in *QuickRepliesRequest, opts ...grpc.CallOption) (*QuickRepliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuickRepliesResponse)
+	err := c.cc.Invoke(ctx, TripService_ListQuickReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) SubscribeTripChat(ctx context.Context, in *SubscribeTripChatRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TripService_ServiceDesc.Streams[1], TripService_SubscribeTripChat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeTripChatRequest, ChatEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_SubscribeTripChatClient = grpc.ServerStreamingClient[ChatEvent]
+
+func (c *tripServiceClient) RaiseSOS(ctx context.Context, in *RaiseSOSRequest, opts ...grpc.CallOption) (*RaiseSOSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RaiseSOSResponse)
+	err := c.cc.Invoke(ctx, TripService_RaiseSOS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetIncident(ctx context.Context, in *IncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, TripService_GetIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, TripService_ListIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetIncidentTrack(ctx context.Context, in *IncidentTrackRequest, opts ...grpc.CallOption) (*IncidentTrackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentTrackResponse)
+	err := c.cc.Invoke(ctx, TripService_GetIncidentTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CloseIncident(ctx context.Context, in *CloseIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, TripService_CloseIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, TripService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, TripService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, TripService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetSharedTrip(ctx context.Context, in *SharedTripRequest, opts ...grpc.CallOption) (*SharedTripView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedTripView)
+	err := c.cc.Invoke(ctx, TripService_GetSharedTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) AddTip(ctx context.Context, in *AddTipRequest, opts ...grpc.CallOption) (*TripReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripReceipt)
+	err := c.cc.Invoke(ctx, TripService_AddTip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetTripReceipt(ctx context.Context, in *TripReceiptRequest, opts ...grpc.CallOption) (*TripReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripReceipt)
+	err := c.cc.Invoke(ctx, TripService_GetTripReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TripServiceServer is the server API for TripService service.
 // All implementations must embed UnimplementedTripServiceServer
 // for forward compatibility.
@@ -195,6 +452,36 @@ type TripServiceServer interface {
 	CancelTrip(context.Context, *CancelTripRequest) (*MessageResponse, error)
 	SubmitReview(context.Context, *SubmitReviewRequest) (*MessageResponse, error)
 	GetTripReview(context.Context, *TripIDRequest) (*GetTripReviewResponse, error)
+	// Aggregated trip statistics for operations dashboards (admin only).
+	GetTripReport(context.Context, *TripReportRequest) (*TripReportResponse, error)
+	// Streams every trip matching the filter, ordered by id (admin only).
+	ExportTrips(*ExportTripsRequest, grpc.ServerStreamingServer[Trip]) error
+	// Per-driver offer outcomes and acceptance/cancellation rates (admin only).
+	GetDriverOfferReport(context.Context, *DriverOfferReportRequest) (*DriverOfferReportResponse, error)
+	// In-trip chat between the passenger and the driver.
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessage, error)
+	GetChatHistory(context.Context, *ChatHistoryRequest) (*ChatHistoryResponse, error)
+	MarkChatRead(context.Context, *MarkChatReadRequest) (*MarkChatReadResponse, error)
+	ListQuickReplies(context.Context, *QuickRepliesRequest) (*QuickRepliesResponse, error)
+	// Pushes new messages and receipts until the trip ends or the caller leaves.
+	SubscribeTripChat(*SubscribeTripChatRequest, grpc.ServerStreamingServer[ChatEvent]) error
+	// Safety incidents. RaiseSOS is called by a participant during an active
+	// trip; the rest are for safety operators.
+	RaiseSOS(context.Context, *RaiseSOSRequest) (*RaiseSOSResponse, error)
+	GetIncident(context.Context, *IncidentRequest) (*Incident, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	GetIncidentTrack(context.Context, *IncidentTrackRequest) (*IncidentTrackResponse, error)
+	CloseIncident(context.Context, *CloseIncidentRequest) (*Incident, error)
+	// Shareable live trip links. The passenger mints and revokes tokens;
+	// GetSharedTrip serves whoever holds one and checks nothing else.
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*MessageResponse, error)
+	GetSharedTrip(context.Context, *SharedTripRequest) (*SharedTripView, error)
+	// Post-trip tipping and receipts. A tip is charged to the trip's payment
+	// method and credited in full to the driver.
+	AddTip(context.Context, *AddTipRequest) (*TripReceipt, error)
+	GetTripReceipt(context.Context, *TripReceiptRequest) (*TripReceipt, error)
 	mustEmbedUnimplementedTripServiceServer()
 }
 
@@ -241,6 +528,63 @@ func (UnimplementedTripServiceServer) SubmitReview(context.Context, *SubmitRevie
 func (UnimplementedTripServiceServer) GetTripReview(context.Context, *TripIDRequest) (*GetTripReviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTripReview not implemented")
 }
+func (UnimplementedTripServiceServer) GetTripReport(context.Context, *TripReportRequest) (*TripReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripReport not implemented")
+}
+func (UnimplementedTripServiceServer) ExportTrips(*ExportTripsRequest, grpc.ServerStreamingServer[Trip]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTrips not implemented")
+}
+func (UnimplementedTripServiceServer) GetDriverOfferReport(context.Context, *DriverOfferReportRequest) (*DriverOfferReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverOfferReport not implemented")
+}
+func (UnimplementedTripServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*ChatMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
+}
+func (UnimplementedTripServiceServer) GetChatHistory(context.Context, *ChatHistoryRequest) (*ChatHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatHistory not implemented")
+}
+func (UnimplementedTripServiceServer) MarkChatRead(context.Context, *MarkChatReadRequest) (*MarkChatReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkChatRead not implemented")
+}
+func (UnimplementedTripServiceServer) ListQuickReplies(context.Context, *QuickRepliesRequest) (*QuickRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuickReplies not implemented")
+}
+func (UnimplementedTripServiceServer) SubscribeTripChat(*SubscribeTripChatRequest, grpc.ServerStreamingServer[ChatEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTripChat not implemented")
+}
+func (UnimplementedTripServiceServer) RaiseSOS(context.Context, *RaiseSOSRequest) (*RaiseSOSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaiseSOS not implemented")
+}
+func (UnimplementedTripServiceServer) GetIncident(context.Context, *IncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncident not implemented")
+}
+func (UnimplementedTripServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (UnimplementedTripServiceServer) GetIncidentTrack(context.Context, *IncidentTrackRequest) (*IncidentTrackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentTrack not implemented")
+}
+func (UnimplementedTripServiceServer) CloseIncident(context.Context, *CloseIncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseIncident not implemented")
+}
+func (UnimplementedTripServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedTripServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedTripServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedTripServiceServer) GetSharedTrip(context.Context, *SharedTripRequest) (*SharedTripView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedTrip not implemented")
+}
+func (UnimplementedTripServiceServer) AddTip(context.Context, *AddTipRequest) (*TripReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTip not implemented")
+}
+func (UnimplementedTripServiceServer) GetTripReceipt(context.Context, *TripReceiptRequest) (*TripReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripReceipt not implemented")
+}
 func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
 func (UnimplementedTripServiceServer) testEmbeddedByValue()                     {}
 
@@ -478,6 +822,334 @@ func _TripService_GetTripReview_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TripService_GetTripReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTripReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetTripReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTripReport(ctx, req.(*TripReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ExportTrips_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTripsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TripServiceServer).ExportTrips(m, &grpc.GenericServerStream[ExportTripsRequest, Trip]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_ExportTripsServer = grpc.ServerStreamingServer[Trip]
+
+func _TripService_GetDriverOfferReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverOfferReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetDriverOfferReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetDriverOfferReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetDriverOfferReport(ctx, req.(*DriverOfferReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetChatHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetChatHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetChatHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetChatHistory(ctx, req.(*ChatHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_MarkChatRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkChatReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).MarkChatRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_MarkChatRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).MarkChatRead(ctx, req.(*MarkChatReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListQuickReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListQuickReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListQuickReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListQuickReplies(ctx, req.(*QuickRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_SubscribeTripChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTripChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TripServiceServer).SubscribeTripChat(m, &grpc.GenericServerStream[SubscribeTripChatRequest, ChatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_SubscribeTripChatServer = grpc.ServerStreamingServer[ChatEvent]
+
+func _TripService_RaiseSOS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaiseSOSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).RaiseSOS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_RaiseSOS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).RaiseSOS(ctx, req.(*RaiseSOSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetIncident(ctx, req.(*IncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetIncidentTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetIncidentTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetIncidentTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetIncidentTrack(ctx, req.(*IncidentTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CloseIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CloseIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_CloseIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CloseIncident(ctx, req.(*CloseIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_RevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetSharedTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharedTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetSharedTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetSharedTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetSharedTrip(ctx, req.(*SharedTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_AddTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).AddTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_AddTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).AddTip(ctx, req.(*AddTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetTripReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTripReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetTripReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTripReceipt(ctx, req.(*TripReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -533,7 +1205,86 @@ var TripService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTripReview",
 			Handler:    _TripService_GetTripReview_Handler,
 		},
+		{
+			MethodName: "GetTripReport",
+			Handler:    _TripService_GetTripReport_Handler,
+		},
+		{
+			MethodName: "GetDriverOfferReport",
+			Handler:    _TripService_GetDriverOfferReport_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _TripService_SendChatMessage_Handler,
+		},
+		{
+			MethodName: "GetChatHistory",
+			Handler:    _TripService_GetChatHistory_Handler,
+		},
+		{
+			MethodName: "MarkChatRead",
+			Handler:    _TripService_MarkChatRead_Handler,
+		},
+		{
+			MethodName: "ListQuickReplies",
+			Handler:    _TripService_ListQuickReplies_Handler,
+		},
+		{
+			MethodName: "RaiseSOS",
+			Handler:    _TripService_RaiseSOS_Handler,
+		},
+		{
+			MethodName: "GetIncident",
+			Handler:    _TripService_GetIncident_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _TripService_ListIncidents_Handler,
+		},
+		{
+			MethodName: "GetIncidentTrack",
+			Handler:    _TripService_GetIncidentTrack_Handler,
+		},
+		{
+			MethodName: "CloseIncident",
+			Handler:    _TripService_CloseIncident_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _TripService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _TripService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _TripService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedTrip",
+			Handler:    _TripService_GetSharedTrip_Handler,
+		},
+		{
+			MethodName: "AddTip",
+			Handler:    _TripService_AddTip_Handler,
+		},
+		{
+			MethodName: "GetTripReceipt",
+			Handler:    _TripService_GetTripReceipt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTrips",
+			Handler:       _TripService_ExportTrips_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTripChat",
+			Handler:       _TripService_SubscribeTripChat_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "trip/trip.proto",
 }
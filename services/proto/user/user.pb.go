@@ -1256,31 +1256,2149 @@ func (x *CreateVehicleResponse) GetVehicle() *Vehicle {
 }
 
 type UpdateVehicleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId    int32                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	LicensePlate string                 `protobuf:"bytes,2,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	VehicleType  string                 `protobuf:"bytes,3,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	Seats        int32                  `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// verify marks the vehicle verified. Changing the plate, type or seats
+	// without it clears an earlier verification.
+	Verify        bool `protobuf:"varint,6,opt,name=verify,proto3" json:"verify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateVehicleRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+type UpdateVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Vehicle       *Vehicle               `protobuf:"bytes,3,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleResponse) Reset() {
+	*x = UpdateVehicleResponse{}
+	mi := &file_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleResponse) ProtoMessage() {}
+
+func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateVehicleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateVehicleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateVehicleResponse) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type DeleteVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     int32                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleRequest) Reset() {
+	*x = DeleteVehicleRequest{}
+	mi := &file_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleRequest) ProtoMessage() {}
+
+func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteVehicleRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+type DeleteVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleResponse) Reset() {
+	*x = DeleteVehicleResponse{}
+	mi := &file_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleResponse) ProtoMessage() {}
+
+func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteVehicleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVehicleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TicketMessage is one entry in a ticket's conversation
+type TicketMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      int32                  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorRole    string                 `protobuf:"bytes,2,opt,name=author_role,json=authorRole,proto3" json:"author_role,omitempty"` // passenger, driver or admin
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketMessage) Reset() {
+	*x = TicketMessage{}
+	mi := &file_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketMessage) ProtoMessage() {}
+
+func (x *TicketMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketMessage.ProtoReflect.Descriptor instead.
+func (*TicketMessage) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TicketMessage) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *TicketMessage) GetAuthorRole() string {
+	if x != nil {
+		return x.AuthorRole
+	}
+	return ""
+}
+
+func (x *TicketMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TicketMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// SupportTicket is a complaint or lost-item report about a trip
+type SupportTicket struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TicketId        int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	TripId          int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	OpenedBy        int32                  `protobuf:"varint,3,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	OpenerRole      string                 `protobuf:"bytes,4,opt,name=opener_role,json=openerRole,proto3" json:"opener_role,omitempty"` // passenger or driver
+	Category        string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Subject         string                 `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	ItemDescription string                 `protobuf:"bytes,7,opt,name=item_description,json=itemDescription,proto3" json:"item_description,omitempty"` // only for lost_item tickets
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                          // open, pending or resolved
+	AssigneeId      int32                  `protobuf:"varint,9,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`               // 0 while unassigned
+	Messages        []*TicketMessage       `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt      string                 `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SupportTicket) Reset() {
+	*x = SupportTicket{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportTicket) ProtoMessage() {}
+
+func (x *SupportTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportTicket.ProtoReflect.Descriptor instead.
+func (*SupportTicket) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SupportTicket) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *SupportTicket) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *SupportTicket) GetOpenedBy() int32 {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return 0
+}
+
+func (x *SupportTicket) GetOpenerRole() string {
+	if x != nil {
+		return x.OpenerRole
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetItemDescription() string {
+	if x != nil {
+		return x.ItemDescription
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetAssigneeId() int32 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *SupportTicket) GetMessages() []*TicketMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SupportTicket) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SupportTicket) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+// OpenTicketRequest is sent by the gateway after it has checked that the user
+// took part in the trip in the given role.
+type OpenTicketRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TripId          int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role            string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Category        string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Subject         string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message         string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	ItemDescription string                 `protobuf:"bytes,7,opt,name=item_description,json=itemDescription,proto3" json:"item_description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OpenTicketRequest) Reset() {
+	*x = OpenTicketRequest{}
+	mi := &file_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenTicketRequest) ProtoMessage() {}
+
+func (x *OpenTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenTicketRequest.ProtoReflect.Descriptor instead.
+func (*OpenTicketRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OpenTicketRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *OpenTicketRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OpenTicketRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *OpenTicketRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *OpenTicketRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *OpenTicketRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OpenTicketRequest) GetItemDescription() string {
+	if x != nil {
+		return x.ItemDescription
+	}
+	return ""
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *GetTicketRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetTicketRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type ListTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenedBy      int32                  `protobuf:"varint,1,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	AssigneeId    int32                  `protobuf:"varint,3,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	TripId        int32                  `protobuf:"varint,4,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsRequest) ProtoMessage() {}
+
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTicketsRequest) GetOpenedBy() int32 {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return 0
+}
+
+func (x *ListTicketsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTicketsRequest) GetAssigneeId() int32 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+func (x *ListTicketsRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type ListTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tickets       []*SupportTicket       `protobuf:"bytes,3,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsResponse) Reset() {
+	*x = ListTicketsResponse{}
+	mi := &file_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsResponse) ProtoMessage() {}
+
+func (x *ListTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTicketsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListTicketsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListTicketsResponse) GetTickets() []*SupportTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ListTicketsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type AddTicketMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTicketMessageRequest) Reset() {
+	*x = AddTicketMessageRequest{}
+	mi := &file_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTicketMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTicketMessageRequest) ProtoMessage() {}
+
+func (x *AddTicketMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTicketMessageRequest.ProtoReflect.Descriptor instead.
+func (*AddTicketMessageRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddTicketMessageRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *AddTicketMessageRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddTicketMessageRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *AddTicketMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateTicketStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketStatusRequest) Reset() {
+	*x = UpdateTicketStatusRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketStatusRequest) ProtoMessage() {}
+
+func (x *UpdateTicketStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTicketStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateTicketStatusRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *UpdateTicketStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AssignTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	AssigneeId    int32                  `protobuf:"varint,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTicketRequest) Reset() {
+	*x = AssignTicketRequest{}
+	mi := &file_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTicketRequest) ProtoMessage() {}
+
+func (x *AssignTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTicketRequest.ProtoReflect.Descriptor instead.
+func (*AssignTicketRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AssignTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *AssignTicketRequest) GetAssigneeId() int32 {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return 0
+}
+
+type TicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Ticket        *SupportTicket         `protobuf:"bytes,3,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketResponse) Reset() {
+	*x = TicketResponse{}
+	mi := &file_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketResponse) ProtoMessage() {}
+
+func (x *TicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketResponse.ProtoReflect.Descriptor instead.
+func (*TicketResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TicketResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TicketResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TicketResponse) GetTicket() *SupportTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type CreditDriverEarningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	TripId        int32                  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // tip
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditDriverEarningRequest) Reset() {
+	*x = CreditDriverEarningRequest{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditDriverEarningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditDriverEarningRequest) ProtoMessage() {}
+
+func (x *CreditDriverEarningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditDriverEarningRequest.ProtoReflect.Descriptor instead.
+func (*CreditDriverEarningRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreditDriverEarningRequest) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *CreditDriverEarningRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *CreditDriverEarningRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreditDriverEarningRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CreditDriverEarningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Credited      bool                   `protobuf:"varint,3,opt,name=credited,proto3" json:"credited,omitempty"` // false if this earning had already been credited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditDriverEarningResponse) Reset() {
+	*x = CreditDriverEarningResponse{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditDriverEarningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditDriverEarningResponse) ProtoMessage() {}
+
+func (x *CreditDriverEarningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditDriverEarningResponse.ProtoReflect.Descriptor instead.
+func (*CreditDriverEarningResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreditDriverEarningResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreditDriverEarningResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreditDriverEarningResponse) GetCredited() bool {
+	if x != nil {
+		return x.Credited
+	}
+	return false
+}
+
+// SavedPlace is a passenger's favourite address. kind is home, work or
+// custom; a user has at most one home and one work place.
+type SavedPlace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaceId       int32                  `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedPlace) Reset() {
+	*x = SavedPlace{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedPlace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedPlace) ProtoMessage() {}
+
+func (x *SavedPlace) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedPlace.ProtoReflect.Descriptor instead.
+func (*SavedPlace) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SavedPlace) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *SavedPlace) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavedPlace) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SavedPlace) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SavedPlace) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SavedPlace) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SavedPlace) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SavedPlace) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SavedPlace) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateSavedPlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"` // required for custom places
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedPlaceRequest) Reset() {
+	*x = CreateSavedPlaceRequest{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedPlaceRequest) ProtoMessage() {}
+
+func (x *CreateSavedPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedPlaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateSavedPlaceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSavedPlaceRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaceRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateSavedPlaceRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateSavedPlaceRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type GetSavedPlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaceId       int32                  `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedPlaceRequest) Reset() {
+	*x = GetSavedPlaceRequest{}
+	mi := &file_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedPlaceRequest) ProtoMessage() {}
+
+func (x *GetSavedPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedPlaceRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSavedPlaceRequest) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *GetSavedPlaceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListSavedPlacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedPlacesRequest) Reset() {
+	*x = ListSavedPlacesRequest{}
+	mi := &file_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedPlacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPlacesRequest) ProtoMessage() {}
+
+func (x *ListSavedPlacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPlacesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedPlacesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSavedPlacesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UpdateSavedPlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaceId       int32                  `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedPlaceRequest) Reset() {
+	*x = UpdateSavedPlaceRequest{}
+	mi := &file_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedPlaceRequest) ProtoMessage() {}
+
+func (x *UpdateSavedPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedPlaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateSavedPlaceRequest) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *UpdateSavedPlaceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateSavedPlaceRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UpdateSavedPlaceRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateSavedPlaceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateSavedPlaceRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateSavedPlaceRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type DeleteSavedPlaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaceId       int32                  `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedPlaceRequest) Reset() {
+	*x = DeleteSavedPlaceRequest{}
+	mi := &file_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedPlaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedPlaceRequest) ProtoMessage() {}
+
+func (x *DeleteSavedPlaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedPlaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedPlaceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteSavedPlaceRequest) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *DeleteSavedPlaceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SavedPlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Place         *SavedPlace            `protobuf:"bytes,3,opt,name=place,proto3" json:"place,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedPlaceResponse) Reset() {
+	*x = SavedPlaceResponse{}
+	mi := &file_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedPlaceResponse) ProtoMessage() {}
+
+func (x *SavedPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedPlaceResponse.ProtoReflect.Descriptor instead.
+func (*SavedPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SavedPlaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SavedPlaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SavedPlaceResponse) GetPlace() *SavedPlace {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+type ListSavedPlacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Places        []*SavedPlace          `protobuf:"bytes,3,rep,name=places,proto3" json:"places,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedPlacesResponse) Reset() {
+	*x = ListSavedPlacesResponse{}
+	mi := &file_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedPlacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPlacesResponse) ProtoMessage() {}
+
+func (x *ListSavedPlacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPlacesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedPlacesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSavedPlacesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListSavedPlacesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSavedPlacesResponse) GetPlaces() []*SavedPlace {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *ListSavedPlacesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type DeleteSavedPlaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedPlaceResponse) Reset() {
+	*x = DeleteSavedPlaceResponse{}
+	mi := &file_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedPlaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedPlaceResponse) ProtoMessage() {}
+
+func (x *DeleteSavedPlaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedPlaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedPlaceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteSavedPlaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSavedPlaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UserBlock is user_id's block of blocked_user_id.
+type UserBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId int32                  `protobuf:"varint,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserBlock) Reset() {
+	*x = UserBlock{}
+	mi := &file_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBlock) ProtoMessage() {}
+
+func (x *UserBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBlock.ProtoReflect.Descriptor instead.
+func (*UserBlock) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UserBlock) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserBlock) GetBlockedUserId() int32 {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return 0
+}
+
+func (x *UserBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserBlock) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId int32                  `protobuf:"varint,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // optional, only shown to user_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BlockUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() int32 {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return 0
+}
+
+func (x *BlockUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Block         *UserBlock             `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BlockUserResponse) GetBlock() *UserBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId int32                  `protobuf:"varint,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnblockUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() int32 {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return 0
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnblockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnblockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListBlockedUsersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Blocks        []*UserBlock           `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBlockedUsersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListBlockedUsersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersResponse) GetBlocks() []*UserBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type FilterBlockedUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleId     int32                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
-	LicensePlate  string                 `protobuf:"bytes,2,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
-	VehicleType   string                 `protobuf:"bytes,3,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
-	Seats         int32                  `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CandidateIds  []int32                `protobuf:"varint,2,rep,packed,name=candidate_ids,json=candidateIds,proto3" json:"candidate_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVehicleRequest) Reset() {
-	*x = UpdateVehicleRequest{}
-	mi := &file_user_proto_msgTypes[20]
+func (x *FilterBlockedUsersRequest) Reset() {
+	*x = FilterBlockedUsersRequest{}
+	mi := &file_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVehicleRequest) String() string {
+func (x *FilterBlockedUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVehicleRequest) ProtoMessage() {}
+func (*FilterBlockedUsersRequest) ProtoMessage() {}
 
-func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+func (x *FilterBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,70 +3409,274 @@ func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FilterBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*FilterBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UpdateVehicleRequest) GetVehicleId() int32 {
+func (x *FilterBlockedUsersRequest) GetUserId() int32 {
 	if x != nil {
-		return x.VehicleId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateVehicleRequest) GetLicensePlate() string {
+func (x *FilterBlockedUsersRequest) GetCandidateIds() []int32 {
 	if x != nil {
-		return x.LicensePlate
+		return x.CandidateIds
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateVehicleRequest) GetVehicleType() string {
+type FilterBlockedUsersResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// allowed_ids are the candidates with no block either way, in the order
+	// they were given.
+	AllowedIds    []int32 `protobuf:"varint,3,rep,packed,name=allowed_ids,json=allowedIds,proto3" json:"allowed_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterBlockedUsersResponse) Reset() {
+	*x = FilterBlockedUsersResponse{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterBlockedUsersResponse) ProtoMessage() {}
+
+func (x *FilterBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
 	if x != nil {
-		return x.VehicleType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*FilterBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FilterBlockedUsersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FilterBlockedUsersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateVehicleRequest) GetSeats() int32 {
+func (x *FilterBlockedUsersResponse) GetAllowedIds() []int32 {
 	if x != nil {
-		return x.Seats
+		return x.AllowedIds
+	}
+	return nil
+}
+
+// DriverOfferStats are a driver's offer outcomes over the last window_days.
+// offers counts accepted, rejected and timed out offers; cancelled counts
+// accepted trips the driver cancelled afterwards.
+type DriverOfferStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DriverId         int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Offers           int64                  `protobuf:"varint,2,opt,name=offers,proto3" json:"offers,omitempty"`
+	Accepted         int64                  `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected         int64                  `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	TimedOut         int64                  `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Cancelled        int64                  `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	AcceptanceRate   float64                `protobuf:"fixed64,7,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`
+	CancellationRate float64                `protobuf:"fixed64,8,opt,name=cancellation_rate,json=cancellationRate,proto3" json:"cancellation_rate,omitempty"`
+	WindowDays       int32                  `protobuf:"varint,9,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	AsOf             string                 `protobuf:"bytes,10,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // RFC 3339
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DriverOfferStats) Reset() {
+	*x = DriverOfferStats{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverOfferStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverOfferStats) ProtoMessage() {}
+
+func (x *DriverOfferStats) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverOfferStats.ProtoReflect.Descriptor instead.
+func (*DriverOfferStats) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DriverOfferStats) GetDriverId() int32 {
+	if x != nil {
+		return x.DriverId
 	}
 	return 0
 }
 
-func (x *UpdateVehicleRequest) GetStatus() string {
+func (x *DriverOfferStats) GetOffers() int64 {
 	if x != nil {
-		return x.Status
+		return x.Offers
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetAccepted() int64 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetTimedOut() int64 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetCancelled() int64 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetAcceptanceRate() float64 {
+	if x != nil {
+		return x.AcceptanceRate
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetCancellationRate() float64 {
+	if x != nil {
+		return x.CancellationRate
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *DriverOfferStats) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
 	}
 	return ""
 }
 
-type UpdateVehicleResponse struct {
+type RecordDriverOfferStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *DriverOfferStats      `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordDriverOfferStatsRequest) Reset() {
+	*x = RecordDriverOfferStatsRequest{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordDriverOfferStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordDriverOfferStatsRequest) ProtoMessage() {}
+
+func (x *RecordDriverOfferStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordDriverOfferStatsRequest.ProtoReflect.Descriptor instead.
+func (*RecordDriverOfferStatsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RecordDriverOfferStatsRequest) GetStats() *DriverOfferStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type RecordDriverOfferStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Vehicle       *Vehicle               `protobuf:"bytes,3,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	Applied       bool                   `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"` // false if newer stats were already stored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVehicleResponse) Reset() {
-	*x = UpdateVehicleResponse{}
-	mi := &file_user_proto_msgTypes[21]
+func (x *RecordDriverOfferStatsResponse) Reset() {
+	*x = RecordDriverOfferStatsResponse{}
+	mi := &file_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVehicleResponse) String() string {
+func (x *RecordDriverOfferStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVehicleResponse) ProtoMessage() {}
+func (*RecordDriverOfferStatsResponse) ProtoMessage() {}
 
-func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+func (x *RecordDriverOfferStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1365,54 +3687,54 @@ func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVehicleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVehicleResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use RecordDriverOfferStatsResponse.ProtoReflect.Descriptor instead.
+func (*RecordDriverOfferStatsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UpdateVehicleResponse) GetSuccess() bool {
+func (x *RecordDriverOfferStatsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateVehicleResponse) GetMessage() string {
+func (x *RecordDriverOfferStatsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateVehicleResponse) GetVehicle() *Vehicle {
+func (x *RecordDriverOfferStatsResponse) GetApplied() bool {
 	if x != nil {
-		return x.Vehicle
+		return x.Applied
 	}
-	return nil
+	return false
 }
 
-type DeleteVehicleRequest struct {
+type GetDriverProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleId     int32                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DriverId      int32                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVehicleRequest) Reset() {
-	*x = DeleteVehicleRequest{}
-	mi := &file_user_proto_msgTypes[22]
+func (x *GetDriverProfileRequest) Reset() {
+	*x = GetDriverProfileRequest{}
+	mi := &file_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVehicleRequest) String() string {
+func (x *GetDriverProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVehicleRequest) ProtoMessage() {}
+func (*GetDriverProfileRequest) ProtoMessage() {}
 
-func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+func (x *GetDriverProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1423,41 +3745,45 @@ func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetDriverProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DeleteVehicleRequest) GetVehicleId() int32 {
+func (x *GetDriverProfileRequest) GetDriverId() int32 {
 	if x != nil {
-		return x.VehicleId
+		return x.DriverId
 	}
 	return 0
 }
 
-type DeleteVehicleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type GetDriverProfileResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Success  bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message  string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Driver   *User                  `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Vehicles []*Vehicle             `protobuf:"bytes,4,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	// Unset until the driver's first offer was decided.
+	OfferStats    *DriverOfferStats `protobuf:"bytes,5,opt,name=offer_stats,json=offerStats,proto3" json:"offer_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVehicleResponse) Reset() {
-	*x = DeleteVehicleResponse{}
-	mi := &file_user_proto_msgTypes[23]
+func (x *GetDriverProfileResponse) Reset() {
+	*x = GetDriverProfileResponse{}
+	mi := &file_user_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVehicleResponse) String() string {
+func (x *GetDriverProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVehicleResponse) ProtoMessage() {}
+func (*GetDriverProfileResponse) ProtoMessage() {}
 
-func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+func (x *GetDriverProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,25 +3794,46 @@ func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetDriverProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteVehicleResponse) GetSuccess() bool {
+func (x *GetDriverProfileResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteVehicleResponse) GetMessage() string {
+func (x *GetDriverProfileResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
+func (x *GetDriverProfileResponse) GetDriver() *User {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *GetDriverProfileResponse) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *GetDriverProfileResponse) GetOfferStats() *DriverOfferStats {
+	if x != nil {
+		return x.OfferStats
+	}
+	return nil
+}
+
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
@@ -1591,14 +3938,15 @@ const file_user_proto_rawDesc = "" +
 	"\x15CreateVehicleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\avehicle\x18\x03 \x01(\v2\r.user.VehicleR\avehicle\"\xab\x01\n" +
+	"\avehicle\x18\x03 \x01(\v2\r.user.VehicleR\avehicle\"\xc3\x01\n" +
 	"\x14UpdateVehicleRequest\x12\x1d\n" +
 	"\n" +
 	"vehicle_id\x18\x01 \x01(\x05R\tvehicleId\x12#\n" +
 	"\rlicense_plate\x18\x02 \x01(\tR\flicensePlate\x12!\n" +
 	"\fvehicle_type\x18\x03 \x01(\tR\vvehicleType\x12\x14\n" +
 	"\x05seats\x18\x04 \x01(\x05R\x05seats\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"t\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06verify\x18\x06 \x01(\bR\x06verify\"t\n" +
 	"\x15UpdateVehicleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
@@ -1608,7 +3956,197 @@ const file_user_proto_rawDesc = "" +
 	"vehicle_id\x18\x01 \x01(\x05R\tvehicleId\"K\n" +
 	"\x15DeleteVehicleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xac\x06\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x80\x01\n" +
+	"\rTicketMessage\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\x05R\bauthorId\x12\x1f\n" +
+	"\vauthor_role\x18\x02 \x01(\tR\n" +
+	"authorRole\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xad\x03\n" +
+	"\rSupportTicket\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12\x1b\n" +
+	"\topened_by\x18\x03 \x01(\x05R\bopenedBy\x12\x1f\n" +
+	"\vopener_role\x18\x04 \x01(\tR\n" +
+	"openerRole\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x18\n" +
+	"\asubject\x18\x06 \x01(\tR\asubject\x12)\n" +
+	"\x10item_description\x18\a \x01(\tR\x0fitemDescription\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1f\n" +
+	"\vassignee_id\x18\t \x01(\x05R\n" +
+	"assigneeId\x12/\n" +
+	"\bmessages\x18\n" +
+	" \x03(\v2\x13.user.TicketMessageR\bmessages\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\r \x01(\tR\n" +
+	"resolvedAt\"\xd4\x01\n" +
+	"\x11OpenTicketRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12)\n" +
+	"\x10item_description\x18\a \x01(\tR\x0fitemDescription\"c\n" +
+	"\x10GetTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\"\x83\x01\n" +
+	"\x12ListTicketsRequest\x12\x1b\n" +
+	"\topened_by\x18\x01 \x01(\x05R\bopenedBy\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vassignee_id\x18\x03 \x01(\x05R\n" +
+	"assigneeId\x12\x17\n" +
+	"\atrip_id\x18\x04 \x01(\x05R\x06tripId\"\x99\x01\n" +
+	"\x13ListTicketsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\atickets\x18\x03 \x03(\v2\x13.user.SupportTicketR\atickets\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"~\n" +
+	"\x17AddTicketMessageRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bis_admin\x18\x03 \x01(\bR\aisAdmin\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"P\n" +
+	"\x19UpdateTicketStatusRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"S\n" +
+	"\x13AssignTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x1f\n" +
+	"\vassignee_id\x18\x02 \x01(\x05R\n" +
+	"assigneeId\"q\n" +
+	"\x0eTicketResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x06ticket\x18\x03 \x01(\v2\x13.user.SupportTicketR\x06ticket\"~\n" +
+	"\x1aCreditDriverEarningRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x17\n" +
+	"\atrip_id\x18\x02 \x01(\x05R\x06tripId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"m\n" +
+	"\x1bCreditDriverEarningResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bcredited\x18\x03 \x01(\bR\bcredited\"\xfc\x01\n" +
+	"\n" +
+	"SavedPlace\x12\x19\n" +
+	"\bplace_id\x18\x01 \x01(\x05R\aplaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xb0\x01\n" +
+	"\x17CreateSavedPlaceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\"J\n" +
+	"\x14GetSavedPlaceRequest\x12\x19\n" +
+	"\bplace_id\x18\x01 \x01(\x05R\aplaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"1\n" +
+	"\x16ListSavedPlacesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\xcb\x01\n" +
+	"\x17UpdateSavedPlaceRequest\x12\x19\n" +
+	"\bplace_id\x18\x01 \x01(\x05R\aplaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\"M\n" +
+	"\x17DeleteSavedPlaceRequest\x12\x19\n" +
+	"\bplace_id\x18\x01 \x01(\x05R\aplaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"p\n" +
+	"\x12SavedPlaceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x05place\x18\x03 \x01(\v2\x10.user.SavedPlaceR\x05place\"\x98\x01\n" +
+	"\x17ListSavedPlacesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\x06places\x18\x03 \x03(\v2\x10.user.SavedPlaceR\x06places\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"N\n" +
+	"\x18DeleteSavedPlaceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\x01\n" +
+	"\tUserBlock\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\x05R\rblockedUserId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"k\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\x05R\rblockedUserId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"n\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x05block\x18\x03 \x01(\v2\x0f.user.UserBlockR\x05block\"U\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12&\n" +
+	"\x0fblocked_user_id\x18\x02 \x01(\x05R\rblockedUserId\"I\n" +
+	"\x13UnblockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x17ListBlockedUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\x98\x01\n" +
+	"\x18ListBlockedUsersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x06blocks\x18\x03 \x03(\v2\x0f.user.UserBlockR\x06blocks\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\"Y\n" +
+	"\x19FilterBlockedUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
+	"\rcandidate_ids\x18\x02 \x03(\x05R\fcandidateIds\"q\n" +
+	"\x1aFilterBlockedUsersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vallowed_ids\x18\x03 \x03(\x05R\n" +
+	"allowedIds\"\xc6\x02\n" +
+	"\x10DriverOfferStats\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\x12\x16\n" +
+	"\x06offers\x18\x02 \x01(\x03R\x06offers\x12\x1a\n" +
+	"\baccepted\x18\x03 \x01(\x03R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x03R\brejected\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\x03R\btimedOut\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\x03R\tcancelled\x12'\n" +
+	"\x0facceptance_rate\x18\a \x01(\x01R\x0eacceptanceRate\x12+\n" +
+	"\x11cancellation_rate\x18\b \x01(\x01R\x10cancellationRate\x12\x1f\n" +
+	"\vwindow_days\x18\t \x01(\x05R\n" +
+	"windowDays\x12\x13\n" +
+	"\x05as_of\x18\n" +
+	" \x01(\tR\x04asOf\"M\n" +
+	"\x1dRecordDriverOfferStatsRequest\x12,\n" +
+	"\x05stats\x18\x01 \x01(\v2\x16.user.DriverOfferStatsR\x05stats\"n\n" +
+	"\x1eRecordDriverOfferStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\bR\aapplied\"6\n" +
+	"\x17GetDriverProfileRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x05R\bdriverId\"\xd6\x01\n" +
+	"\x18GetDriverProfileResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x06driver\x18\x03 \x01(\v2\n" +
+	".user.UserR\x06driver\x12)\n" +
+	"\bvehicles\x18\x04 \x03(\v2\r.user.VehicleR\bvehicles\x127\n" +
+	"\voffer_stats\x18\x05 \x01(\v2\x16.user.DriverOfferStatsR\n" +
+	"offerStats2\x85\x11\n" +
 	"\vUserService\x12B\n" +
 	"\vGetUserById\x12\x18.user.GetUserByIdRequest\x1a\x19.user.GetUserByIdResponse\x12B\n" +
 	"\vGetAllUsers\x12\x18.user.GetAllUsersRequest\x1a\x19.user.GetAllUsersResponse\x12?\n" +
@@ -1623,7 +4161,26 @@ const file_user_proto_rawDesc = "" +
 	"\x0eGetAllVehicles\x12\x1b.user.GetAllVehiclesRequest\x1a\x1c.user.GetAllVehiclesResponse\x12H\n" +
 	"\rCreateVehicle\x12\x1a.user.CreateVehicleRequest\x1a\x1b.user.CreateVehicleResponse\x12H\n" +
 	"\rUpdateVehicle\x12\x1a.user.UpdateVehicleRequest\x1a\x1b.user.UpdateVehicleResponse\x12H\n" +
-	"\rDeleteVehicle\x12\x1a.user.DeleteVehicleRequest\x1a\x1b.user.DeleteVehicleResponseB2Z0github.com/OneKeyCoder/UIT-Go-Backend/proto/userb\x06proto3"
+	"\rDeleteVehicle\x12\x1a.user.DeleteVehicleRequest\x1a\x1b.user.DeleteVehicleResponse\x12;\n" +
+	"\n" +
+	"OpenTicket\x12\x17.user.OpenTicketRequest\x1a\x14.user.TicketResponse\x129\n" +
+	"\tGetTicket\x12\x16.user.GetTicketRequest\x1a\x14.user.TicketResponse\x12B\n" +
+	"\vListTickets\x12\x18.user.ListTicketsRequest\x1a\x19.user.ListTicketsResponse\x12G\n" +
+	"\x10AddTicketMessage\x12\x1d.user.AddTicketMessageRequest\x1a\x14.user.TicketResponse\x12K\n" +
+	"\x12UpdateTicketStatus\x12\x1f.user.UpdateTicketStatusRequest\x1a\x14.user.TicketResponse\x12?\n" +
+	"\fAssignTicket\x12\x19.user.AssignTicketRequest\x1a\x14.user.TicketResponse\x12Z\n" +
+	"\x13CreditDriverEarning\x12 .user.CreditDriverEarningRequest\x1a!.user.CreditDriverEarningResponse\x12K\n" +
+	"\x10CreateSavedPlace\x12\x1d.user.CreateSavedPlaceRequest\x1a\x18.user.SavedPlaceResponse\x12E\n" +
+	"\rGetSavedPlace\x12\x1a.user.GetSavedPlaceRequest\x1a\x18.user.SavedPlaceResponse\x12N\n" +
+	"\x0fListSavedPlaces\x12\x1c.user.ListSavedPlacesRequest\x1a\x1d.user.ListSavedPlacesResponse\x12K\n" +
+	"\x10UpdateSavedPlace\x12\x1d.user.UpdateSavedPlaceRequest\x1a\x18.user.SavedPlaceResponse\x12Q\n" +
+	"\x10DeleteSavedPlace\x12\x1d.user.DeleteSavedPlaceRequest\x1a\x1e.user.DeleteSavedPlaceResponse\x12<\n" +
+	"\tBlockUser\x12\x16.user.BlockUserRequest\x1a\x17.user.BlockUserResponse\x12B\n" +
+	"\vUnblockUser\x12\x18.user.UnblockUserRequest\x1a\x19.user.UnblockUserResponse\x12Q\n" +
+	"\x10ListBlockedUsers\x12\x1d.user.ListBlockedUsersRequest\x1a\x1e.user.ListBlockedUsersResponse\x12W\n" +
+	"\x12FilterBlockedUsers\x12\x1f.user.FilterBlockedUsersRequest\x1a .user.FilterBlockedUsersResponse\x12c\n" +
+	"\x16RecordDriverOfferStats\x12#.user.RecordDriverOfferStatsRequest\x1a$.user.RecordDriverOfferStatsResponse\x12Q\n" +
+	"\x10GetDriverProfile\x12\x1d.user.GetDriverProfileRequest\x1a\x1e.user.GetDriverProfileResponseB2Z0github.com/OneKeyCoder/UIT-Go-Backend/proto/userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -1637,32 +4194,67 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_user_proto_goTypes = []any{
-	(*User)(nil),                        // 0: user.User
-	(*Vehicle)(nil),                     // 1: user.Vehicle
-	(*GetUserByIdRequest)(nil),          // 2: user.GetUserByIdRequest
-	(*GetUserByIdResponse)(nil),         // 3: user.GetUserByIdResponse
-	(*GetAllUsersRequest)(nil),          // 4: user.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),         // 5: user.GetAllUsersResponse
-	(*CreateUserRequest)(nil),           // 6: user.CreateUserRequest
-	(*CreateUserResponse)(nil),          // 7: user.CreateUserResponse
-	(*UpdateUserRequest)(nil),           // 8: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),          // 9: user.UpdateUserResponse
-	(*DeleteUserRequest)(nil),           // 10: user.DeleteUserRequest
-	(*DeleteUserResponse)(nil),          // 11: user.DeleteUserResponse
-	(*GetVehicleByIdRequest)(nil),       // 12: user.GetVehicleByIdRequest
-	(*GetVehicleByIdResponse)(nil),      // 13: user.GetVehicleByIdResponse
-	(*GetVehiclesByUserIdRequest)(nil),  // 14: user.GetVehiclesByUserIdRequest
-	(*GetVehiclesByUserIdResponse)(nil), // 15: user.GetVehiclesByUserIdResponse
-	(*GetAllVehiclesRequest)(nil),       // 16: user.GetAllVehiclesRequest
-	(*GetAllVehiclesResponse)(nil),      // 17: user.GetAllVehiclesResponse
-	(*CreateVehicleRequest)(nil),        // 18: user.CreateVehicleRequest
-	(*CreateVehicleResponse)(nil),       // 19: user.CreateVehicleResponse
-	(*UpdateVehicleRequest)(nil),        // 20: user.UpdateVehicleRequest
-	(*UpdateVehicleResponse)(nil),       // 21: user.UpdateVehicleResponse
-	(*DeleteVehicleRequest)(nil),        // 22: user.DeleteVehicleRequest
-	(*DeleteVehicleResponse)(nil),       // 23: user.DeleteVehicleResponse
+	(*User)(nil),                           // 0: user.User
+	(*Vehicle)(nil),                        // 1: user.Vehicle
+	(*GetUserByIdRequest)(nil),             // 2: user.GetUserByIdRequest
+	(*GetUserByIdResponse)(nil),            // 3: user.GetUserByIdResponse
+	(*GetAllUsersRequest)(nil),             // 4: user.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),            // 5: user.GetAllUsersResponse
+	(*CreateUserRequest)(nil),              // 6: user.CreateUserRequest
+	(*CreateUserResponse)(nil),             // 7: user.CreateUserResponse
+	(*UpdateUserRequest)(nil),              // 8: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),             // 9: user.UpdateUserResponse
+	(*DeleteUserRequest)(nil),              // 10: user.DeleteUserRequest
+	(*DeleteUserResponse)(nil),             // 11: user.DeleteUserResponse
+	(*GetVehicleByIdRequest)(nil),          // 12: user.GetVehicleByIdRequest
+	(*GetVehicleByIdResponse)(nil),         // 13: user.GetVehicleByIdResponse
+	(*GetVehiclesByUserIdRequest)(nil),     // 14: user.GetVehiclesByUserIdRequest
+	(*GetVehiclesByUserIdResponse)(nil),    // 15: user.GetVehiclesByUserIdResponse
+	(*GetAllVehiclesRequest)(nil),          // 16: user.GetAllVehiclesRequest
+	(*GetAllVehiclesResponse)(nil),         // 17: user.GetAllVehiclesResponse
+	(*CreateVehicleRequest)(nil),           // 18: user.CreateVehicleRequest
+	(*CreateVehicleResponse)(nil),          // 19: user.CreateVehicleResponse
+	(*UpdateVehicleRequest)(nil),           // 20: user.UpdateVehicleRequest
+	(*UpdateVehicleResponse)(nil),          // 21: user.UpdateVehicleResponse
+	(*DeleteVehicleRequest)(nil),           // 22: user.DeleteVehicleRequest
+	(*DeleteVehicleResponse)(nil),          // 23: user.DeleteVehicleResponse
+	(*TicketMessage)(nil),                  // 24: user.TicketMessage
+	(*SupportTicket)(nil),                  // 25: user.SupportTicket
+	(*OpenTicketRequest)(nil),              // 26: user.OpenTicketRequest
+	(*GetTicketRequest)(nil),               // 27: user.GetTicketRequest
+	(*ListTicketsRequest)(nil),             // 28: user.ListTicketsRequest
+	(*ListTicketsResponse)(nil),            // 29: user.ListTicketsResponse
+	(*AddTicketMessageRequest)(nil),        // 30: user.AddTicketMessageRequest
+	(*UpdateTicketStatusRequest)(nil),      // 31: user.UpdateTicketStatusRequest
+	(*AssignTicketRequest)(nil),            // 32: user.AssignTicketRequest
+	(*TicketResponse)(nil),                 // 33: user.TicketResponse
+	(*CreditDriverEarningRequest)(nil),     // 34: user.CreditDriverEarningRequest
+	(*CreditDriverEarningResponse)(nil),    // 35: user.CreditDriverEarningResponse
+	(*SavedPlace)(nil),                     // 36: user.SavedPlace
+	(*CreateSavedPlaceRequest)(nil),        // 37: user.CreateSavedPlaceRequest
+	(*GetSavedPlaceRequest)(nil),           // 38: user.GetSavedPlaceRequest
+	(*ListSavedPlacesRequest)(nil),         // 39: user.ListSavedPlacesRequest
+	(*UpdateSavedPlaceRequest)(nil),        // 40: user.UpdateSavedPlaceRequest
+	(*DeleteSavedPlaceRequest)(nil),        // 41: user.DeleteSavedPlaceRequest
+	(*SavedPlaceResponse)(nil),             // 42: user.SavedPlaceResponse
+	(*ListSavedPlacesResponse)(nil),        // 43: user.ListSavedPlacesResponse
+	(*DeleteSavedPlaceResponse)(nil),       // 44: user.DeleteSavedPlaceResponse
+	(*UserBlock)(nil),                      // 45: user.UserBlock
+	(*BlockUserRequest)(nil),               // 46: user.BlockUserRequest
+	(*BlockUserResponse)(nil),              // 47: user.BlockUserResponse
+	(*UnblockUserRequest)(nil),             // 48: user.UnblockUserRequest
+	(*UnblockUserResponse)(nil),            // 49: user.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),        // 50: user.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),       // 51: user.ListBlockedUsersResponse
+	(*FilterBlockedUsersRequest)(nil),      // 52: user.FilterBlockedUsersRequest
+	(*FilterBlockedUsersResponse)(nil),     // 53: user.FilterBlockedUsersResponse
+	(*DriverOfferStats)(nil),               // 54: user.DriverOfferStats
+	(*RecordDriverOfferStatsRequest)(nil),  // 55: user.RecordDriverOfferStatsRequest
+	(*RecordDriverOfferStatsResponse)(nil), // 56: user.RecordDriverOfferStatsResponse
+	(*GetDriverProfileRequest)(nil),        // 57: user.GetDriverProfileRequest
+	(*GetDriverProfileResponse)(nil),       // 58: user.GetDriverProfileResponse
 }
 var file_user_proto_depIdxs = []int32{
 	0,  // 0: user.GetUserByIdResponse.user:type_name -> user.User
@@ -1672,33 +4264,80 @@ var file_user_proto_depIdxs = []int32{
 	1,  // 4: user.GetAllVehiclesResponse.vehicles:type_name -> user.Vehicle
 	1,  // 5: user.CreateVehicleResponse.vehicle:type_name -> user.Vehicle
 	1,  // 6: user.UpdateVehicleResponse.vehicle:type_name -> user.Vehicle
-	2,  // 7: user.UserService.GetUserById:input_type -> user.GetUserByIdRequest
-	4,  // 8: user.UserService.GetAllUsers:input_type -> user.GetAllUsersRequest
-	6,  // 9: user.UserService.CreateUser:input_type -> user.CreateUserRequest
-	8,  // 10: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
-	10, // 11: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
-	12, // 12: user.UserService.GetVehicleById:input_type -> user.GetVehicleByIdRequest
-	14, // 13: user.UserService.GetVehiclesByUserId:input_type -> user.GetVehiclesByUserIdRequest
-	16, // 14: user.UserService.GetAllVehicles:input_type -> user.GetAllVehiclesRequest
-	18, // 15: user.UserService.CreateVehicle:input_type -> user.CreateVehicleRequest
-	20, // 16: user.UserService.UpdateVehicle:input_type -> user.UpdateVehicleRequest
-	22, // 17: user.UserService.DeleteVehicle:input_type -> user.DeleteVehicleRequest
-	3,  // 18: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
-	5,  // 19: user.UserService.GetAllUsers:output_type -> user.GetAllUsersResponse
-	7,  // 20: user.UserService.CreateUser:output_type -> user.CreateUserResponse
-	9,  // 21: user.UserService.UpdateUser:output_type -> user.UpdateUserResponse
-	11, // 22: user.UserService.DeleteUser:output_type -> user.DeleteUserResponse
-	13, // 23: user.UserService.GetVehicleById:output_type -> user.GetVehicleByIdResponse
-	15, // 24: user.UserService.GetVehiclesByUserId:output_type -> user.GetVehiclesByUserIdResponse
-	17, // 25: user.UserService.GetAllVehicles:output_type -> user.GetAllVehiclesResponse
-	19, // 26: user.UserService.CreateVehicle:output_type -> user.CreateVehicleResponse
-	21, // 27: user.UserService.UpdateVehicle:output_type -> user.UpdateVehicleResponse
-	23, // 28: user.UserService.DeleteVehicle:output_type -> user.DeleteVehicleResponse
-	18, // [18:29] is the sub-list for method output_type
-	7,  // [7:18] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	24, // 7: user.SupportTicket.messages:type_name -> user.TicketMessage
+	25, // 8: user.ListTicketsResponse.tickets:type_name -> user.SupportTicket
+	25, // 9: user.TicketResponse.ticket:type_name -> user.SupportTicket
+	36, // 10: user.SavedPlaceResponse.place:type_name -> user.SavedPlace
+	36, // 11: user.ListSavedPlacesResponse.places:type_name -> user.SavedPlace
+	45, // 12: user.BlockUserResponse.block:type_name -> user.UserBlock
+	45, // 13: user.ListBlockedUsersResponse.blocks:type_name -> user.UserBlock
+	54, // 14: user.RecordDriverOfferStatsRequest.stats:type_name -> user.DriverOfferStats
+	0,  // 15: user.GetDriverProfileResponse.driver:type_name -> user.User
+	1,  // 16: user.GetDriverProfileResponse.vehicles:type_name -> user.Vehicle
+	54, // 17: user.GetDriverProfileResponse.offer_stats:type_name -> user.DriverOfferStats
+	2,  // 18: user.UserService.GetUserById:input_type -> user.GetUserByIdRequest
+	4,  // 19: user.UserService.GetAllUsers:input_type -> user.GetAllUsersRequest
+	6,  // 20: user.UserService.CreateUser:input_type -> user.CreateUserRequest
+	8,  // 21: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
+	10, // 22: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
+	12, // 23: user.UserService.GetVehicleById:input_type -> user.GetVehicleByIdRequest
+	14, // 24: user.UserService.GetVehiclesByUserId:input_type -> user.GetVehiclesByUserIdRequest
+	16, // 25: user.UserService.GetAllVehicles:input_type -> user.GetAllVehiclesRequest
+	18, // 26: user.UserService.CreateVehicle:input_type -> user.CreateVehicleRequest
+	20, // 27: user.UserService.UpdateVehicle:input_type -> user.UpdateVehicleRequest
+	22, // 28: user.UserService.DeleteVehicle:input_type -> user.DeleteVehicleRequest
+	26, // 29: user.UserService.OpenTicket:input_type -> user.OpenTicketRequest
+	27, // 30: user.UserService.GetTicket:input_type -> user.GetTicketRequest
+	28, // 31: user.UserService.ListTickets:input_type -> user.ListTicketsRequest
+	30, // 32: user.UserService.AddTicketMessage:input_type -> user.AddTicketMessageRequest
+	31, // 33: user.UserService.UpdateTicketStatus:input_type -> user.UpdateTicketStatusRequest
+	32, // 34: user.UserService.AssignTicket:input_type -> user.AssignTicketRequest
+	34, // 35: user.UserService.CreditDriverEarning:input_type -> user.CreditDriverEarningRequest
+	37, // 36: user.UserService.CreateSavedPlace:input_type -> user.CreateSavedPlaceRequest
+	38, // 37: user.UserService.GetSavedPlace:input_type -> user.GetSavedPlaceRequest
+	39, // 38: user.UserService.ListSavedPlaces:input_type -> user.ListSavedPlacesRequest
+	40, // 39: user.UserService.UpdateSavedPlace:input_type -> user.UpdateSavedPlaceRequest
+	41, // 40: user.UserService.DeleteSavedPlace:input_type -> user.DeleteSavedPlaceRequest
+	46, // 41: user.UserService.BlockUser:input_type -> user.BlockUserRequest
+	48, // 42: user.UserService.UnblockUser:input_type -> user.UnblockUserRequest
+	50, // 43: user.UserService.ListBlockedUsers:input_type -> user.ListBlockedUsersRequest
+	52, // 44: user.UserService.FilterBlockedUsers:input_type -> user.FilterBlockedUsersRequest
+	55, // 45: user.UserService.RecordDriverOfferStats:input_type -> user.RecordDriverOfferStatsRequest
+	57, // 46: user.UserService.GetDriverProfile:input_type -> user.GetDriverProfileRequest
+	3,  // 47: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
+	5,  // 48: user.UserService.GetAllUsers:output_type -> user.GetAllUsersResponse
+	7,  // 49: user.UserService.CreateUser:output_type -> user.CreateUserResponse
+	9,  // 50: user.UserService.UpdateUser:output_type -> user.UpdateUserResponse
+	11, // 51: user.UserService.DeleteUser:output_type -> user.DeleteUserResponse
+	13, // 52: user.UserService.GetVehicleById:output_type -> user.GetVehicleByIdResponse
+	15, // 53: user.UserService.GetVehiclesByUserId:output_type -> user.GetVehiclesByUserIdResponse
+	17, // 54: user.UserService.GetAllVehicles:output_type -> user.GetAllVehiclesResponse
+	19, // 55: user.UserService.CreateVehicle:output_type -> user.CreateVehicleResponse
+	21, // 56: user.UserService.UpdateVehicle:output_type -> user.UpdateVehicleResponse
+	23, // 57: user.UserService.DeleteVehicle:output_type -> user.DeleteVehicleResponse
+	33, // 58: user.UserService.OpenTicket:output_type -> user.TicketResponse
+	33, // 59: user.UserService.GetTicket:output_type -> user.TicketResponse
+	29, // 60: user.UserService.ListTickets:output_type -> user.ListTicketsResponse
+	33, // 61: user.UserService.AddTicketMessage:output_type -> user.TicketResponse
+	33, // 62: user.UserService.UpdateTicketStatus:output_type -> user.TicketResponse
+	33, // 63: user.UserService.AssignTicket:output_type -> user.TicketResponse
+	35, // 64: user.UserService.CreditDriverEarning:output_type -> user.CreditDriverEarningResponse
+	42, // 65: user.UserService.CreateSavedPlace:output_type -> user.SavedPlaceResponse
+	42, // 66: user.UserService.GetSavedPlace:output_type -> user.SavedPlaceResponse
+	43, // 67: user.UserService.ListSavedPlaces:output_type -> user.ListSavedPlacesResponse
+	42, // 68: user.UserService.UpdateSavedPlace:output_type -> user.SavedPlaceResponse
+	44, // 69: user.UserService.DeleteSavedPlace:output_type -> user.DeleteSavedPlaceResponse
+	47, // 70: user.UserService.BlockUser:output_type -> user.BlockUserResponse
+	49, // 71: user.UserService.UnblockUser:output_type -> user.UnblockUserResponse
+	51, // 72: user.UserService.ListBlockedUsers:output_type -> user.ListBlockedUsersResponse
+	53, // 73: user.UserService.FilterBlockedUsers:output_type -> user.FilterBlockedUsersResponse
+	56, // 74: user.UserService.RecordDriverOfferStats:output_type -> user.RecordDriverOfferStatsResponse
+	58, // 75: user.UserService.GetDriverProfile:output_type -> user.GetDriverProfileResponse
+	47, // [47:76] is the sub-list for method output_type
+	18, // [18:47] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1712,7 +4351,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,17 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUserById_FullMethodName         = "/user.UserService/GetUserById"
-	UserService_GetAllUsers_FullMethodName         = "/user.UserService/GetAllUsers"
-	UserService_CreateUser_FullMethodName          = "/user.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName          = "/user.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName          = "/user.UserService/DeleteUser"
-	UserService_GetVehicleById_FullMethodName      = "/user.UserService/GetVehicleById"
-	UserService_GetVehiclesByUserId_FullMethodName = "/user.UserService/GetVehiclesByUserId"
-	UserService_GetAllVehicles_FullMethodName      = "/user.UserService/GetAllVehicles"
-	UserService_CreateVehicle_FullMethodName       = "/user.UserService/CreateVehicle"
-	UserService_UpdateVehicle_FullMethodName       = "/user.UserService/UpdateVehicle"
-	UserService_DeleteVehicle_FullMethodName       = "/user.UserService/DeleteVehicle"
+	UserService_GetUserById_FullMethodName            = "/user.UserService/GetUserById"
+	UserService_GetAllUsers_FullMethodName            = "/user.UserService/GetAllUsers"
+	UserService_CreateUser_FullMethodName             = "/user.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName             = "/user.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName             = "/user.UserService/DeleteUser"
+	UserService_GetVehicleById_FullMethodName         = "/user.UserService/GetVehicleById"
+	UserService_GetVehiclesByUserId_FullMethodName    = "/user.UserService/GetVehiclesByUserId"
+	UserService_GetAllVehicles_FullMethodName         = "/user.UserService/GetAllVehicles"
+	UserService_CreateVehicle_FullMethodName          = "/user.UserService/CreateVehicle"
+	UserService_UpdateVehicle_FullMethodName          = "/user.UserService/UpdateVehicle"
+	UserService_DeleteVehicle_FullMethodName          = "/user.UserService/DeleteVehicle"
+	UserService_OpenTicket_FullMethodName             = "/user.UserService/OpenTicket"
+	UserService_GetTicket_FullMethodName              = "/user.UserService/GetTicket"
+	UserService_ListTickets_FullMethodName            = "/user.UserService/ListTickets"
+	UserService_AddTicketMessage_FullMethodName       = "/user.UserService/AddTicketMessage"
+	UserService_UpdateTicketStatus_FullMethodName     = "/user.UserService/UpdateTicketStatus"
+	UserService_AssignTicket_FullMethodName           = "/user.UserService/AssignTicket"
+	UserService_CreditDriverEarning_FullMethodName    = "/user.UserService/CreditDriverEarning"
+	UserService_CreateSavedPlace_FullMethodName       = "/user.UserService/CreateSavedPlace"
+	UserService_GetSavedPlace_FullMethodName          = "/user.UserService/GetSavedPlace"
+	UserService_ListSavedPlaces_FullMethodName        = "/user.UserService/ListSavedPlaces"
+	UserService_UpdateSavedPlace_FullMethodName       = "/user.UserService/UpdateSavedPlace"
+	UserService_DeleteSavedPlace_FullMethodName       = "/user.UserService/DeleteSavedPlace"
+	UserService_BlockUser_FullMethodName              = "/user.UserService/BlockUser"
+	UserService_UnblockUser_FullMethodName            = "/user.UserService/UnblockUser"
+	UserService_ListBlockedUsers_FullMethodName       = "/user.UserService/ListBlockedUsers"
+	UserService_FilterBlockedUsers_FullMethodName     = "/user.UserService/FilterBlockedUsers"
+	UserService_RecordDriverOfferStats_FullMethodName = "/user.UserService/RecordDriverOfferStats"
+	UserService_GetDriverProfile_FullMethodName       = "/user.UserService/GetDriverProfile"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -51,6 +69,33 @@ type UserServiceClient interface {
 	CreateVehicle(ctx context.Context, in *CreateVehicleRequest, opts ...grpc.CallOption) (*CreateVehicleResponse, error)
 	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
 	DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error)
+	// Support ticket operations
+	OpenTicket(ctx context.Context, in *OpenTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error)
+	AddTicketMessage(ctx context.Context, in *AddTicketMessageRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	UpdateTicketStatus(ctx context.Context, in *UpdateTicketStatusRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	AssignTicket(ctx context.Context, in *AssignTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	// Driver earnings. Each (trip_id, kind) is credited to driver_revenue at
+	// most once, so callers can retry freely.
+	CreditDriverEarning(ctx context.Context, in *CreditDriverEarningRequest, opts ...grpc.CallOption) (*CreditDriverEarningResponse, error)
+	// Saved places. Every call is scoped to user_id; another user's place
+	// answers NotFound.
+	CreateSavedPlace(ctx context.Context, in *CreateSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error)
+	GetSavedPlace(ctx context.Context, in *GetSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error)
+	ListSavedPlaces(ctx context.Context, in *ListSavedPlacesRequest, opts ...grpc.CallOption) (*ListSavedPlacesResponse, error)
+	UpdateSavedPlace(ctx context.Context, in *UpdateSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error)
+	DeleteSavedPlace(ctx context.Context, in *DeleteSavedPlaceRequest, opts ...grpc.CallOption) (*DeleteSavedPlaceResponse, error)
+	// Block lists. A block works both ways for matching: FilterBlockedUsers
+	// drops candidates that blocked user_id or that user_id blocked.
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error)
+	// Driver profiles. trip-service pushes a driver's offer statistics after
+	// every decided offer; a snapshot older than the stored one is ignored.
+	RecordDriverOfferStats(ctx context.Context, in *RecordDriverOfferStatsRequest, opts ...grpc.CallOption) (*RecordDriverOfferStatsResponse, error)
+	GetDriverProfile(ctx context.Context, in *GetDriverProfileRequest, opts ...grpc.CallOption) (*GetDriverProfileResponse, error)
 }
 
 type userServiceClient struct {
@@ -171,6 +216,186 @@ func (c *userServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehicle
 	return out, nil
 }
 
+func (c *userServiceClient) OpenTicket(ctx context.Context, in *OpenTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, UserService_OpenTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, UserService_GetTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddTicketMessage(ctx context.Context, in *AddTicketMessageRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, UserService_AddTicketMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateTicketStatus(ctx context.Context, in *UpdateTicketStatusRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateTicketStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AssignTicket(ctx context.Context, in *AssignTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, UserService_AssignTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreditDriverEarning(ctx context.Context, in *CreditDriverEarningRequest, opts ...grpc.CallOption) (*CreditDriverEarningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditDriverEarningResponse)
+	err := c.cc.Invoke(ctx, UserService_CreditDriverEarning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateSavedPlace(ctx context.Context, in *CreateSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedPlaceResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateSavedPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSavedPlace(ctx context.Context, in *GetSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedPlaceResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSavedPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListSavedPlaces(ctx context.Context, in *ListSavedPlacesRequest, opts ...grpc.CallOption) (*ListSavedPlacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedPlacesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListSavedPlaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateSavedPlace(ctx context.Context, in *UpdateSavedPlaceRequest, opts ...grpc.CallOption) (*SavedPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedPlaceResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateSavedPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteSavedPlace(ctx context.Context, in *DeleteSavedPlaceRequest, opts ...grpc.CallOption) (*DeleteSavedPlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedPlaceResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteSavedPlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_FilterBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RecordDriverOfferStats(ctx context.Context, in *RecordDriverOfferStatsRequest, opts ...grpc.CallOption) (*RecordDriverOfferStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordDriverOfferStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordDriverOfferStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDriverProfile(ctx context.Context, in *GetDriverProfileRequest, opts ...grpc.CallOption) (*GetDriverProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDriverProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -190,6 +415,33 @@ type UserServiceServer interface {
 	CreateVehicle(context.Context, *CreateVehicleRequest) (*CreateVehicleResponse, error)
 	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error)
 	DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error)
+	// Support ticket operations
+	OpenTicket(context.Context, *OpenTicketRequest) (*TicketResponse, error)
+	GetTicket(context.Context, *GetTicketRequest) (*TicketResponse, error)
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error)
+	AddTicketMessage(context.Context, *AddTicketMessageRequest) (*TicketResponse, error)
+	UpdateTicketStatus(context.Context, *UpdateTicketStatusRequest) (*TicketResponse, error)
+	AssignTicket(context.Context, *AssignTicketRequest) (*TicketResponse, error)
+	// Driver earnings. Each (trip_id, kind) is credited to driver_revenue at
+	// most once, so callers can retry freely.
+	CreditDriverEarning(context.Context, *CreditDriverEarningRequest) (*CreditDriverEarningResponse, error)
+	// Saved places. Every call is scoped to user_id; another user's place
+	// answers NotFound.
+	CreateSavedPlace(context.Context, *CreateSavedPlaceRequest) (*SavedPlaceResponse, error)
+	GetSavedPlace(context.Context, *GetSavedPlaceRequest) (*SavedPlaceResponse, error)
+	ListSavedPlaces(context.Context, *ListSavedPlacesRequest) (*ListSavedPlacesResponse, error)
+	UpdateSavedPlace(context.Context, *UpdateSavedPlaceRequest) (*SavedPlaceResponse, error)
+	DeleteSavedPlace(context.Context, *DeleteSavedPlaceRequest) (*DeleteSavedPlaceResponse, error)
+	// Block lists. A block works both ways for matching: FilterBlockedUsers
+	// drops candidates that blocked user_id or that user_id blocked.
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	FilterBlockedUsers(context.Context, *FilterBlockedUsersRequest) (*FilterBlockedUsersResponse, error)
+	// Driver profiles. trip-service pushes a driver's offer statistics after
+	// every decided offer; a snapshot older than the stored one is ignored.
+	RecordDriverOfferStats(context.Context, *RecordDriverOfferStatsRequest) (*RecordDriverOfferStatsResponse, error)
+	GetDriverProfile(context.Context, *GetDriverProfileRequest) (*GetDriverProfileResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -233,6 +485,60 @@ func (UnimplementedUserServiceServer) UpdateVehicle(context.Context, *UpdateVehi
 func (UnimplementedUserServiceServer) DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
 }
+func (UnimplementedUserServiceServer) OpenTicket(context.Context, *OpenTicketRequest) (*TicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenTicket not implemented")
+}
+func (UnimplementedUserServiceServer) GetTicket(context.Context, *GetTicketRequest) (*TicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedUserServiceServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedUserServiceServer) AddTicketMessage(context.Context, *AddTicketMessageRequest) (*TicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTicketMessage not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateTicketStatus(context.Context, *UpdateTicketStatusRequest) (*TicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicketStatus not implemented")
+}
+func (UnimplementedUserServiceServer) AssignTicket(context.Context, *AssignTicketRequest) (*TicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTicket not implemented")
+}
+func (UnimplementedUserServiceServer) CreditDriverEarning(context.Context, *CreditDriverEarningRequest) (*CreditDriverEarningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditDriverEarning not implemented")
+}
+func (UnimplementedUserServiceServer) CreateSavedPlace(context.Context, *CreateSavedPlaceRequest) (*SavedPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedPlace not implemented")
+}
+func (UnimplementedUserServiceServer) GetSavedPlace(context.Context, *GetSavedPlaceRequest) (*SavedPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedPlace not implemented")
+}
+func (UnimplementedUserServiceServer) ListSavedPlaces(context.Context, *ListSavedPlacesRequest) (*ListSavedPlacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedPlaces not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateSavedPlace(context.Context, *UpdateSavedPlaceRequest) (*SavedPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedPlace not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteSavedPlace(context.Context, *DeleteSavedPlaceRequest) (*DeleteSavedPlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedPlace not implemented")
+}
+func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedUserServiceServer) FilterBlockedUsers(context.Context, *FilterBlockedUsersRequest) (*FilterBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterBlockedUsers not implemented")
+}
+func (UnimplementedUserServiceServer) RecordDriverOfferStats(context.Context, *RecordDriverOfferStatsRequest) (*RecordDriverOfferStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDriverOfferStats not implemented")
+}
+func (UnimplementedUserServiceServer) GetDriverProfile(context.Context, *GetDriverProfileRequest) (*GetDriverProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverProfile not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -452,6 +758,330 @@ func _UserService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_OpenTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).OpenTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_OpenTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).OpenTicket(ctx, req.(*OpenTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddTicketMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTicketMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddTicketMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddTicketMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddTicketMessage(ctx, req.(*AddTicketMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateTicketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateTicketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateTicketStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateTicketStatus(ctx, req.(*UpdateTicketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AssignTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AssignTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AssignTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AssignTicket(ctx, req.(*AssignTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreditDriverEarning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditDriverEarningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreditDriverEarning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreditDriverEarning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreditDriverEarning(ctx, req.(*CreditDriverEarningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateSavedPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateSavedPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateSavedPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateSavedPlace(ctx, req.(*CreateSavedPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSavedPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSavedPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSavedPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSavedPlace(ctx, req.(*GetSavedPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListSavedPlaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedPlacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSavedPlaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSavedPlaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSavedPlaces(ctx, req.(*ListSavedPlacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateSavedPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateSavedPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateSavedPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateSavedPlace(ctx, req.(*UpdateSavedPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteSavedPlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedPlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteSavedPlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteSavedPlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteSavedPlace(ctx, req.(*DeleteSavedPlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FilterBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FilterBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FilterBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FilterBlockedUsers(ctx, req.(*FilterBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RecordDriverOfferStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordDriverOfferStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RecordDriverOfferStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RecordDriverOfferStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RecordDriverOfferStats(ctx, req.(*RecordDriverOfferStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDriverProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDriverProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDriverProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDriverProfile(ctx, req.(*GetDriverProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -503,6 +1133,78 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVehicle",
 			Handler:    _UserService_DeleteVehicle_Handler,
 		},
+		{
+			MethodName: "OpenTicket",
+			Handler:    _UserService_OpenTicket_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _UserService_GetTicket_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _UserService_ListTickets_Handler,
+		},
+		{
+			MethodName: "AddTicketMessage",
+			Handler:    _UserService_AddTicketMessage_Handler,
+		},
+		{
+			MethodName: "UpdateTicketStatus",
+			Handler:    _UserService_UpdateTicketStatus_Handler,
+		},
+		{
+			MethodName: "AssignTicket",
+			Handler:    _UserService_AssignTicket_Handler,
+		},
+		{
+			MethodName: "CreditDriverEarning",
+			Handler:    _UserService_CreditDriverEarning_Handler,
+		},
+		{
+			MethodName: "CreateSavedPlace",
+			Handler:    _UserService_CreateSavedPlace_Handler,
+		},
+		{
+			MethodName: "GetSavedPlace",
+			Handler:    _UserService_GetSavedPlace_Handler,
+		},
+		{
+			MethodName: "ListSavedPlaces",
+			Handler:    _UserService_ListSavedPlaces_Handler,
+		},
+		{
+			MethodName: "UpdateSavedPlace",
+			Handler:    _UserService_UpdateSavedPlace_Handler,
+		},
+		{
+			MethodName: "DeleteSavedPlace",
+			Handler:    _UserService_DeleteSavedPlace_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _UserService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "FilterBlockedUsers",
+			Handler:    _UserService_FilterBlockedUsers_Handler,
+		},
+		{
+			MethodName: "RecordDriverOfferStats",
+			Handler:    _UserService_RecordDriverOfferStats_Handler,
+		},
+		{
+			MethodName: "GetDriverProfile",
+			Handler:    _UserService_GetDriverProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
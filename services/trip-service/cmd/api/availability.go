@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrDriverBusy = errors.New("driver is already on another trip")
+
+// markDriverOnTrip takes the driver out of matching before they're assigned
+// to tripID. location-service refusing because the driver is on another trip
+// rejects the accept; it being unreachable doesn't, since the trip record is
+// what decides who drives.
+func (trip *TripService) markDriverOnTrip(ctx context.Context, driverID int, tripID int) error {
+	_, err := trip.grpcClients.MarkDriverOnTripViaGRPC(ctx, driverID, tripID)
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", ErrDriverBusy, status.Convert(err).Message())
+	}
+	if err != nil {
+		logger.Warn(ctx, "Failed to mark driver on trip", "driver_id", driverID, "trip_id", tripID, "error", err)
+	}
+	return nil
+}
+
+// releaseDriver makes the driver available again once tripID is over.
+// Releasing is idempotent in location-service, so a failure is only logged;
+// the driver can still go online again themselves.
+func (trip *TripService) releaseDriver(ctx context.Context, driverID int, tripID int) {
+	if _, err := trip.grpcClients.ReleaseDriverViaGRPC(ctx, driverID, tripID); err != nil {
+		logger.Warn(ctx, "Failed to release driver", "driver_id", driverID, "trip_id", tripID, "error", err)
+	}
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	maxChatMessageLength    = 1000
+	defaultChatHistoryLimit = 50
+	maxChatHistoryLimit     = 200
+	// chatSubscriberBuffer is how many events a slow subscriber may fall
+	// behind before it starts missing them.
+	chatSubscriberBuffer = 32
+)
+
+var (
+	ErrNotTripParticipant = errors.New("user is not a participant of this trip")
+	ErrChatClosed         = errors.New("chat is only available while the trip is accepted or started")
+	ErrInvalidChatMessage = errors.New("invalid chat message")
+)
+
+// quickReplies are the canned messages offered to each side of a trip.
+var quickReplies = map[string][]models.QuickReply{
+	"passenger": {
+		{Key: "coming_out", Text: "I'm coming out now."},
+		{Key: "at_pickup", Text: "I'm at the pickup point."},
+		{Key: "wait", Text: "Please wait a couple of minutes."},
+		{Key: "call_me", Text: "Please call me."},
+	},
+	"driver": {
+		{Key: "on_my_way", Text: "I'm on my way."},
+		{Key: "arrived", Text: "I've arrived at the pickup point."},
+		{Key: "traffic", Text: "Stuck in traffic, I'll be a few minutes late."},
+		{Key: "call_me", Text: "Please call me."},
+	},
+}
+
+// QuickReplies returns the canned messages for role ("passenger" or "driver").
+func QuickReplies(role string) []models.QuickReply {
+	return quickReplies[role]
+}
+
+func quickReplyText(role string, key string) (string, bool) {
+	for _, reply := range quickReplies[role] {
+		if reply.Key == key {
+			return reply.Text, true
+		}
+	}
+	return "", false
+}
+
+// ChatEvent is pushed to chat subscribers; exactly one field is set.
+type ChatEvent struct {
+	Message *models.ChatMessage
+	Receipt *models.ChatReceipt
+}
+
+type chatSubscription struct {
+	userID int
+	events chan ChatEvent
+}
+
+// chatHub fans chat events out to the subscribers of a trip within this
+// process. A subscriber that falls behind loses events instead of blocking the
+// sender; clients catch up through GetChatHistory. Events never leave the
+// process, so the chat only works with a single trip-service replica.
+type chatHub struct {
+	mu   sync.Mutex
+	subs map[int]map[*chatSubscription]struct{}
+}
+
+func newChatHub() *chatHub {
+	return &chatHub{subs: make(map[int]map[*chatSubscription]struct{})}
+}
+
+func (h *chatHub) subscribe(tripID int, userID int) (*chatSubscription, func()) {
+	sub := &chatSubscription{userID: userID, events: make(chan ChatEvent, chatSubscriberBuffer)}
+	h.mu.Lock()
+	if h.subs[tripID] == nil {
+		h.subs[tripID] = make(map[*chatSubscription]struct{})
+	}
+	h.subs[tripID][sub] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subs[tripID][sub]; ok {
+			delete(h.subs[tripID], sub)
+			if len(h.subs[tripID]) == 0 {
+				delete(h.subs, tripID)
+			}
+			close(sub.events)
+		}
+	}
+	return sub, unsubscribe
+}
+
+func (h *chatHub) publish(tripID int, event ChatEvent) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[tripID] {
+		select {
+		case sub.events <- event:
+		default:
+			logger.Warn("Chat subscriber is falling behind, dropping event", "trip_id", tripID, "user_id", sub.userID)
+		}
+	}
+}
+
+// close ends every subscription to the trip's chat.
+func (h *chatHub) close(tripID int) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[tripID] {
+		close(sub.events)
+	}
+	delete(h.subs, tripID)
+}
+
+// tripCounterpart returns the other participant of the trip and the role
+// userID has in it.
+func tripCounterpart(record models.Trip, userID int) (int, string, error) {
+	driverID := 0
+	if record.DriverID.Valid {
+		driverID = int(record.DriverID.Int32)
+	}
+	switch userID {
+	case record.PassengerID:
+		return driverID, "passenger", nil
+	case driverID:
+		return record.PassengerID, "driver", nil
+	}
+	return 0, "", ErrNotTripParticipant
+}
+
+// tripInProgress reports whether a driver has the trip and it hasn't ended.
+func tripInProgress(record models.Trip) bool {
+	return record.Status == models.StatusAccepted || record.Status == models.StatusStarted
+}
+
+// activeChat loads the trip and checks that userID may chat in it now.
+func (trip *TripService) activeChat(ctx context.Context, tripID int, userID int) (int, string, error) {
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return 0, "", err
+	}
+	counterpart, role, err := tripCounterpart(record, userID)
+	if err != nil {
+		return 0, "", err
+	}
+	if !tripInProgress(record) {
+		return 0, "", ErrChatClosed
+	}
+	return counterpart, role, nil
+}
+
+// SendChatMessage stores a message from senderID to the other participant and
+// pushes it to live subscribers. Exactly one of body and quickReply is set.
+func (trip *TripService) SendChatMessage(ctx context.Context, tripID int, senderID int, body string, quickReply string) (models.ChatMessage, error) {
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.SendChatMessage",
+		trace.WithAttributes(
+			attribute.Int("trip_id", tripID),
+			attribute.Int("sender_id", senderID),
+			attribute.Bool("chat.quick_reply", quickReply != ""),
+		),
+	)
+	defer span.End()
+
+	body = strings.TrimSpace(body)
+	if (body == "") == (quickReply == "") {
+		return models.ChatMessage{}, fmt.Errorf("%w: send either a body or a quick reply", ErrInvalidChatMessage)
+	}
+	if len(body) > maxChatMessageLength {
+		return models.ChatMessage{}, fmt.Errorf("%w: message is longer than %d bytes", ErrInvalidChatMessage, maxChatMessageLength)
+	}
+
+	recipientID, role, err := trip.activeChat(ctx, tripID, senderID)
+	if err != nil {
+		return models.ChatMessage{}, err
+	}
+	message := models.ChatMessage{TripID: tripID, SenderID: senderID, RecipientID: recipientID, Body: body}
+	if quickReply != "" {
+		text, ok := quickReplyText(role, quickReply)
+		if !ok {
+			return models.ChatMessage{}, fmt.Errorf("%w: unknown quick reply %q", ErrInvalidChatMessage, quickReply)
+		}
+		message.Body = text
+		message.QuickReply = sql.NullString{String: quickReply, Valid: true}
+	}
+
+	message, err = trip.DB.CreateChatMessage(ctx, message)
+	if err != nil {
+		logger.Error(ctx, "Failed to store chat message", "trip_id", tripID, "error", err)
+		span.RecordError(err)
+		return models.ChatMessage{}, err
+	}
+	trip.Chat.publish(tripID, ChatEvent{Message: &message})
+	return message, nil
+}
+
+// GetChatHistory returns the messages of a trip after afterID, oldest first.
+// Participants can read it at any time, also after the trip has ended.
+// Messages handed to their recipient this way count as delivered.
+func (trip *TripService) GetChatHistory(ctx context.Context, tripID int, userID int, afterID int64, limit int) ([]models.ChatMessage, error) {
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := tripCounterpart(record, userID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultChatHistoryLimit
+	}
+	if limit > maxChatHistoryLimit {
+		limit = maxChatHistoryLimit
+	}
+
+	messages, err := trip.DB.GetChatMessages(ctx, tripID, afterID, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat history", "trip_id", tripID, "error", err)
+		return nil, err
+	}
+	var undelivered int64
+	for _, message := range messages {
+		if message.RecipientID == userID && !message.DeliveredAt.Valid {
+			undelivered = message.ID
+		}
+	}
+	if undelivered > 0 {
+		at, err := trip.markChat(ctx, tripID, userID, undelivered, models.ReceiptDelivered)
+		if err != nil {
+			return nil, err
+		}
+		for i := range messages {
+			if messages[i].RecipientID == userID && messages[i].ID <= undelivered && !messages[i].DeliveredAt.Valid {
+				messages[i].DeliveredAt = sql.NullTime{Time: at, Valid: true}
+			}
+		}
+	}
+	return messages, nil
+}
+
+// MarkChatRead marks the messages addressed to userID up to upToID as read and
+// returns how many changed.
+func (trip *TripService) MarkChatRead(ctx context.Context, tripID int, userID int, upToID int64) (int64, error) {
+	if upToID <= 0 {
+		return 0, fmt.Errorf("%w: up_to_id is required", ErrInvalidChatMessage)
+	}
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return 0, err
+	}
+	if _, _, err := tripCounterpart(record, userID); err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	updated, err := trip.DB.MarkChatMessages(ctx, tripID, userID, upToID, models.ReceiptRead, now)
+	if err != nil {
+		logger.Error(ctx, "Failed to mark chat messages read", "trip_id", tripID, "error", err)
+		return 0, err
+	}
+	if updated > 0 {
+		trip.Chat.publish(tripID, ChatEvent{Receipt: &models.ChatReceipt{
+			TripID: tripID, RecipientID: userID, UpToID: upToID, Kind: models.ReceiptRead, At: now,
+		}})
+	}
+	return updated, nil
+}
+
+// markChat records a delivery receipt and tells the sender about it.
+func (trip *TripService) markChat(ctx context.Context, tripID int, recipientID int, upToID int64, kind models.ChatReceiptKind) (time.Time, error) {
+	now := time.Now()
+	updated, err := trip.DB.MarkChatMessages(ctx, tripID, recipientID, upToID, kind, now)
+	if err != nil {
+		logger.Error(ctx, "Failed to record chat receipt", "trip_id", tripID, "kind", string(kind), "error", err)
+		return now, err
+	}
+	if updated > 0 {
+		trip.Chat.publish(tripID, ChatEvent{Receipt: &models.ChatReceipt{
+			TripID: tripID, RecipientID: recipientID, UpToID: upToID, Kind: kind, At: now,
+		}})
+	}
+	return now, nil
+}
+
+// SubscribeChat streams the trip's chat events to userID until the returned
+// cancel func is called or the trip ends, at which point the channel closes.
+// Messages addressed to userID must be acknowledged with ChatDelivered once
+// they have been passed on.
+func (trip *TripService) SubscribeChat(ctx context.Context, tripID int, userID int) (<-chan ChatEvent, func(), error) {
+	if _, _, err := trip.activeChat(ctx, tripID, userID); err != nil {
+		return nil, nil, err
+	}
+	sub, unsubscribe := trip.Chat.subscribe(tripID, userID)
+	return sub.events, unsubscribe, nil
+}
+
+// ChatDelivered records that messages up to messageID reached recipientID.
+func (trip *TripService) ChatDelivered(ctx context.Context, tripID int, recipientID int, messageID int64) error {
+	_, err := trip.markChat(ctx, tripID, recipientID, messageID, models.ReceiptDelivered)
+	return err
+}
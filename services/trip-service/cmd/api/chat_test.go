@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+)
+
+const chatDriverID = 7
+
+func TestSendChatMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     models.TripStatus
+		sender     int
+		body       string
+		quickReply string
+		wantErr    error
+		wantBody   string
+		wantTo     int
+	}{
+		{name: "passenger while accepted", status: models.StatusAccepted, sender: passengerID, body: " I'm at gate 3 ", wantBody: "I'm at gate 3", wantTo: chatDriverID},
+		{name: "driver while started", status: models.StatusStarted, sender: chatDriverID, body: "Turning left here", wantBody: "Turning left here", wantTo: passengerID},
+		{name: "quick reply", status: models.StatusAccepted, sender: chatDriverID, quickReply: "arrived", wantBody: "I've arrived at the pickup point.", wantTo: passengerID},
+		{name: "quick reply of the other role", status: models.StatusAccepted, sender: passengerID, quickReply: "arrived", wantErr: ErrInvalidChatMessage},
+		{name: "body and quick reply", status: models.StatusAccepted, sender: passengerID, body: "hi", quickReply: "call_me", wantErr: ErrInvalidChatMessage},
+		{name: "empty", status: models.StatusAccepted, sender: passengerID, body: "   ", wantErr: ErrInvalidChatMessage},
+		{name: "too long", status: models.StatusAccepted, sender: passengerID, body: strings.Repeat("a", maxChatMessageLength+1), wantErr: ErrInvalidChatMessage},
+		{name: "stranger", status: models.StatusAccepted, sender: strangerID, body: "hi", wantErr: ErrNotTripParticipant},
+		{name: "completed trip", status: models.StatusCompleted, sender: passengerID, body: "hi", wantErr: ErrChatClosed},
+		{name: "cancelled trip", status: models.StatusCancelled, sender: chatDriverID, body: "hi", wantErr: ErrChatClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			trip := env.createTrip(t)
+			env.setTrip(t, trip.ID, tt.status, chatDriverID)
+
+			message, err := env.service.SendChatMessage(context.Background(), trip.ID, tt.sender, tt.body, tt.quickReply)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("SendChatMessage error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SendChatMessage: %v", err)
+			}
+			if message.ID == 0 || message.Body != tt.wantBody || message.RecipientID != tt.wantTo {
+				t.Errorf("message = %+v, want body %q to %d", message, tt.wantBody, tt.wantTo)
+			}
+			if message.QuickReply.String != tt.quickReply {
+				t.Errorf("quick reply = %q, want %q", message.QuickReply.String, tt.quickReply)
+			}
+		})
+	}
+
+	t.Run("requested trip has nobody to talk to", func(t *testing.T) {
+		env := newTestEnv(t)
+		trip := env.createTrip(t)
+		_, err := env.service.SendChatMessage(context.Background(), trip.ID, passengerID, "hello?", "")
+		if !errors.Is(err, ErrChatClosed) {
+			t.Errorf("SendChatMessage error = %v, want %v", err, ErrChatClosed)
+		}
+	})
+}
+
+func TestChatReceipts(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusAccepted, chatDriverID)
+
+	for _, body := range []string{"first", "second"} {
+		if _, err := env.service.SendChatMessage(ctx, trip.ID, passengerID, body, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+	reply, err := env.service.SendChatMessage(ctx, trip.ID, chatDriverID, "", "on_my_way")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The sender's own history doesn't acknowledge anything on their behalf.
+	history, err := env.service.GetChatHistory(ctx, trip.ID, passengerID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 || history[0].DeliveredAt.Valid || history[1].DeliveredAt.Valid {
+		t.Fatalf("passenger history = %+v, want 3 messages with the passenger's undelivered", history)
+	}
+	if !history[2].DeliveredAt.Valid {
+		t.Errorf("driver's reply was not marked delivered when the passenger fetched it")
+	}
+
+	history, err = env.service.GetChatHistory(ctx, trip.ID, chatDriverID, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || !history[0].DeliveredAt.Valid {
+		t.Fatalf("driver history = %+v, want the first message, delivered", history)
+	}
+
+	updated, err := env.service.MarkChatRead(ctx, trip.ID, chatDriverID, reply.ID)
+	if err != nil || updated != 2 {
+		t.Fatalf("MarkChatRead = %d, %v, want 2 messages", updated, err)
+	}
+	// Reading again changes nothing.
+	if updated, err := env.service.MarkChatRead(ctx, trip.ID, chatDriverID, reply.ID); err != nil || updated != 0 {
+		t.Errorf("second MarkChatRead = %d, %v, want 0", updated, err)
+	}
+	history, err = env.service.GetChatHistory(ctx, trip.ID, passengerID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, message := range history[:2] {
+		if !message.ReadAt.Valid || !message.DeliveredAt.Valid {
+			t.Errorf("message %d = %+v, want delivered and read", message.ID, message)
+		}
+	}
+
+	if _, err := env.service.GetChatHistory(ctx, trip.ID, strangerID, 0, 0); !errors.Is(err, ErrNotTripParticipant) {
+		t.Errorf("stranger history error = %v, want %v", err, ErrNotTripParticipant)
+	}
+	if _, err := env.service.MarkChatRead(ctx, trip.ID, strangerID, reply.ID); !errors.Is(err, ErrNotTripParticipant) {
+		t.Errorf("stranger MarkChatRead error = %v, want %v", err, ErrNotTripParticipant)
+	}
+}
+
+func nextChatEvent(t *testing.T, events <-chan ChatEvent) (ChatEvent, bool) {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatal("no chat event within a second")
+		return ChatEvent{}, false
+	}
+}
+
+func TestSubscribeChat(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusStarted, chatDriverID)
+
+	if _, _, err := env.service.SubscribeChat(ctx, trip.ID, strangerID); !errors.Is(err, ErrNotTripParticipant) {
+		t.Fatalf("stranger SubscribeChat error = %v, want %v", err, ErrNotTripParticipant)
+	}
+	events, unsubscribe, err := env.service.SubscribeChat(ctx, trip.ID, passengerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unsubscribe()
+
+	sent, err := env.service.SendChatMessage(ctx, trip.ID, chatDriverID, "Almost there", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, ok := nextChatEvent(t, events)
+	if !ok || event.Message == nil || event.Message.ID != sent.ID {
+		t.Fatalf("event = %+v, want message %d", event, sent.ID)
+	}
+	if err := env.service.ChatDelivered(ctx, trip.ID, passengerID, sent.ID); err != nil {
+		t.Fatal(err)
+	}
+	event, ok = nextChatEvent(t, events)
+	if !ok || event.Receipt == nil || event.Receipt.Kind != models.ReceiptDelivered || event.Receipt.UpToID != sent.ID {
+		t.Fatalf("event = %+v, want delivery receipt for %d", event, sent.ID)
+	}
+
+	if err := env.service.UpdateTripStatus(ctx, models.StatusCompleted, trip.ID, chatDriverID); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := nextChatEvent(t, events); ok {
+		t.Fatal("subscription still open after the trip completed")
+	}
+	// Unsubscribing after the hub closed the subscription is harmless.
+	unsubscribe()
+
+	if _, _, err := env.service.SubscribeChat(ctx, trip.ID, passengerID); !errors.Is(err, ErrChatClosed) {
+		t.Errorf("SubscribeChat after completion error = %v, want %v", err, ErrChatClosed)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// exportBatchSize is how many trips are read from the database at a time, so
+// memory use stays flat however many trips are exported.
+const exportBatchSize = 500
+
+// ExportTrips calls send for every trip matching filter, in id order, and
+// returns how many were sent. It stops at the first error from send or the
+// database.
+func (trip *TripService) ExportTrips(ctx context.Context, filter repository.TripExportFilter, send func(models.Trip) error) (int, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return 0, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+	if zone := filter.Zone; zone != nil && (zone.MinLat > zone.MaxLat || zone.MinLng > zone.MaxLng) {
+		return 0, fmt.Errorf("%w: zone is not a valid bounding box", ErrInvalidFilter)
+	}
+
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.ExportTrips")
+	defer span.End()
+
+	sent, afterID := 0, 0
+	for {
+		batch, err := trip.DB.ExportTrips(ctx, filter, afterID, exportBatchSize)
+		if err != nil {
+			logger.Error(ctx, "Failed to read trips for export", "after_id", afterID, "error", err)
+			span.RecordError(err)
+			return sent, err
+		}
+		for _, record := range batch {
+			if err := send(record); err != nil {
+				span.RecordError(err)
+				return sent, err
+			}
+			sent++
+		}
+		span.SetAttributes(attribute.Int("export.trips", sent))
+		if len(batch) < exportBatchSize {
+			return sent, nil
+		}
+		afterID = batch[len(batch)-1].ID
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+)
+
+func TestExportTrips(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	// More than two batches, every third trip completed and paid by card.
+	const total = 2*exportBatchSize + 7
+	for i := 1; i <= total; i++ {
+		dto := repository.NewTripDTO{PassengerID: passengerID, PaymentMethod: "cash"}
+		if i%3 == 0 {
+			dto.PaymentMethod = "card"
+		}
+		trip, err := env.repo.CreateTrip(ctx, dto, 1000, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%3 == 0 {
+			env.setTrip(t, trip.ID, models.StatusCompleted, 1)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter repository.TripExportFilter
+		want   int
+	}{
+		{name: "everything", want: total},
+		{name: "by status", filter: repository.TripExportFilter{Statuses: []models.TripStatus{models.StatusCompleted}}, want: total / 3},
+		{name: "by payment method", filter: repository.TripExportFilter{PaymentMethod: "cash"}, want: total - total/3},
+		{name: "by driver", filter: repository.TripExportFilter{DriverID: 1, PaymentMethod: "card"}, want: total / 3},
+		{name: "other passenger", filter: repository.TripExportFilter{PassengerID: strangerID}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lastID := 0
+			sent, err := env.service.ExportTrips(ctx, tt.filter, func(trip models.Trip) error {
+				if trip.ID <= lastID {
+					t.Fatalf("trip %d exported after %d", trip.ID, lastID)
+				}
+				lastID = trip.ID
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ExportTrips: %v", err)
+			}
+			if sent != tt.want {
+				t.Errorf("exported %d trips, want %d", sent, tt.want)
+			}
+		})
+	}
+
+	t.Run("stops when the client goes away", func(t *testing.T) {
+		errGone := errors.New("client gone")
+		sent, err := env.service.ExportTrips(ctx, repository.TripExportFilter{}, func(trip models.Trip) error {
+			if trip.ID == 10 {
+				return errGone
+			}
+			return nil
+		})
+		if !errors.Is(err, errGone) || sent != 9 {
+			t.Errorf("ExportTrips = %d, %v, want 9, %v", sent, err, errGone)
+		}
+	})
+}
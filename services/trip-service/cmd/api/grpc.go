@@ -2,9 +2,12 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 	"trip-service/internal/models"
 	"trip-service/internal/repository"
 
@@ -13,6 +16,9 @@ import (
 	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,6 +28,12 @@ type TripServer struct {
 }
 
 func (s *TripServer) CreateTrip(ctx context.Context, req *pb.CreateTripRequest) (*pb.CreateTripResponse, error) {
+	return idempotent(ctx, s.Config.TripService, int(req.PassengerId), req.IdempotencyKey, "CreateTrip", req, func() (*pb.CreateTripResponse, error) {
+		return s.createTrip(ctx, req)
+	})
+}
+
+func (s *TripServer) createTrip(ctx context.Context, req *pb.CreateTripRequest) (*pb.CreateTripResponse, error) {
 	logger.AppInfo("Create Trip via gRPC",
 		"userID", strconv.Itoa(int(req.PassengerId)),
 	)
@@ -32,10 +44,19 @@ func (s *TripServer) CreateTrip(ctx context.Context, req *pb.CreateTripRequest)
 		DestLat:       req.DestLat,
 		DestLng:       req.DestLng,
 		PaymentMethod: req.PaymentMethod,
+		VehicleClass:  req.VehicleClass,
+		OriginPlaceID: int(req.OriginPlaceId),
+		DestPlaceID:   int(req.DestPlaceId),
 	}
 	tripRecord, duration, err := s.Config.TripService.CreateTrip(ctx, newTrip)
 	if err != nil {
 		logger.Error("Failed to create trip via gRPC", "error", err)
+		if errors.Is(err, ErrPlaceNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, ErrInvalidVehicleClass) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 	var status pb.TripStatus
@@ -63,19 +84,29 @@ func (s *TripServer) CreateTrip(ctx context.Context, req *pb.CreateTripRequest)
 			Status:        status,
 			Fare:          tripRecord.Fare,
 			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
 		},
 		Duration: float32(duration),
 	}, nil
 }
 
 func (s *TripServer) AcceptTrip(ctx context.Context, req *pb.AcceptTripRequest) (*pb.MessageResponse, error) {
+	return idempotent(ctx, s.Config.TripService, int(req.DriverId), req.IdempotencyKey, "AcceptTrip", req, func() (*pb.MessageResponse, error) {
+		return s.acceptTrip(ctx, req)
+	})
+}
+
+func (s *TripServer) acceptTrip(ctx context.Context, req *pb.AcceptTripRequest) (*pb.MessageResponse, error) {
 	logger.Info("Accept Trip via gRPC",
 		"driverID", strconv.Itoa(int(req.DriverId)),
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	err := s.Config.TripService.AcceptTrip(int(req.DriverId), int(req.TripId))
+	err := s.Config.TripService.AcceptTrip(ctx, int(req.DriverId), int(req.TripId))
 	if err != nil {
 		logger.Error("Failed to accept trip via gRPC", "error", err)
+		if errors.Is(err, ErrDriverBusy) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
 	return &pb.MessageResponse{
@@ -85,11 +116,17 @@ func (s *TripServer) AcceptTrip(ctx context.Context, req *pb.AcceptTripRequest)
 }
 
 func (s *TripServer) RejectTrip(ctx context.Context, req *pb.RejectTripRequest) (*pb.MessageResponse, error) {
+	return idempotent(ctx, s.Config.TripService, int(req.DriverId), req.IdempotencyKey, "RejectTrip", req, func() (*pb.MessageResponse, error) {
+		return s.rejectTrip(ctx, req)
+	})
+}
+
+func (s *TripServer) rejectTrip(ctx context.Context, req *pb.RejectTripRequest) (*pb.MessageResponse, error) {
 	logger.Info("Reject Trip via gRPC",
 		"driverID", strconv.Itoa(int(req.DriverId)),
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	err := s.Config.TripService.RejectTrip(int(req.PassengerId), int(req.DriverId), int(req.TripId))
+	err := s.Config.TripService.RejectTrip(ctx, int(req.DriverId), int(req.PassengerId), int(req.TripId))
 	if err != nil {
 		logger.Error("Failed to reject trip via gRPC", "error", err)
 		return nil, err
@@ -104,7 +141,7 @@ func (s *TripServer) GetSuggestedDriver(ctx context.Context, req *pb.TripIDReque
 	logger.Info("Get Suggested Driver via gRPC",
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	driverID, err := s.Config.TripService.GetSuggestedDriver(int(req.TripId))
+	driverID, err := s.Config.TripService.GetSuggestedDriver(ctx, int(req.TripId))
 	if err != nil {
 		logger.Error("Failed to get suggested driver via gRPC", "error", err)
 		return nil, err
@@ -119,7 +156,7 @@ func (s *TripServer) GetTripDetail(ctx context.Context, req *pb.TripIDRequest) (
 		"userID", strconv.Itoa(int(req.PassengerId)),
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	tripRecord, err := s.Config.TripService.GetTrip(int(req.PassengerId), int(req.TripId))
+	tripRecord, err := s.Config.TripService.GetTrip(ctx, int(req.PassengerId), int(req.TripId))
 	if err != nil {
 		logger.Error("Failed to get trip via gRPC", "error", err)
 		return nil, err
@@ -143,6 +180,7 @@ func (s *TripServer) GetTripDetail(ctx context.Context, req *pb.TripIDRequest) (
 			Distance:      tripRecord.Distance,
 			Fare:          tripRecord.Fare,
 			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
 			CreatedAt:     timestamppb.New(tripRecord.CreatedAt),
 			UpdatedAt:     timestamppb.New(tripRecord.UpdatedAt),
 		},
@@ -153,7 +191,7 @@ func (s *TripServer) GetTripsByPassenger(ctx context.Context, req *pb.GetTripsBy
 	logger.Info("Get Trips By Passenger via gRPC",
 		"passengerID", strconv.Itoa(int(req.UserId)),
 	)
-	trips, err := s.Config.TripService.GetTripsByPassenger(int(req.UserId))
+	trips, err := s.Config.TripService.GetTripsByPassenger(ctx, int(req.UserId))
 	if err != nil {
 		logger.Error("Failed to get trips by passenger via gRPC", "error", err)
 		return nil, err
@@ -178,6 +216,7 @@ func (s *TripServer) GetTripsByPassenger(ctx context.Context, req *pb.GetTripsBy
 			Distance:      tripRecord.Distance,
 			Fare:          tripRecord.Fare,
 			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
 			CreatedAt:     timestamppb.New(tripRecord.CreatedAt),
 			UpdatedAt:     timestamppb.New(tripRecord.UpdatedAt),
 		}
@@ -192,7 +231,7 @@ func (s *TripServer) GetTripsByDriver(ctx context.Context, req *pb.GetTripsByUse
 	logger.Info("Get Trips By Driver via gRPC",
 		"driverID", strconv.Itoa(int(req.UserId)),
 	)
-	trips, err := s.Config.TripService.GetTripsByDriver(int(req.UserId))
+	trips, err := s.Config.TripService.GetTripsByDriver(ctx, int(req.UserId))
 	if err != nil {
 		logger.Error("Failed to get trips by driver via gRPC", "error", err)
 		return nil, err
@@ -217,6 +256,7 @@ func (s *TripServer) GetTripsByDriver(ctx context.Context, req *pb.GetTripsByUse
 			Fare:          tripRecord.Fare,
 			Distance:      tripRecord.Distance,
 			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
 			CreatedAt:     timestamppb.New(tripRecord.CreatedAt),
 			UpdatedAt:     timestamppb.New(tripRecord.UpdatedAt),
 		}
@@ -232,7 +272,7 @@ func (s *TripServer) GetAllTrips(ctx context.Context, req *pb.GetAllTripsRequest
 		"page", strconv.Itoa(int(req.Page)),
 		"limit", strconv.Itoa(int(req.Limit)),
 	)
-	trips, err := s.Config.TripService.GetAllTrips(int(req.Page), int(req.Limit))
+	trips, err := s.Config.TripService.GetAllTrips(ctx, int(req.Page), int(req.Limit))
 	if err != nil {
 		logger.Error("Failed to get all trips via gRPC", "error", err)
 		return nil, err
@@ -257,6 +297,7 @@ func (s *TripServer) GetAllTrips(ctx context.Context, req *pb.GetAllTripsRequest
 			Distance:      tripRecord.Distance,
 			Fare:          tripRecord.Fare,
 			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
 		}
 		pbTrips = append(pbTrips, pbTrip)
 	}
@@ -268,19 +309,23 @@ func (s *TripServer) GetAllTrips(ctx context.Context, req *pb.GetAllTripsRequest
 }
 
 func (s *TripServer) UpdateTripStatus(ctx context.Context, req *pb.UpdateTripStatusRequest) (*pb.MessageResponse, error) {
+	return idempotent(ctx, s.Config.TripService, int(req.DriverId), req.IdempotencyKey, "UpdateTripStatus", req, func() (*pb.MessageResponse, error) {
+		return s.updateTripStatus(ctx, req)
+	})
+}
+
+func (s *TripServer) updateTripStatus(ctx context.Context, req *pb.UpdateTripStatusRequest) (*pb.MessageResponse, error) {
 	logger.Info("Update Trip Status via gRPC",
 		"tripID", strconv.Itoa(int(req.TripId)),
 		"tripStatus", req.Status.String(),
 	)
-	var status models.TripStatus
-	if v, ok := pb.TripStatus_value[req.Status.String()]; ok {
-		status = models.TripStatus(v)
-	} else {
-		err := fmt.Errorf("invalid trip status: %s", req.Status.String())
+	tripStatus, ok := tripStatusFromProto(req.Status)
+	if !ok {
+		err := status.Errorf(codes.InvalidArgument, "invalid trip status: %s", req.Status.String())
 		logger.Error("Failed to update trip status via gRPC", "error", err)
 		return nil, err
 	}
-	err := s.Config.TripService.UpdateTripStatus(status, int(req.TripId), int(req.DriverId))
+	err := s.Config.TripService.UpdateTripStatus(ctx, tripStatus, int(req.TripId), int(req.DriverId))
 	if err != nil {
 		logger.Error("Failed to update trip status via gRPC", "error", err)
 		return nil, err
@@ -292,13 +337,19 @@ func (s *TripServer) UpdateTripStatus(ctx context.Context, req *pb.UpdateTripSta
 }
 
 func (s *TripServer) CancelTrip(ctx context.Context, req *pb.CancelTripRequest) (*pb.MessageResponse, error) {
+	return idempotent(ctx, s.Config.TripService, int(req.UserId), req.IdempotencyKey, "CancelTrip", req, func() (*pb.MessageResponse, error) {
+		return s.cancelTrip(ctx, req)
+	})
+}
+
+func (s *TripServer) cancelTrip(ctx context.Context, req *pb.CancelTripRequest) (*pb.MessageResponse, error) {
 	logger.Info("Cancel Trip via gRPC",
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	err := s.Config.TripService.CancelTrip(int(req.UserId), int(req.TripId))
+	err := s.Config.TripService.CancelTrip(ctx, int(req.UserId), int(req.TripId))
 	if err != nil {
 		logger.Error("Failed to cancel trip via gRPC", "error", err)
-		return nil, err
+		return nil, tripRuleError(err)
 	}
 	return &pb.MessageResponse{
 		Message: fmt.Sprintf("Trip %d cancelled successfully", req.TripId),
@@ -314,10 +365,10 @@ func (s *TripServer) ReviewTrip(ctx context.Context, req *pb.SubmitReviewRequest
 		Comment:     req.Review.Comment,
 		Rating:      int(req.Review.Rating),
 	}
-	err := s.Config.TripService.ReviewTrip(int(req.UserId), int(req.TripId), review)
+	err := s.Config.TripService.ReviewTrip(ctx, int(req.UserId), int(req.TripId), review)
 	if err != nil {
 		logger.Error("Failed to review trip via gRPC", "error", err)
-		return nil, err
+		return nil, tripRuleError(err)
 	}
 	return &pb.MessageResponse{
 		Success: true,
@@ -330,7 +381,7 @@ func (s *TripServer) GetReview(ctx context.Context, req *pb.TripIDRequest) (*pb.
 		"userID", strconv.Itoa(int(req.PassengerId)),
 		"tripID", strconv.Itoa(int(req.TripId)),
 	)
-	review, err := s.Config.TripService.GetReview(int(req.TripId), int(req.PassengerId))
+	review, err := s.Config.TripService.GetReview(ctx, int(req.TripId), int(req.PassengerId))
 	if err != nil {
 		logger.Error("Failed to get review via gRPC", "error", err)
 		return nil, err
@@ -343,6 +394,619 @@ func (s *TripServer) GetReview(ctx context.Context, req *pb.TripIDRequest) (*pb.
 	}, nil
 }
 
+func (s *TripServer) GetTripReport(ctx context.Context, req *pb.TripReportRequest) (*pb.TripReportResponse, error) {
+	filter := repository.TripReportFilter{
+		ZoneCellSize: req.ZoneCellSize,
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	switch req.Interval {
+	case pb.ReportInterval_REPORT_INTERVAL_HOUR:
+		filter.Interval = models.IntervalHour
+	case pb.ReportInterval_REPORT_INTERVAL_DAY, pb.ReportInterval_REPORT_INTERVAL_UNSPECIFIED:
+		filter.Interval = models.IntervalDay
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown report interval %d", req.Interval)
+	}
+	if req.Zone != nil {
+		filter.Zone = &models.BoundingBox{
+			MinLat: req.Zone.MinLat,
+			MinLng: req.Zone.MinLng,
+			MaxLat: req.Zone.MaxLat,
+			MaxLng: req.Zone.MaxLng,
+		}
+	}
+
+	report, err := s.Config.TripService.GetTripReport(ctx, filter)
+	if errors.Is(err, ErrInvalidFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.Error("Failed to get trip report via gRPC", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.TripReportResponse{
+		From:    timestamppb.New(report.From),
+		To:      timestamppb.New(report.To),
+		Summary: toPBTripStats(report.Summary),
+	}
+	if report.Interval == models.IntervalHour {
+		resp.Interval = pb.ReportInterval_REPORT_INTERVAL_HOUR
+	} else {
+		resp.Interval = pb.ReportInterval_REPORT_INTERVAL_DAY
+	}
+	for _, bucket := range report.Buckets {
+		resp.Buckets = append(resp.Buckets, &pb.TripReportBucket{
+			Start: timestamppb.New(bucket.Start),
+			Stats: toPBTripStats(bucket.TripStats),
+		})
+	}
+	for _, zone := range report.Zones {
+		resp.Zones = append(resp.Zones, &pb.ZoneStats{
+			Cell: &pb.BoundingBox{
+				MinLat: zone.Cell.MinLat,
+				MinLng: zone.Cell.MinLng,
+				MaxLat: zone.Cell.MaxLat,
+				MaxLng: zone.Cell.MaxLng,
+			},
+			Stats: toPBTripStats(zone.TripStats),
+		})
+	}
+	for _, revenue := range report.RevenueByPaymentMethod {
+		resp.RevenueByPaymentMethod = append(resp.RevenueByPaymentMethod, &pb.PaymentMethodRevenue{
+			PaymentMethod: revenue.PaymentMethod,
+			Trips:         revenue.Trips,
+			Revenue:       revenue.Revenue,
+		})
+	}
+	return resp, nil
+}
+
+func toPBTripStats(stats models.TripStats) *pb.TripStats {
+	return &pb.TripStats{
+		Trips:                  stats.Trips,
+		Completed:              stats.Completed,
+		Cancelled:              stats.Cancelled,
+		CompletionRate:         stats.CompletionRate,
+		CancellationRate:       stats.CancellationRate,
+		AvgWaitToAcceptSeconds: stats.AvgWaitToAcceptSeconds,
+		AvgFare:                stats.AvgFare,
+		AvgDistance:            stats.AvgDistance,
+		Revenue:                stats.Revenue,
+	}
+}
+
+func (s *TripServer) GetDriverOfferReport(ctx context.Context, req *pb.DriverOfferReportRequest) (*pb.DriverOfferReportResponse, error) {
+	filter := DriverOfferReportFilter{
+		DriverID:  int(req.DriverId),
+		MinOffers: int(req.MinOffers),
+		Limit:     int(req.Limit),
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+
+	report, err := s.Config.TripService.GetDriverOfferReport(ctx, filter)
+	if errors.Is(err, ErrInvalidFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.Error("Failed to get driver offer report via gRPC", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.DriverOfferReportResponse{
+		From:    timestamppb.New(report.From),
+		To:      timestamppb.New(report.To),
+		Summary: toPBDriverOfferStats(report.Summary),
+	}
+	for _, driver := range report.Drivers {
+		resp.Drivers = append(resp.Drivers, toPBDriverOfferStats(driver))
+	}
+	return resp, nil
+}
+
+func toPBDriverOfferStats(stats models.DriverOfferStats) *pb.DriverOfferStats {
+	return &pb.DriverOfferStats{
+		DriverId:         int32(stats.DriverID),
+		Offers:           stats.Offers,
+		Accepted:         stats.Accepted,
+		Rejected:         stats.Rejected,
+		TimedOut:         stats.TimedOut,
+		Cancelled:        stats.Cancelled,
+		AcceptanceRate:   stats.AcceptanceRate,
+		CancellationRate: stats.CancellationRate,
+	}
+}
+
+func (s *TripServer) ExportTrips(req *pb.ExportTripsRequest, stream grpc.ServerStreamingServer[pb.Trip]) error {
+	filter := repository.TripExportFilter{
+		PassengerID:   int(req.PassengerId),
+		DriverID:      int(req.DriverId),
+		PaymentMethod: req.PaymentMethod,
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	for _, st := range req.Statuses {
+		tripStatus, ok := tripStatusFromProto(st)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid trip status %d", st)
+		}
+		filter.Statuses = append(filter.Statuses, tripStatus)
+	}
+	if req.Zone != nil {
+		filter.Zone = &models.BoundingBox{
+			MinLat: req.Zone.MinLat,
+			MinLng: req.Zone.MinLng,
+			MaxLat: req.Zone.MaxLat,
+			MaxLng: req.Zone.MaxLng,
+		}
+	}
+
+	ctx := stream.Context()
+	sent, err := s.Config.TripService.ExportTrips(ctx, filter, func(trip models.Trip) error {
+		return stream.Send(toPBTrip(trip))
+	})
+	if errors.Is(err, ErrInvalidFilter) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to export trips via gRPC", "sent", sent, "error", err)
+		return err
+	}
+	logger.Info(ctx, "Exported trips via gRPC", "sent", sent)
+	return nil
+}
+
+// toPBTrip converts a trip including its timestamps; unset times stay nil.
+func toPBTrip(trip models.Trip) *pb.Trip {
+	timestamp := func(t sql.NullTime) *timestamppb.Timestamp {
+		if !t.Valid {
+			return nil
+		}
+		return timestamppb.New(t.Time)
+	}
+	return &pb.Trip{
+		Id:             int32(trip.ID),
+		PassengerId:    int32(trip.PassengerID),
+		DriverId:       trip.DriverID.Int32,
+		OriginLat:      trip.OriginLat,
+		OriginLng:      trip.OriginLng,
+		DestLat:        trip.DestLat,
+		DestLng:        trip.DestLng,
+		Status:         pb.TripStatus(pb.TripStatus_value[string(trip.Status)]),
+		Distance:       trip.Distance,
+		Fare:           trip.Fare,
+		PaymentMethod:  trip.PaymentMethod,
+		VehicleClass:   trip.VehicleClass,
+		Rating:         trip.Rating.Int32,
+		Review:         trip.Review.String,
+		CreatedAt:      timestamppb.New(trip.CreatedAt),
+		UpdatedAt:      timestamppb.New(trip.UpdatedAt),
+		AcceptedAt:     timestamp(trip.AcceptedAt),
+		StartedAt:      timestamp(trip.StartedAt),
+		CompletedAt:    timestamp(trip.CompletedAt),
+		CancelledAt:    timestamp(trip.CancelledAt),
+		CancelByUserId: int32(trip.CancelByUserID.Int64),
+	}
+}
+
+// chatError maps chat errors to status codes the gateway can act on.
+func chatError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidChatMessage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNotTripParticipant):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrChatClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "trip not found")
+	}
+	return err
+}
+
+func toPBChatMessage(message models.ChatMessage) *pb.ChatMessage {
+	msg := &pb.ChatMessage{
+		Id:          message.ID,
+		TripId:      int32(message.TripID),
+		SenderId:    int32(message.SenderID),
+		RecipientId: int32(message.RecipientID),
+		Body:        message.Body,
+		QuickReply:  message.QuickReply.String,
+		CreatedAt:   timestamppb.New(message.CreatedAt),
+	}
+	if message.DeliveredAt.Valid {
+		msg.DeliveredAt = timestamppb.New(message.DeliveredAt.Time)
+	}
+	if message.ReadAt.Valid {
+		msg.ReadAt = timestamppb.New(message.ReadAt.Time)
+	}
+	return msg
+}
+
+func toPBChatEvent(event ChatEvent) *pb.ChatEvent {
+	if event.Message != nil {
+		return &pb.ChatEvent{Event: &pb.ChatEvent_Message{Message: toPBChatMessage(*event.Message)}}
+	}
+	return &pb.ChatEvent{Event: &pb.ChatEvent_Receipt{Receipt: &pb.ChatReceipt{
+		TripId:      int32(event.Receipt.TripID),
+		RecipientId: int32(event.Receipt.RecipientID),
+		UpToId:      event.Receipt.UpToID,
+		Kind:        pb.ChatReceiptKind(pb.ChatReceiptKind_value[string(event.Receipt.Kind)]),
+		At:          timestamppb.New(event.Receipt.At),
+	}}}
+}
+
+func (s *TripServer) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.ChatMessage, error) {
+	message, err := s.Config.TripService.SendChatMessage(ctx, int(req.TripId), int(req.SenderId), req.Body, req.QuickReply)
+	if err != nil {
+		logger.Error(ctx, "Failed to send chat message via gRPC", "trip_id", req.TripId, "error", err)
+		return nil, chatError(err)
+	}
+	return toPBChatMessage(message), nil
+}
+
+func (s *TripServer) GetChatHistory(ctx context.Context, req *pb.ChatHistoryRequest) (*pb.ChatHistoryResponse, error) {
+	messages, err := s.Config.TripService.GetChatHistory(ctx, int(req.TripId), int(req.UserId), req.AfterId, int(req.Limit))
+	if err != nil {
+		logger.Error(ctx, "Failed to get chat history via gRPC", "trip_id", req.TripId, "error", err)
+		return nil, chatError(err)
+	}
+	resp := &pb.ChatHistoryResponse{Messages: make([]*pb.ChatMessage, 0, len(messages))}
+	for _, message := range messages {
+		resp.Messages = append(resp.Messages, toPBChatMessage(message))
+	}
+	return resp, nil
+}
+
+func (s *TripServer) MarkChatRead(ctx context.Context, req *pb.MarkChatReadRequest) (*pb.MarkChatReadResponse, error) {
+	updated, err := s.Config.TripService.MarkChatRead(ctx, int(req.TripId), int(req.UserId), req.UpToId)
+	if err != nil {
+		logger.Error(ctx, "Failed to mark chat read via gRPC", "trip_id", req.TripId, "error", err)
+		return nil, chatError(err)
+	}
+	return &pb.MarkChatReadResponse{Updated: updated}, nil
+}
+
+func (s *TripServer) ListQuickReplies(ctx context.Context, req *pb.QuickRepliesRequest) (*pb.QuickRepliesResponse, error) {
+	replies := QuickReplies(req.Role)
+	if replies == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown role %q", req.Role)
+	}
+	resp := &pb.QuickRepliesResponse{Replies: make([]*pb.QuickReply, 0, len(replies))}
+	for _, reply := range replies {
+		resp.Replies = append(resp.Replies, &pb.QuickReply{Key: reply.Key, Text: reply.Text})
+	}
+	return resp, nil
+}
+
+// SubscribeTripChat sends empty headers as soon as the subscription is in
+// place, so the caller can tell a rejected subscription from a quiet chat.
+func (s *TripServer) SubscribeTripChat(req *pb.SubscribeTripChatRequest, stream grpc.ServerStreamingServer[pb.ChatEvent]) error {
+	ctx := stream.Context()
+	tripID, userID := int(req.TripId), int(req.UserId)
+	events, unsubscribe, err := s.Config.TripService.SubscribeChat(ctx, tripID, userID)
+	if err != nil {
+		logger.Error(ctx, "Failed to subscribe to trip chat", "trip_id", tripID, "user_id", userID, "error", err)
+		return chatError(err)
+	}
+	defer unsubscribe()
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				// The trip has ended.
+				return nil
+			}
+			if err := stream.Send(toPBChatEvent(event)); err != nil {
+				return err
+			}
+			if event.Message != nil && event.Message.RecipientID == userID {
+				if err := s.Config.TripService.ChatDelivered(ctx, tripID, userID, event.Message.ID); err != nil {
+					logger.Warn(ctx, "Failed to record chat delivery", "trip_id", tripID, "error", err)
+				}
+			}
+		}
+	}
+}
+
+func incidentError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidIncident):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrNotTripParticipant):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTripNotActive), errors.Is(err, ErrIncidentClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrIncidentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "trip not found")
+	}
+	return err
+}
+
+func toPBIncident(incident models.Incident) *pb.Incident {
+	resp := &pb.Incident{
+		Id:           incident.ID,
+		TripId:       int32(incident.TripID),
+		RaisedBy:     int32(incident.RaisedBy),
+		RaisedByRole: incident.RaisedByRole,
+		Note:         incident.Note,
+		Status:       string(incident.Status),
+		Trip:         toPBTrip(incident.Snapshot.Trip),
+		CreatedAt:    timestamppb.New(incident.CreatedAt),
+		ClosedBy:     incident.ClosedBy.Int32,
+		Resolution:   incident.Resolution.String,
+	}
+	for _, party := range incident.Snapshot.Locations {
+		resp.Locations = append(resp.Locations, &pb.PartyLocation{
+			UserId:     int32(party.UserID),
+			Role:       party.Role,
+			Known:      party.Known,
+			Latitude:   party.Latitude,
+			Longitude:  party.Longitude,
+			Speed:      party.Speed,
+			Heading:    party.Heading,
+			ReportedAt: party.ReportedAt,
+		})
+	}
+	if vehicle := incident.Snapshot.Vehicle; vehicle != nil {
+		resp.Vehicle = &pb.IncidentVehicle{
+			VehicleId:    int32(vehicle.VehicleID),
+			LicensePlate: vehicle.LicensePlate,
+			VehicleType:  vehicle.VehicleType,
+			Seats:        int32(vehicle.Seats),
+		}
+	}
+	if incident.ClosedAt.Valid {
+		resp.ClosedAt = timestamppb.New(incident.ClosedAt.Time)
+	}
+	return resp
+}
+
+func (s *TripServer) RaiseSOS(ctx context.Context, req *pb.RaiseSOSRequest) (*pb.RaiseSOSResponse, error) {
+	incident, created, err := s.Config.TripService.RaiseSOS(ctx, int(req.TripId), int(req.UserId), req.Note)
+	if err != nil {
+		logger.Error(ctx, "Failed to raise SOS via gRPC", "trip_id", req.TripId, "error", err)
+		return nil, incidentError(err)
+	}
+	return &pb.RaiseSOSResponse{Incident: toPBIncident(incident), Created: created}, nil
+}
+
+func (s *TripServer) GetIncident(ctx context.Context, req *pb.IncidentRequest) (*pb.Incident, error) {
+	incident, err := s.Config.TripService.GetIncident(ctx, req.IncidentId)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+	return toPBIncident(incident), nil
+}
+
+func (s *TripServer) ListIncidents(ctx context.Context, req *pb.ListIncidentsRequest) (*pb.ListIncidentsResponse, error) {
+	incidents, err := s.Config.TripService.ListIncidents(ctx, models.IncidentStatus(req.Status), int(req.TripId), 0)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+	resp := &pb.ListIncidentsResponse{}
+	for _, incident := range incidents {
+		resp.Incidents = append(resp.Incidents, toPBIncident(incident))
+	}
+	return resp, nil
+}
+
+func (s *TripServer) GetIncidentTrack(ctx context.Context, req *pb.IncidentTrackRequest) (*pb.IncidentTrackResponse, error) {
+	points, err := s.Config.TripService.GetIncidentTrack(ctx, req.IncidentId, req.AfterId, int(req.Limit))
+	if err != nil {
+		return nil, incidentError(err)
+	}
+	resp := &pb.IncidentTrackResponse{}
+	for _, point := range points {
+		resp.Points = append(resp.Points, &pb.TrackPoint{
+			Id:         point.ID,
+			UserId:     int32(point.UserID),
+			Latitude:   point.Latitude,
+			Longitude:  point.Longitude,
+			Speed:      point.Speed,
+			Heading:    point.Heading,
+			ReportedAt: point.ReportedAt,
+			RecordedAt: timestamppb.New(point.RecordedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *TripServer) CloseIncident(ctx context.Context, req *pb.CloseIncidentRequest) (*pb.Incident, error) {
+	incident, err := s.Config.TripService.CloseIncident(ctx, req.IncidentId, int(req.OperatorId), req.Resolution)
+	if err != nil {
+		logger.Error(ctx, "Failed to close incident via gRPC", "incident_id", req.IncidentId, "error", err)
+		return nil, incidentError(err)
+	}
+	return toPBIncident(incident), nil
+}
+
+func shareError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidShare):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrShareNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrShareExpired), errors.Is(err, ErrShareTripEnded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrShareNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "trip not found")
+	}
+	return err
+}
+
+func toPBShareLink(link models.ShareLink) *pb.ShareLink {
+	resp := &pb.ShareLink{
+		Id:        link.ID,
+		TripId:    int32(link.TripID),
+		CreatedAt: timestamppb.New(link.CreatedAt),
+		ExpiresAt: timestamppb.New(link.ExpiresAt),
+	}
+	if link.RevokedAt.Valid {
+		resp.RevokedAt = timestamppb.New(link.RevokedAt.Time)
+	}
+	return resp
+}
+
+func (s *TripServer) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.ShareLink, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	link, token, err := s.Config.TripService.CreateShareLink(ctx, int(req.TripId), int(req.UserId), ttl)
+	if err != nil {
+		return nil, shareError(err)
+	}
+	resp := toPBShareLink(link)
+	resp.Token = token
+	return resp, nil
+}
+
+func (s *TripServer) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	links, err := s.Config.TripService.ListShareLinks(ctx, int(req.TripId), int(req.UserId))
+	if err != nil {
+		return nil, shareError(err)
+	}
+	resp := &pb.ListShareLinksResponse{}
+	for _, link := range links {
+		resp.Links = append(resp.Links, toPBShareLink(link))
+	}
+	return resp, nil
+}
+
+func (s *TripServer) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.MessageResponse, error) {
+	if err := s.Config.TripService.RevokeShareLink(ctx, int(req.TripId), int(req.UserId), req.ShareId); err != nil {
+		return nil, shareError(err)
+	}
+	return &pb.MessageResponse{
+		Success: true,
+		Message: "Share link revoked",
+	}, nil
+}
+
+func (s *TripServer) GetSharedTrip(ctx context.Context, req *pb.SharedTripRequest) (*pb.SharedTripView, error) {
+	view, err := s.Config.TripService.GetSharedTrip(ctx, req.Token)
+	if err != nil {
+		return nil, shareError(err)
+	}
+	resp := &pb.SharedTripView{
+		Status:          pb.TripStatus(pb.TripStatus_value[string(view.Status)]),
+		DriverFirstName: view.DriverFirstName,
+		LicensePlate:    view.LicensePlate,
+		VehicleType:     view.VehicleType,
+		EtaSeconds:      int32(view.ETASeconds),
+		EtaTarget:       view.ETATarget,
+		ExpiresAt:       timestamppb.New(view.ExpiresAt),
+	}
+	if p := view.DriverPosition; p != nil {
+		resp.DriverPosition = &pb.SharedPosition{
+			Latitude:   p.Latitude,
+			Longitude:  p.Longitude,
+			Heading:    p.Heading,
+			ReportedAt: p.ReportedAt,
+		}
+	}
+	return resp, nil
+}
+
+// tripStatusFromProto returns the status as stored in trips.status, which holds
+// the enum's name rather than its number, or false for STATUS_UNKNOWN and
+// values the proto doesn't define.
+func tripStatusFromProto(st pb.TripStatus) (models.TripStatus, bool) {
+	name, ok := pb.TripStatus_name[int32(st)]
+	if !ok || st == pb.TripStatus_STATUS_UNKNOWN {
+		return "", false
+	}
+	return models.TripStatus(name), true
+}
+
+// tripRuleError maps CancelTrip and ReviewTrip refusals to status codes.
+func tripRuleError(err error) error {
+	switch {
+	case errors.Is(err, ErrCancelNotAllowed), errors.Is(err, ErrReviewNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTripNotCancellable), errors.Is(err, ErrTripNotReviewable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
+func tipError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidTip):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrTipNotAllowed), errors.Is(err, ErrNotTripParticipant):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrTipExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrTipsUnavailable):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, ErrTipWindowClosed), errors.Is(err, ErrTipNotChargeable), errors.Is(err, ErrReceiptUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, "trip not found")
+	}
+	return err
+}
+
+func toPBReceipt(receipt models.Receipt) *pb.TripReceipt {
+	resp := &pb.TripReceipt{
+		TripId:        int32(receipt.TripID),
+		PassengerId:   int32(receipt.PassengerID),
+		DriverId:      int32(receipt.DriverID),
+		PaymentMethod: receipt.PaymentMethod,
+		Distance:      receipt.Distance,
+		Fare:          receipt.Fare,
+		Tip:           receipt.Tip,
+		Total:         receipt.Total,
+		CompletedAt:   timestamppb.New(receipt.CompletedAt),
+	}
+	if receipt.TippedAt.Valid {
+		resp.TippedAt = timestamppb.New(receipt.TippedAt.Time)
+	}
+	if receipt.TipDeadline.Valid {
+		resp.TipDeadline = timestamppb.New(receipt.TipDeadline.Time)
+	}
+	return resp
+}
+
+func (s *TripServer) AddTip(ctx context.Context, req *pb.AddTipRequest) (*pb.TripReceipt, error) {
+	receipt, err := s.Config.TripService.AddTip(ctx, int(req.TripId), int(req.PassengerId), req.Amount)
+	if err != nil {
+		return nil, tipError(err)
+	}
+	return toPBReceipt(receipt), nil
+}
+
+func (s *TripServer) GetTripReceipt(ctx context.Context, req *pb.TripReceiptRequest) (*pb.TripReceipt, error) {
+	receipt, err := s.Config.TripService.GetTripReceipt(ctx, int(req.TripId), int(req.UserId))
+	if err != nil {
+		return nil, tipError(err)
+	}
+	return toPBReceipt(receipt), nil
+}
+
 func (app *Config) StartGRPCServer() error {
 	lis, err := net.Listen("tcp", ":50054")
 	if err != nil {
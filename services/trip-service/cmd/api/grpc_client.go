@@ -4,9 +4,11 @@ import (
 	"context"
 	"strconv"
 	"time"
+	"trip-service/internal/models"
 
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
 	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -14,6 +16,7 @@ import (
 
 type GRPCClients struct {
 	LocationClient locationpb.LocationServiceClient
+	UserClient     userpb.UserServiceClient
 }
 
 func (grpcClients *GRPCClients) InitGRPCClients() (*GRPCClients, error) {
@@ -27,11 +30,23 @@ func (grpcClients *GRPCClients) InitGRPCClients() (*GRPCClients, error) {
 		return nil, err
 	}
 
+	userConn, err := grpc.NewClient(
+		"user-service:50055",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		logger.Error("Failed to connect to user service", "error", err)
+		return nil, err
+	}
+
 	logger.Info("gRPC clients initialized",
 		"location_addr", "location-service:50053",
+		"user_addr", "user-service:50055",
 	)
 	return &GRPCClients{
 		LocationClient: locationpb.NewLocationServiceClient(locationConn),
+		UserClient:     userpb.NewUserServiceClient(userConn),
 	}, nil
 }
 
@@ -84,26 +99,30 @@ func (grpcClients *GRPCClients) GetLocationViaGRPC(ctx context.Context, userID i
 	return resp, nil
 }
 
-// FindNearestUsersViaGRPC finds nearest users via gRPC
-func (grpcClients *GRPCClients) FindNearestUsersViaGRPC(ctx context.Context, userID int, topN int32, radius float64) (*locationpb.FindNearestUsersResponse, error) {
+// SearchDriversViaGRPC finds the online drivers within radius km of a point
+// via gRPC
+func (grpcClients *GRPCClients) SearchDriversViaGRPC(ctx context.Context, lat, lng float64, radius float64, limit int32) (*locationpb.SearchLocationsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	req := &locationpb.FindNearestUsersRequest{
-		UserId: int32(userID),
-		TopN:   topN,
-		Radius: radius,
+	req := &locationpb.SearchLocationsRequest{
+		Latitude:  lat,
+		Longitude: lng,
+		Radius:    radius,
+		Role:      "driver",
+		Limit:     limit,
 	}
 
-	logger.Info("Calling location service FindNearestUsers via gRPC",
-		"user_id", strconv.Itoa(userID),
-		"top_n", topN,
+	logger.Info("Calling location service SearchLocations via gRPC",
+		"latitude", lat,
+		"longitude", lng,
+		"limit", limit,
 		"radius", radius,
 	)
 
-	resp, err := grpcClients.LocationClient.FindNearestUsers(ctx, req)
+	resp, err := grpcClients.LocationClient.SearchLocations(ctx, req)
 	if err != nil {
-		logger.Error("gRPC FindNearestUsers failed", "error", err)
+		logger.Error("gRPC SearchLocations failed", "error", err)
 		return nil, err
 	}
 
@@ -127,3 +146,188 @@ func (grpcClients *GRPCClients) GetAllLocationsViaGRPC(ctx context.Context) (*lo
 
 	return resp, nil
 }
+
+// MarkDriverOnTripViaGRPC takes a driver out of matching for a trip via gRPC
+func (grpcClients *GRPCClients) MarkDriverOnTripViaGRPC(ctx context.Context, driverID int, tripID int) (*locationpb.DriverAvailabilityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.DriverTripRequest{
+		DriverId: int32(driverID),
+		TripId:   int32(tripID),
+	}
+
+	resp, err := grpcClients.LocationClient.MarkDriverOnTrip(ctx, req)
+	if err != nil {
+		logger.Error("gRPC MarkDriverOnTrip failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ReleaseDriverViaGRPC puts a driver back online after a trip via gRPC
+func (grpcClients *GRPCClients) ReleaseDriverViaGRPC(ctx context.Context, driverID int, tripID int) (*locationpb.DriverAvailabilityResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &locationpb.DriverTripRequest{
+		DriverId: int32(driverID),
+		TripId:   int32(tripID),
+	}
+
+	resp, err := grpcClients.LocationClient.ReleaseDriver(ctx, req)
+	if err != nil {
+		logger.Error("gRPC ReleaseDriver failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetVehiclesByUserIdViaGRPC gets a driver's vehicles via gRPC
+func (grpcClients *GRPCClients) GetVehiclesByUserIdViaGRPC(ctx context.Context, userID int) (*userpb.GetVehiclesByUserIdResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetVehiclesByUserIdRequest{
+		UserId: int32(userID),
+	}
+
+	logger.Info("Calling user service GetVehiclesByUserId via gRPC",
+		"user_id", strconv.Itoa(userID),
+	)
+
+	resp, err := grpcClients.UserClient.GetVehiclesByUserId(ctx, req)
+	if err != nil {
+		logger.Error("gRPC GetVehiclesByUserId failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetUserByIdViaGRPC gets a user's profile via gRPC
+func (grpcClients *GRPCClients) GetUserByIdViaGRPC(ctx context.Context, userID int) (*userpb.GetUserByIdResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetUserByIdRequest{
+		UserId: int32(userID),
+	}
+
+	logger.Info("Calling user service GetUserById via gRPC",
+		"user_id", strconv.Itoa(userID),
+	)
+
+	resp, err := grpcClients.UserClient.GetUserById(ctx, req)
+	if err != nil {
+		logger.Error("gRPC GetUserById failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreditDriverEarningViaGRPC adds a trip earning to the driver's revenue via gRPC
+func (grpcClients *GRPCClients) CreditDriverEarningViaGRPC(ctx context.Context, driverID int, tripID int, kind string, amount float64) (*userpb.CreditDriverEarningResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.CreditDriverEarningRequest{
+		DriverId: int32(driverID),
+		TripId:   int32(tripID),
+		Kind:     kind,
+		Amount:   amount,
+	}
+
+	logger.Info("Calling user service CreditDriverEarning via gRPC",
+		"driver_id", strconv.Itoa(driverID),
+		"trip_id", strconv.Itoa(tripID),
+	)
+
+	resp, err := grpcClients.UserClient.CreditDriverEarning(ctx, req)
+	if err != nil {
+		logger.Error("gRPC CreditDriverEarning failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetSavedPlaceViaGRPC gets one of a user's saved places via gRPC
+func (grpcClients *GRPCClients) GetSavedPlaceViaGRPC(ctx context.Context, placeID int, userID int) (*userpb.SavedPlaceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.GetSavedPlaceRequest{
+		PlaceId: int32(placeID),
+		UserId:  int32(userID),
+	}
+
+	logger.Info("Calling user service GetSavedPlace via gRPC",
+		"place_id", strconv.Itoa(placeID),
+		"user_id", strconv.Itoa(userID),
+	)
+
+	resp, err := grpcClients.UserClient.GetSavedPlace(ctx, req)
+	if err != nil {
+		logger.Error("gRPC GetSavedPlace failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FilterBlockedUsersViaGRPC returns the candidates with no block either way
+// with userID via gRPC
+func (grpcClients *GRPCClients) FilterBlockedUsersViaGRPC(ctx context.Context, userID int, candidateIDs []int) (*userpb.FilterBlockedUsersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.FilterBlockedUsersRequest{
+		UserId:       int32(userID),
+		CandidateIds: make([]int32, 0, len(candidateIDs)),
+	}
+	for _, id := range candidateIDs {
+		req.CandidateIds = append(req.CandidateIds, int32(id))
+	}
+
+	resp, err := grpcClients.UserClient.FilterBlockedUsers(ctx, req)
+	if err != nil {
+		logger.Error("gRPC FilterBlockedUsers failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RecordDriverOfferStatsViaGRPC pushes a driver's offer stats to their profile
+// via gRPC
+func (grpcClients *GRPCClients) RecordDriverOfferStatsViaGRPC(ctx context.Context, stats models.DriverOfferStats, windowDays int, asOf time.Time) (*userpb.RecordDriverOfferStatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req := &userpb.RecordDriverOfferStatsRequest{
+		Stats: &userpb.DriverOfferStats{
+			DriverId:         int32(stats.DriverID),
+			Offers:           stats.Offers,
+			Accepted:         stats.Accepted,
+			Rejected:         stats.Rejected,
+			TimedOut:         stats.TimedOut,
+			Cancelled:        stats.Cancelled,
+			AcceptanceRate:   stats.AcceptanceRate,
+			CancellationRate: stats.CancellationRate,
+			WindowDays:       int32(windowDays),
+			AsOf:             asOf.UTC().Format(time.RFC3339Nano),
+		},
+	}
+
+	resp, err := grpcClients.UserClient.RecordDriverOfferStats(ctx, req)
+	if err != nil {
+		logger.Error("gRPC RecordDriverOfferStats failed", "error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -23,8 +23,10 @@ type AcceptTripRequest struct {
 	TripID   int `json:"trip_id" validate:"required"`
 }
 
+// RejectTripRequest still accepts passenger_id from older clients, but no
+// longer requires it: the trip already names its passenger.
 type RejectTripRequest struct {
-	PassengerID int `json:"passenger_id" validate:"required"`
+	PassengerID int `json:"passenger_id"`
 	DriverID    int `json:"driver_id" validate:"required"`
 	TripID      int `json:"trip_id" validate:"required"`
 }
@@ -70,7 +72,7 @@ func (app *Config) AcceptTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.TripService.AcceptTrip(acceptRequest.DriverID, acceptRequest.TripID)
+	err = app.TripService.AcceptTrip(r.Context(), acceptRequest.DriverID, acceptRequest.TripID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -88,7 +90,7 @@ func (app *Config) RejectTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.TripService.RejectTrip(rejectRequest.PassengerID, rejectRequest.DriverID, rejectRequest.TripID)
+	err = app.TripService.RejectTrip(r.Context(), rejectRequest.DriverID, rejectRequest.PassengerID, rejectRequest.TripID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -106,7 +108,7 @@ func (app *Config) GetSuggestedDriver(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Invalid trip ID")
 		return
 	}
-	driver, err := app.TripService.GetSuggestedDriver(tripID)
+	driver, err := app.TripService.GetSuggestedDriver(r.Context(), tripID)
 	if err != nil || driver == 0 {
 		response.BadRequest(w, err.Error())
 		return
@@ -131,7 +133,7 @@ func (app *Config) GetTripDetail(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Invalid user ID")
 		return
 	}
-	tripRecord, err := app.TripService.GetTrip(userID, tripID)
+	tripRecord, err := app.TripService.GetTrip(r.Context(), userID, tripID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -149,7 +151,7 @@ func (app *Config) GetTripsByPassenger(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Invalid passenger ID")
 		return
 	}
-	trips, err := app.TripService.GetTripsByPassenger(passengerID)
+	trips, err := app.TripService.GetTripsByPassenger(r.Context(), passengerID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -168,7 +170,7 @@ func (app *Config) GetTripsByDriver(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Invalid driver ID")
 		return
 	}
-	trips, err := app.TripService.GetTripsByDriver(driverID)
+	trips, err := app.TripService.GetTripsByDriver(r.Context(), driverID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -195,7 +197,7 @@ func (app *Config) GetAllTrips(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	trips, err := app.TripService.GetAllTrips(page, limit)
+	trips, err := app.TripService.GetAllTrips(r.Context(), page, limit)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -214,7 +216,7 @@ func (app *Config) UpdateTripStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.TripService.UpdateTripStatus(updateRequest.TripStatus, updateRequest.TripID, updateRequest.UserID)
+	err = app.TripService.UpdateTripStatus(r.Context(), updateRequest.TripStatus, updateRequest.TripID, updateRequest.UserID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -232,7 +234,7 @@ func (app *Config) CancelTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.TripService.CancelTrip(cancelRequest.UserID, cancelRequest.TripID)
+	err = app.TripService.CancelTrip(r.Context(), cancelRequest.UserID, cancelRequest.TripID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -250,7 +252,7 @@ func (app *Config) ReviewTrip(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = app.TripService.ReviewTrip(reviewRequest.UserID, reviewRequest.TripID, reviewRequest.Review)
+	err = app.TripService.ReviewTrip(r.Context(), reviewRequest.UserID, reviewRequest.TripID, reviewRequest.Review)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -274,7 +276,7 @@ func (app *Config) GetReview(w http.ResponseWriter, r *http.Request) {
 		response.BadRequest(w, "Invalid trip ID")
 		return
 	}
-	review, err := app.TripService.GetReview(tripID, userID)
+	review, err := app.TripService.GetReview(r.Context(), tripID, userID)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/grpcutil"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultIdempotencyRetention = 24 * time.Hour
+	// idempotencyLease is how long a reservation holds its key while the
+	// request runs. A request that crashed or couldn't store its response
+	// stops blocking retries once it ends.
+	idempotencyLease = time.Minute
+	idempotencyKeyField         = "idempotency_key"
+	maxIdempotencyKeyLength     = 255
+)
+
+// idempotencyRetention returns how long stored responses are replayed for,
+// configurable via IDEMPOTENCY_RETENTION (e.g. "24h").
+func idempotencyRetention() time.Duration {
+	d, err := time.ParseDuration(env.Get("IDEMPOTENCY_RETENTION", ""))
+	if err != nil || d <= 0 {
+		return defaultIdempotencyRetention
+	}
+	return d
+}
+
+// idempotent runs exec at most once per (userID, key) within the retention window.
+// A retry with the same key and payload gets the stored response back; a retry that
+// arrives while the first call is still running gets codes.Aborted, and reusing a key
+// for a different request gets codes.FailedPrecondition. Failed calls are not stored,
+// so the client may retry them with the same key. A call that never finishes only
+// holds its key for idempotencyLease. An empty key disables the check.
+func idempotent[Resp proto.Message](ctx context.Context, trip *TripService, userID int, key, operation string, req proto.Message, exec func() (Resp, error)) (Resp, error) {
+	var zero Resp
+	if key == "" {
+		return exec()
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return zero, status.Error(codes.InvalidArgument, "idempotency key is too long")
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		logger.Error(ctx, "Failed to hash request for idempotency", "error", err)
+		return zero, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+
+	lockedUntil := time.Now().Add(idempotencyLease)
+	record, reserved, err := trip.DB.ReserveIdempotencyKey(ctx, models.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Operation:   operation,
+		RequestHash: hash,
+		LockedUntil: &lockedUntil,
+	}, time.Now().Add(-trip.IdempotencyRetention))
+	if err != nil {
+		logger.Error(ctx, "Failed to reserve idempotency key", "operation", operation, "error", err)
+		return zero, status.Error(codes.Internal, "failed to process idempotency key")
+	}
+
+	if !reserved {
+		if record.Operation != operation || record.RequestHash != hash {
+			return zero, grpcutil.ErrorWithReason(codes.FailedPrecondition, grpcutil.ReasonIdempotencyKeyReused, "idempotency key was already used for a different request")
+		}
+		if record.Response == nil {
+			return zero, grpcutil.ErrorWithReason(codes.Aborted, grpcutil.ReasonIdempotencyKeyInProgress, "a request with this idempotency key is still in progress")
+		}
+		resp := zero.ProtoReflect().New().Interface().(Resp)
+		if err := proto.Unmarshal(record.Response, resp); err != nil {
+			logger.Error(ctx, "Failed to decode stored idempotent response", "operation", operation, "error", err)
+			return zero, status.Error(codes.Internal, "failed to replay stored response")
+		}
+		logger.Info(ctx, "Replayed idempotent response", "operation", operation, "user_id", userID)
+		return resp, nil
+	}
+
+	resp, err := exec()
+	if err != nil {
+		if releaseErr := trip.DB.ReleaseIdempotencyKey(context.WithoutCancel(ctx), userID, key); releaseErr != nil {
+			logger.Error(ctx, "Failed to release idempotency key", "operation", operation, "error", releaseErr)
+		}
+		return zero, err
+	}
+
+	data, err := proto.Marshal(resp)
+	if err == nil {
+		err = trip.DB.CompleteIdempotencyKey(context.WithoutCancel(ctx), userID, key, data)
+	}
+	if err != nil {
+		// The request itself succeeded, but without its response a retry
+		// after the lease runs out executes it again.
+		logger.Error(ctx, "Failed to store idempotent response", "operation", operation, "error", err)
+	}
+	return resp, nil
+}
+
+// requestHash fingerprints a request with its idempotency key cleared, so a
+// key reused for a different payload can be detected.
+func requestHash(req proto.Message) (string, error) {
+	clone := proto.Clone(req)
+	m := clone.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName(idempotencyKeyField); fd != nil {
+		m.Clear(fd)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// purgeExpiredIdempotencyKeys periodically deletes stored responses older than
+// the retention window until ctx is cancelled.
+func (trip *TripService) purgeExpiredIdempotencyKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := trip.DB.DeleteExpiredIdempotencyKeys(ctx, time.Now().Add(-trip.IdempotencyRetention))
+			if err != nil {
+				logger.Error("Failed to purge expired idempotency keys", "error", err)
+				continue
+			}
+			if deleted > 0 {
+				logger.Info("Purged expired idempotency keys", "deleted", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/grpcutil"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cancelIdempotently runs a CancelTrip-shaped request under key, counting
+// how often exec runs, and returns exec's or the replayed response.
+func (e *testEnv) cancelIdempotently(key string, calls *int, fail error) (*pb.MessageResponse, error) {
+	req := &pb.CancelTripRequest{TripId: 1, UserId: passengerID, IdempotencyKey: key}
+	return idempotent(context.Background(), e.service, passengerID, key, "CancelTrip", req, func() (*pb.MessageResponse, error) {
+		*calls++
+		if fail != nil {
+			return nil, fail
+		}
+		return &pb.MessageResponse{Success: true, Message: "cancelled"}, nil
+	})
+}
+
+func TestIdempotentReplaysCompletedRequest(t *testing.T) {
+	env := newTestEnv(t)
+	env.service.IdempotencyRetention = time.Hour
+	calls := 0
+	for i := 0; i < 2; i++ {
+		resp, err := env.cancelIdempotently("k", &calls, nil)
+		if err != nil || resp.Message != "cancelled" {
+			t.Fatalf("call %d = %v, %v", i, resp, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("exec ran %d times, want once", calls)
+	}
+}
+
+func TestIdempotentKeyReuse(t *testing.T) {
+	env := newTestEnv(t)
+	env.service.IdempotencyRetention = time.Hour
+	calls := 0
+	if _, err := env.cancelIdempotently("k", &calls, nil); err != nil {
+		t.Fatal(err)
+	}
+	other := &pb.CancelTripRequest{TripId: 2, UserId: passengerID, IdempotencyKey: "k"}
+	_, err := idempotent(context.Background(), env.service, passengerID, "k", "CancelTrip", other, func() (*pb.MessageResponse, error) {
+		calls++
+		return &pb.MessageResponse{Success: true}, nil
+	})
+	// Business rules also fail with FailedPrecondition; the reason tells
+	// key reuse apart.
+	if status.Code(err) != codes.FailedPrecondition || grpcutil.Reason(err) != grpcutil.ReasonIdempotencyKeyReused {
+		t.Errorf("reused key error = %v, want FailedPrecondition with the reuse reason", err)
+	}
+	if calls != 1 {
+		t.Errorf("exec ran %d times, want once", calls)
+	}
+	if grpcutil.Reason(status.Error(codes.FailedPrecondition, "trip is not in requested status")) != "" {
+		t.Error("plain FailedPrecondition has an idempotency reason")
+	}
+}
+
+func TestIdempotentReleasesFailedRequest(t *testing.T) {
+	env := newTestEnv(t)
+	env.service.IdempotencyRetention = time.Hour
+	calls := 0
+	failure := errors.New("trip-service database is down")
+	if _, err := env.cancelIdempotently("k", &calls, failure); !errors.Is(err, failure) {
+		t.Fatalf("failed call error = %v, want %v", err, failure)
+	}
+	if resp, err := env.cancelIdempotently("k", &calls, nil); err != nil || !resp.Success {
+		t.Fatalf("retry = %v, %v, want it executed", resp, err)
+	}
+	if calls != 2 {
+		t.Errorf("exec ran %d times, want twice", calls)
+	}
+}
+
+func TestIdempotentTakesOverStaleReservation(t *testing.T) {
+	env := newTestEnv(t)
+	env.service.IdempotencyRetention = time.Hour
+	ctx := context.Background()
+	hash, err := requestHash(&pb.CancelTripRequest{TripId: 1, UserId: passengerID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reserve := func(key string, lockedUntil time.Time) {
+		t.Helper()
+		if _, reserved, err := env.repo.ReserveIdempotencyKey(ctx, models.IdempotencyRecord{
+			UserID:      passengerID,
+			Key:         key,
+			Operation:   "CancelTrip",
+			RequestHash: hash,
+			LockedUntil: &lockedUntil,
+		}, time.Now().Add(-time.Hour)); err != nil || !reserved {
+			t.Fatalf("reserve %s = %v, %v", key, reserved, err)
+		}
+	}
+
+	// A request still within its lease holds the key.
+	reserve("running", time.Now().Add(time.Minute))
+	calls := 0
+	_, err = env.cancelIdempotently("running", &calls, nil)
+	if status.Code(err) != codes.Aborted || grpcutil.Reason(err) != grpcutil.ReasonIdempotencyKeyInProgress {
+		t.Errorf("retry during lease error = %v, want Aborted with the in-progress reason", err)
+	}
+
+	// One that crashed before storing its response gives it up.
+	reserve("crashed", time.Now().Add(-time.Second))
+	if resp, err := env.cancelIdempotently("crashed", &calls, nil); err != nil || !resp.Success {
+		t.Fatalf("retry after lease = %v, %v, want it executed", resp, err)
+	}
+	if calls != 1 {
+		t.Errorf("exec ran %d times, want once", calls)
+	}
+}
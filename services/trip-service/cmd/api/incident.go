@@ -0,0 +1,449 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	maxIncidentNoteLength     = 1000
+	defaultIncidentListLimit  = 100
+	maxIncidentListLimit      = 500
+	defaultIncidentTrackLimit = 500
+	maxIncidentTrackLimit     = 2000
+	// incidentSnapshotTimeout bounds the location and vehicle lookups made
+	// when SOS is raised; the incident is recorded with whatever arrived.
+	incidentSnapshotTimeout = 3 * time.Second
+)
+
+var (
+	ErrTripNotActive    = errors.New("SOS is only available while the trip is accepted or started")
+	ErrIncidentNotFound = errors.New("incident not found")
+	ErrIncidentClosed   = errors.New("incident is already closed")
+	ErrInvalidIncident  = errors.New("invalid incident")
+)
+
+// incidentRecorder runs one position sampler per open incident in this
+// process. Every replica resumes the open incidents at startup; the track
+// table drops the duplicate samples.
+type incidentRecorder struct {
+	interval time.Duration
+
+	mu      sync.Mutex
+	running map[int64]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func newIncidentRecorder(interval time.Duration) *incidentRecorder {
+	return &incidentRecorder{interval: interval, running: make(map[int64]context.CancelFunc)}
+}
+
+// incidentTrackInterval reads INCIDENT_TRACK_INTERVAL, how often positions are
+// sampled while an incident is open.
+func incidentTrackInterval() time.Duration {
+	d, err := time.ParseDuration(env.Get("INCIDENT_TRACK_INTERVAL", "2s"))
+	if err != nil || d <= 0 {
+		return 2 * time.Second
+	}
+	return d
+}
+
+// start runs record for the incident unless it is already being recorded.
+func (r *incidentRecorder) start(incidentID int64, record func(ctx context.Context)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.running[incidentID]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.running[incidentID] = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		record(ctx)
+		r.mu.Lock()
+		delete(r.running, incidentID)
+		r.mu.Unlock()
+		cancel()
+	}()
+}
+
+func (r *incidentRecorder) stop(incidentID int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cancel, ok := r.running[incidentID]; ok {
+		cancel()
+	}
+}
+
+// stopAll stops every sampler and waits for them to return.
+func (r *incidentRecorder) stopAll() {
+	r.mu.Lock()
+	for _, cancel := range r.running {
+		cancel()
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+}
+
+func (r *incidentRecorder) isRecording(incidentID int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.running[incidentID]
+	return ok
+}
+
+// RaiseSOS opens a safety incident for an active trip on behalf of one of its
+// participants. It snapshots the trip, both parties' last known locations and
+// the driver's vehicle, queues a high-priority event for the safety team and
+// starts recording positions until an operator closes the incident. Pressing
+// SOS again while the incident is open returns it unchanged; created reports
+// which case it was.
+func (trip *TripService) RaiseSOS(ctx context.Context, tripID int, userID int, note string) (incident models.Incident, created bool, err error) {
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.RaiseSOS",
+		trace.WithAttributes(
+			attribute.Int("trip_id", tripID),
+			attribute.Int("user_id", userID),
+		),
+	)
+	defer span.End()
+
+	note = strings.TrimSpace(note)
+	if len(note) > maxIncidentNoteLength {
+		return models.Incident{}, false, fmt.Errorf("%w: note is longer than %d bytes", ErrInvalidIncident, maxIncidentNoteLength)
+	}
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return models.Incident{}, false, err
+	}
+	_, role, err := tripCounterpart(record, userID)
+	if err != nil {
+		return models.Incident{}, false, err
+	}
+	if !tripInProgress(record) {
+		return models.Incident{}, false, ErrTripNotActive
+	}
+
+	if open, err := trip.DB.GetOpenIncident(ctx, tripID); err == nil {
+		trip.recordIncident(open)
+		span.SetAttributes(attribute.Int64("incident_id", open.ID), attribute.Bool("incident.created", false))
+		return open, false, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		logger.Error(ctx, "Failed to look up open incident", "trip_id", tripID, "error", err)
+		return models.Incident{}, false, err
+	}
+
+	incident = models.Incident{
+		TripID:       tripID,
+		RaisedBy:     userID,
+		RaisedByRole: role,
+		Note:         note,
+		Snapshot:     trip.incidentSnapshot(ctx, record),
+	}
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		var err error
+		incident, err = tx.CreateIncident(ctx, incident)
+		if err != nil {
+			return err
+		}
+		return enqueueSafetyEvent(ctx, tx, events.UserActor(userID), events.IncidentSubject(incident.ID), sosRaisedEvent(incident), models.PriorityHigh)
+	})
+	if err != nil {
+		// Both parties may press SOS at once; the loser joins the winner's incident.
+		if open, openErr := trip.DB.GetOpenIncident(ctx, tripID); openErr == nil {
+			trip.recordIncident(open)
+			return open, false, nil
+		}
+		logger.Error(ctx, "Failed to create incident", "trip_id", tripID, "error", err)
+		span.RecordError(err)
+		return models.Incident{}, false, err
+	}
+
+	logger.Warn(ctx, "SOS raised", "incident_id", incident.ID, "trip_id", tripID, "user_id", userID, "role", role)
+	span.SetAttributes(attribute.Int64("incident_id", incident.ID), attribute.Bool("incident.created", true))
+	trip.recordIncident(incident)
+	return incident, true, nil
+}
+
+// incidentSnapshot gathers what is known about the trip right now. Lookups
+// that fail or time out are logged and left out rather than failing the SOS.
+func (trip *TripService) incidentSnapshot(ctx context.Context, record models.Trip) models.IncidentSnapshot {
+	ctx, cancel := context.WithTimeout(ctx, incidentSnapshotTimeout)
+	defer cancel()
+
+	snapshot := models.IncidentSnapshot{
+		Trip:      record,
+		Locations: []models.PartyLocation{{UserID: record.PassengerID, Role: "passenger"}},
+	}
+	driverID := int(record.DriverID.Int32)
+	if record.DriverID.Valid {
+		snapshot.Locations = append(snapshot.Locations, models.PartyLocation{UserID: driverID, Role: "driver"})
+	}
+
+	var wg sync.WaitGroup
+	for i := range snapshot.Locations {
+		wg.Add(1)
+		go func(party *models.PartyLocation) {
+			defer wg.Done()
+			resp, err := trip.grpcClients.GetLocationViaGRPC(ctx, party.UserID)
+			if err != nil || resp.Location == nil {
+				logger.Warn(ctx, "No location for incident snapshot", "trip_id", record.ID, "user_id", party.UserID, "error", err)
+				return
+			}
+			party.Known = true
+			party.Latitude = resp.Location.Latitude
+			party.Longitude = resp.Location.Longitude
+			party.Speed = resp.Location.Speed
+			party.Heading = resp.Location.Heading
+			party.ReportedAt = resp.Location.Timestamp
+		}(&snapshot.Locations[i])
+	}
+	if record.DriverID.Valid {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vehicle, err := trip.driverVehicle(ctx, driverID)
+			if err != nil || vehicle == nil {
+				logger.Warn(ctx, "No vehicle for incident snapshot", "trip_id", record.ID, "driver_id", driverID, "error", err)
+				return
+			}
+			snapshot.Vehicle = &models.IncidentVehicle{
+				VehicleID:    int(vehicle.VehicleId),
+				LicensePlate: vehicle.LicensePlate,
+				VehicleType:  vehicle.VehicleType,
+				Seats:        int(vehicle.Seats),
+			}
+		}()
+	}
+	wg.Wait()
+	return snapshot
+}
+
+func sosRaisedEvent(incident models.Incident) events.SOSRaised {
+	snapshot := incident.Snapshot
+	event := events.SOSRaised{
+		IncidentID:  incident.ID,
+		TripID:      incident.TripID,
+		RaisedBy:    incident.RaisedBy,
+		Role:        incident.RaisedByRole,
+		Note:        incident.Note,
+		PassengerID: snapshot.Trip.PassengerID,
+		DriverID:    int(snapshot.Trip.DriverID.Int32),
+		TripStatus:  string(snapshot.Trip.Status),
+		Positions:   []events.PartyPosition{},
+	}
+	if snapshot.Vehicle != nil {
+		event.LicensePlate = snapshot.Vehicle.LicensePlate
+	}
+	for _, party := range snapshot.Locations {
+		if party.Known {
+			event.Positions = append(event.Positions, events.PartyPosition{
+				UserID:     party.UserID,
+				Role:       party.Role,
+				Latitude:   party.Latitude,
+				Longitude:  party.Longitude,
+				ReportedAt: party.ReportedAt,
+			})
+		}
+	}
+	return event
+}
+
+// recordIncident starts sampling the incident's parties in the background.
+func (trip *TripService) recordIncident(incident models.Incident) {
+	trip.Incidents.start(incident.ID, func(ctx context.Context) {
+		trip.trackIncident(ctx, incident)
+	})
+}
+
+// trackIncident stores the parties' positions every interval until ctx is
+// cancelled or the incident is closed, possibly by another replica.
+func (trip *TripService) trackIncident(ctx context.Context, incident models.Incident) {
+	parties := []int{incident.Snapshot.Trip.PassengerID}
+	if incident.Snapshot.Trip.DriverID.Valid {
+		parties = append(parties, int(incident.Snapshot.Trip.DriverID.Int32))
+	}
+	ticker := time.NewTicker(trip.Incidents.interval)
+	defer ticker.Stop()
+	for {
+		current, err := trip.DB.GetIncident(ctx, incident.ID)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			logger.Warn("Failed to check incident status", "incident_id", incident.ID, "error", err)
+		case current.Status != models.IncidentOpen:
+			logger.Info("Stopped recording closed incident", "incident_id", incident.ID)
+			return
+		default:
+			trip.sampleIncident(ctx, incident.ID, parties)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (trip *TripService) sampleIncident(ctx context.Context, incidentID int64, parties []int) {
+	now := time.Now()
+	points := make([]models.TrackPoint, 0, len(parties))
+	for _, userID := range parties {
+		resp, err := trip.grpcClients.GetLocationViaGRPC(ctx, userID)
+		if err != nil || resp.Location == nil {
+			continue
+		}
+		reportedAt := resp.Location.Timestamp
+		if reportedAt == "" {
+			reportedAt = now.UTC().Format(time.RFC3339Nano)
+		}
+		points = append(points, models.TrackPoint{
+			IncidentID: incidentID,
+			UserID:     userID,
+			Latitude:   resp.Location.Latitude,
+			Longitude:  resp.Location.Longitude,
+			Speed:      resp.Location.Speed,
+			Heading:    resp.Location.Heading,
+			ReportedAt: reportedAt,
+			RecordedAt: now,
+		})
+	}
+	if len(points) == 0 {
+		return
+	}
+	if _, err := trip.DB.AddTrackPoints(ctx, points); err != nil && ctx.Err() == nil {
+		logger.Warn("Failed to record incident track", "incident_id", incidentID, "error", err)
+	}
+}
+
+// ResumeIncidentRecording restarts the samplers for incidents that were open
+// when the service last stopped.
+func (trip *TripService) ResumeIncidentRecording(ctx context.Context) error {
+	open, err := trip.DB.ListIncidents(ctx, models.IncidentOpen, 0, maxIncidentListLimit)
+	if err != nil {
+		return err
+	}
+	for _, incident := range open {
+		trip.recordIncident(incident)
+	}
+	if len(open) > 0 {
+		logger.Info("Resumed recording open incidents", "count", len(open))
+	}
+	return nil
+}
+
+func (trip *TripService) GetIncident(ctx context.Context, incidentID int64) (models.Incident, error) {
+	incident, err := trip.DB.GetIncident(ctx, incidentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Incident{}, ErrIncidentNotFound
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to get incident", "incident_id", incidentID, "error", err)
+		return models.Incident{}, err
+	}
+	return incident, nil
+}
+
+// ListIncidents returns incidents newest first, optionally only those with
+// status or of one trip.
+func (trip *TripService) ListIncidents(ctx context.Context, status models.IncidentStatus, tripID int, limit int) ([]models.Incident, error) {
+	if status != "" && status != models.IncidentOpen && status != models.IncidentClosed {
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidIncident, status)
+	}
+	if limit <= 0 {
+		limit = defaultIncidentListLimit
+	}
+	if limit > maxIncidentListLimit {
+		limit = maxIncidentListLimit
+	}
+	incidents, err := trip.DB.ListIncidents(ctx, status, tripID, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to list incidents", "error", err)
+		return nil, err
+	}
+	return incidents, nil
+}
+
+// GetIncidentTrack returns the positions recorded for an incident after
+// afterID, oldest first.
+func (trip *TripService) GetIncidentTrack(ctx context.Context, incidentID int64, afterID int64, limit int) ([]models.TrackPoint, error) {
+	if _, err := trip.GetIncident(ctx, incidentID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultIncidentTrackLimit
+	}
+	if limit > maxIncidentTrackLimit {
+		limit = maxIncidentTrackLimit
+	}
+	points, err := trip.DB.GetTrackPoints(ctx, incidentID, afterID, limit)
+	if err != nil {
+		logger.Error(ctx, "Failed to get incident track", "incident_id", incidentID, "error", err)
+		return nil, err
+	}
+	return points, nil
+}
+
+// CloseIncident is called by a safety operator once the situation is
+// resolved. It stops the position recording.
+func (trip *TripService) CloseIncident(ctx context.Context, incidentID int64, operatorID int, resolution string) (models.Incident, error) {
+	resolution = strings.TrimSpace(resolution)
+	if resolution == "" {
+		return models.Incident{}, fmt.Errorf("%w: resolution is required", ErrInvalidIncident)
+	}
+	if len(resolution) > maxIncidentNoteLength {
+		return models.Incident{}, fmt.Errorf("%w: resolution is longer than %d bytes", ErrInvalidIncident, maxIncidentNoteLength)
+	}
+	incident, err := trip.GetIncident(ctx, incidentID)
+	if err != nil {
+		return models.Incident{}, err
+	}
+
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		closed, err := tx.CloseIncident(ctx, incidentID, operatorID, resolution, time.Now())
+		if err != nil {
+			return err
+		}
+		if !closed {
+			return ErrIncidentClosed
+		}
+		return enqueueSafetyEvent(ctx, tx, events.UserActor(operatorID), events.IncidentSubject(incidentID), events.IncidentClosed{
+			IncidentID: incidentID,
+			TripID:     incident.TripID,
+			ClosedBy:   operatorID,
+			Resolution: resolution,
+		}, models.PriorityNormal)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrIncidentClosed) {
+			logger.Error(ctx, "Failed to close incident", "incident_id", incidentID, "error", err)
+		}
+		return models.Incident{}, err
+	}
+	trip.Incidents.stop(incidentID)
+	return trip.GetIncident(ctx, incidentID)
+}
+
+// enqueueSafetyEvent records an event for the safety queue through repo.
+func enqueueSafetyEvent(ctx context.Context, repo repository.DatabaseRepo, actor events.Actor, subject events.Subject, payload events.Payload, priority uint8) error {
+	event, err := newSafetyEvent(ctx, actor, subject, payload, priority)
+	if err != nil {
+		return err
+	}
+	return repo.EnqueueEvent(ctx, event)
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/rabbitmq"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+)
+
+const sosDriverID = 7
+
+// waitFor polls cond until it holds or a second has passed.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// safetyEvents returns the pending outbox events bound for the safety queue.
+func (e *testEnv) safetyEvents(t *testing.T) []models.OutboxEvent {
+	t.Helper()
+	pending, err := e.repo.FetchPendingEvents(context.Background(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var safety []models.OutboxEvent
+	for _, event := range pending {
+		if event.Destination == rabbitmq.SafetyQueueAddress {
+			safety = append(safety, event)
+		}
+	}
+	return safety
+}
+
+func TestRaiseSOSRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  models.TripStatus
+		driver  int
+		user    int
+		note    string
+		wantErr error
+	}{
+		{name: "stranger", status: models.StatusStarted, driver: sosDriverID, user: strangerID, wantErr: ErrNotTripParticipant},
+		{name: "requested trip", status: models.StatusRequested, user: passengerID, wantErr: ErrTripNotActive},
+		{name: "completed trip", status: models.StatusCompleted, driver: sosDriverID, user: passengerID, wantErr: ErrTripNotActive},
+		{name: "note too long", status: models.StatusStarted, driver: sosDriverID, user: passengerID, note: strings.Repeat("a", maxIncidentNoteLength+1), wantErr: ErrInvalidIncident},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			trip := env.createTrip(t)
+			env.setTrip(t, trip.ID, tt.status, tt.driver)
+
+			_, _, err := env.service.RaiseSOS(context.Background(), trip.ID, tt.user, tt.note)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RaiseSOS error = %v, want %v", err, tt.wantErr)
+			}
+			if events := env.safetyEvents(t); len(events) != 0 {
+				t.Errorf("queued %d safety events for a rejected SOS", len(events))
+			}
+		})
+	}
+}
+
+func TestRaiseSOS(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusStarted, sosDriverID)
+	env.location.setPosition(&locationpb.Location{UserId: passengerID, Latitude: 10.77, Longitude: 106.69, Timestamp: "2025-01-01T10:00:00Z"})
+	env.users.vehicles = map[int32][]*userpb.Vehicle{
+		sosDriverID: {
+			{VehicleId: 1, DriverId: sosDriverID, LicensePlate: "51A-000.01"},
+			{VehicleId: 2, DriverId: sosDriverID, LicensePlate: "51A-123.45", VehicleType: "car", Seats: 4, VerifiedAt: "2024-12-01T00:00:00Z"},
+		},
+	}
+
+	incident, created, err := env.service.RaiseSOS(ctx, trip.ID, passengerID, "  driver is not following the route ")
+	if err != nil {
+		t.Fatalf("RaiseSOS: %v", err)
+	}
+	if !created || incident.Status != models.IncidentOpen || incident.RaisedByRole != "passenger" || incident.Note != "driver is not following the route" {
+		t.Fatalf("incident = %+v, created %v", incident, created)
+	}
+	snapshot := incident.Snapshot
+	if snapshot.Trip.ID != trip.ID || snapshot.Trip.Status != models.StatusStarted {
+		t.Errorf("snapshot trip = %+v", snapshot.Trip)
+	}
+	if len(snapshot.Locations) != 2 {
+		t.Fatalf("snapshot locations = %+v, want passenger and driver", snapshot.Locations)
+	}
+	// The driver's location is unknown; SOS goes through anyway.
+	passenger, driver := snapshot.Locations[0], snapshot.Locations[1]
+	if !passenger.Known || passenger.Latitude != 10.77 || passenger.ReportedAt != "2025-01-01T10:00:00Z" {
+		t.Errorf("passenger location = %+v", passenger)
+	}
+	if driver.Known || driver.UserID != sosDriverID {
+		t.Errorf("driver location = %+v, want unknown", driver)
+	}
+	if snapshot.Vehicle == nil || snapshot.Vehicle.LicensePlate != "51A-123.45" {
+		t.Errorf("vehicle = %+v, want the verified one", snapshot.Vehicle)
+	}
+
+	safety := env.safetyEvents(t)
+	if len(safety) != 1 || safety[0].Priority != models.PriorityHigh || safety[0].EventName != events.TypeSOSRaised {
+		t.Fatalf("safety events = %+v, want one high-priority SOS", safety)
+	}
+	// The SOS overtakes the trip events queued before it.
+	if first, err := env.repo.FetchPendingEvents(ctx, 1); err != nil || first[0].ID != safety[0].ID {
+		t.Errorf("first pending event = %+v, %v, want the SOS", first, err)
+	}
+	envelope, err := events.Decode(safety[0].Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload events.SOSRaised
+	if err := envelope.DecodePayload(&payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.IncidentID != incident.ID || payload.DriverID != sosDriverID || payload.LicensePlate != "51A-123.45" || len(payload.Positions) != 1 {
+		t.Errorf("SOS payload = %+v", payload)
+	}
+
+	// The driver pressing SOS too joins the open incident.
+	again, created, err := env.service.RaiseSOS(ctx, trip.ID, sosDriverID, "")
+	if err != nil || created || again.ID != incident.ID {
+		t.Fatalf("second RaiseSOS = %d, created %v, %v, want incident %d", again.ID, created, err, incident.ID)
+	}
+	if safety := env.safetyEvents(t); len(safety) != 1 {
+		t.Errorf("queued %d safety events, want 1", len(safety))
+	}
+}
+
+func TestIncidentRecording(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusStarted, sosDriverID)
+	env.location.setPosition(&locationpb.Location{UserId: passengerID, Latitude: 10.770, Longitude: 106.690, Timestamp: "2025-01-01T10:00:00Z"})
+	env.location.setPosition(&locationpb.Location{UserId: sosDriverID, Latitude: 10.771, Longitude: 106.691, Timestamp: "2025-01-01T10:00:00Z"})
+
+	incident, _, err := env.service.RaiseSOS(ctx, trip.ID, sosDriverID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	trackLen := func() int {
+		points, err := env.service.GetIncidentTrack(ctx, incident.ID, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(points)
+	}
+	waitFor(t, "both parties to be recorded", func() bool { return trackLen() == 2 })
+
+	// The recording keeps going after the trip ends, until an operator closes it.
+	if err := env.service.UpdateTripStatus(ctx, models.StatusCompleted, trip.ID, sosDriverID); err != nil {
+		t.Fatal(err)
+	}
+	env.location.setPosition(&locationpb.Location{UserId: passengerID, Latitude: 10.780, Longitude: 106.700, Timestamp: "2025-01-01T10:00:02Z"})
+	waitFor(t, "the new position to be recorded", func() bool { return trackLen() == 3 })
+	// Samples of an unchanged report aren't stored again.
+	time.Sleep(50 * time.Millisecond)
+	if n := trackLen(); n != 3 {
+		t.Fatalf("track has %d points, want 3", n)
+	}
+
+	if _, err := env.service.CloseIncident(ctx, incident.ID, 1, "  "); !errors.Is(err, ErrInvalidIncident) {
+		t.Errorf("CloseIncident without resolution error = %v, want %v", err, ErrInvalidIncident)
+	}
+	closed, err := env.service.CloseIncident(ctx, incident.ID, 1, "Passenger reached home safely")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if closed.Status != models.IncidentClosed || closed.ClosedBy.Int32 != 1 || !closed.ClosedAt.Valid {
+		t.Errorf("closed incident = %+v", closed)
+	}
+	waitFor(t, "the recording to stop", func() bool { return !env.service.Incidents.isRecording(incident.ID) })
+	env.location.setPosition(&locationpb.Location{UserId: passengerID, Latitude: 10.790, Longitude: 106.710, Timestamp: "2025-01-01T10:00:04Z"})
+	time.Sleep(50 * time.Millisecond)
+	if n := trackLen(); n != 3 {
+		t.Errorf("track has %d points after closing, want 3", n)
+	}
+
+	if _, err := env.service.CloseIncident(ctx, incident.ID, 1, "again"); !errors.Is(err, ErrIncidentClosed) {
+		t.Errorf("second CloseIncident error = %v, want %v", err, ErrIncidentClosed)
+	}
+	if _, err := env.service.CloseIncident(ctx, incident.ID+1, 1, "nope"); !errors.Is(err, ErrIncidentNotFound) {
+		t.Errorf("CloseIncident of unknown incident error = %v, want %v", err, ErrIncidentNotFound)
+	}
+	safety := env.safetyEvents(t)
+	if len(safety) != 2 || safety[1].EventName != events.TypeIncidentClosed || safety[1].Priority != models.PriorityNormal {
+		t.Errorf("safety events = %+v, want SOS then incident closed", safety)
+	}
+}
+
+func TestResumeIncidentRecording(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusStarted, sosDriverID)
+	incident, err := env.repo.CreateIncident(ctx, models.Incident{TripID: trip.ID, RaisedBy: passengerID, RaisedByRole: "passenger"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := env.service.ResumeIncidentRecording(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !env.service.Incidents.isRecording(incident.ID) {
+		t.Fatal("open incident is not being recorded after resume")
+	}
+
+	open, err := env.service.ListIncidents(ctx, models.IncidentOpen, 0, 0)
+	if err != nil || len(open) != 1 || open[0].ID != incident.ID {
+		t.Errorf("open incidents = %+v, %v", open, err)
+	}
+	if _, err := env.service.ListIncidents(ctx, "PENDING", 0, 0); !errors.Is(err, ErrInvalidIncident) {
+		t.Errorf("ListIncidents with unknown status error = %v, want %v", err, ErrInvalidIncident)
+	}
+}
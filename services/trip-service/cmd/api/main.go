@@ -58,14 +58,40 @@ func main() {
 	logger.InitDefault("trip-service")
 	logger.Info("Starting trip service")
 	service := TripService{}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := service.runMigrateCommand(os.Args[2:]); err != nil {
+			logger.Fatal("Migration failed", "error", err)
+		}
+		return
+	}
 	service.InitializeServices()
 	app.TripService = &service
 	defer app.TripService.DB.Connection().Close()
+
+	// Relay outbox events to RabbitMQ; stopped before the DB is closed.
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		NewOutboxRelay(app.TripService.DB).Run(relayCtx)
+		close(relayDone)
+	}()
 	defer func() {
-		if err := app.TripService.RabbitConn.Close(); err != nil {
-			logger.Error("Error closing RabbitMQ connection", "error", err)
-		}
+		stopRelay()
+		<-relayDone
 	}()
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go app.TripService.purgeExpiredIdempotencyKeys(purgeCtx, time.Hour)
+	go app.TripService.creditPendingTips(purgeCtx, time.Minute)
+	go app.TripService.expireOffers(purgeCtx, offerExpiryInterval)
+
+	// Keep recording incidents that were open when the service last stopped.
+	if err := app.TripService.ResumeIncidentRecording(context.Background()); err != nil {
+		logger.Error("Failed to resume incident recording", "error", err)
+	}
+	defer app.TripService.Incidents.stopAll()
+
 	go func() {
 		err := app.StartGRPCServer()
 		if err != nil {
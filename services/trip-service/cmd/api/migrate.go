@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"time"
+	"trip-service/internal/migrations"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/migrate"
+)
+
+const migrateTimeout = 5 * time.Minute
+
+// runMigrateCommand handles "trip-service migrate [up|down [n]|status|version]".
+func (trip *TripService) runMigrateCommand(args []string) error {
+	conn, err := trip.connectToDB()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	migrator, err := migrate.New(conn, migrations.FS, "trip-service")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	return migrate.Command(ctx, migrator, args, os.Stdout)
+}
+
+// migrateOnStartup applies pending migrations unless DB_AUTO_MIGRATE=false,
+// and refuses to continue if the schema doesn't match the embedded migrations.
+func migrateOnStartup(conn *sql.DB) error {
+	migrator, err := migrate.New(conn, migrations.FS, "trip-service")
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+	defer cancel()
+	return migrate.OnStartup(ctx, migrator, env.Get("DB_AUTO_MIGRATE", "true") == "true")
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultOfferTimeout     = 30 * time.Second
+	defaultOfferRateWindow  = 30 * 24 * time.Hour
+	defaultOfferReportLimit = 50
+	maxOfferReportLimit     = 500
+	// offerExpiryInterval is how often unanswered offers are timed out.
+	offerExpiryInterval = time.Second
+)
+
+// offerPolicy is how long drivers have to answer an offer and how far back
+// their acceptance and cancellation rates look.
+type offerPolicy struct {
+	Timeout    time.Duration
+	RateWindow time.Duration
+}
+
+// loadOfferPolicy reads OFFER_TIMEOUT and OFFER_RATE_WINDOW, keeping the
+// defaults when they are unset or invalid.
+func loadOfferPolicy() offerPolicy {
+	policy := offerPolicy{Timeout: defaultOfferTimeout, RateWindow: defaultOfferRateWindow}
+	if d, err := time.ParseDuration(env.Get("OFFER_TIMEOUT", "")); err == nil && d > 0 {
+		policy.Timeout = d
+	}
+	if d, err := time.ParseDuration(env.Get("OFFER_RATE_WINDOW", "")); err == nil && d > 0 {
+		policy.RateWindow = d
+	}
+	return policy
+}
+
+// offeredAt holds when the driver at the head of each trip's queue in tripMap
+// was offered the trip.
+var offeredAt = make(map[int]time.Time)
+
+// queueMu guards tripMap and offeredAt, which every request handler and the
+// offer expirer share. It is never held across a gRPC or database call.
+var queueMu sync.Mutex
+
+// offerNext offers the trip to the driver now at the head of its queue.
+// queueMu must be held.
+func offerNext(tripID int) {
+	if len(tripMap[tripID]) == 0 {
+		delete(offeredAt, tripID)
+		return
+	}
+	offeredAt[tripID] = time.Now()
+}
+
+// enqueueDrivers appends drivers to the trip's queue, offers the trip to its
+// head and returns the queue's length.
+func enqueueDrivers(tripID int, drivers []int) int {
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	tripMap[tripID] = append(tripMap[tripID], drivers...)
+	offerNext(tripID)
+	return len(tripMap[tripID])
+}
+
+// queueHead returns the driver the trip is offered to and since when, or
+// false when its queue is empty.
+func queueHead(tripID int) (driverID int, offered time.Time, ok bool) {
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	if len(tripMap[tripID]) == 0 {
+		return 0, time.Time{}, false
+	}
+	return tripMap[tripID][0], offeredAt[tripID], true
+}
+
+// popHead takes driverID off the head of the trip's queue and offers the trip
+// to the next driver. It returns when driverID was offered the trip, or false
+// when driverID isn't at the head.
+func popHead(tripID int, driverID int) (offered time.Time, remaining int, ok bool) {
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	queue := tripMap[tripID]
+	if len(queue) == 0 || queue[0] != driverID {
+		return time.Time{}, len(queue), false
+	}
+	offered = offeredAt[tripID]
+	tripMap[tripID] = queue[1:]
+	offerNext(tripID)
+	return offered, len(tripMap[tripID]), true
+}
+
+// restoreHead puts driverID back at the head of the trip's queue with its
+// original offer time, undoing a popHead whose answer couldn't be saved.
+func restoreHead(tripID int, driverID int, offered time.Time) {
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	tripMap[tripID] = append([]int{driverID}, tripMap[tripID]...)
+	offeredAt[tripID] = offered
+}
+
+// dropQueue forgets the trip's driver queue once the trip no longer needs a
+// driver.
+func dropQueue(tripID int) {
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	delete(tripMap, tripID)
+	delete(offeredAt, tripID)
+}
+
+// expiredOffer is a head offer taken off its queue because it timed out.
+type expiredOffer struct {
+	tripID   int
+	driverID int
+	offered  time.Time
+}
+
+// popExpired takes the timed out head offer off each of tripIDs' queues, or
+// off every queue when tripIDs is empty. Only the head can time out: the next
+// driver's time starts now, not when the previous offer expired.
+func (trip *TripService) popExpired(tripIDs ...int) []expiredOffer {
+	if trip.Offers.Timeout <= 0 {
+		return nil
+	}
+	queueMu.Lock()
+	defer queueMu.Unlock()
+	if len(tripIDs) == 0 {
+		for tripID := range offeredAt {
+			tripIDs = append(tripIDs, tripID)
+		}
+	}
+	var expired []expiredOffer
+	for _, tripID := range tripIDs {
+		queue := tripMap[tripID]
+		offered, ok := offeredAt[tripID]
+		if len(queue) == 0 || !ok || time.Since(offered) < trip.Offers.Timeout {
+			continue
+		}
+		expired = append(expired, expiredOffer{tripID: tripID, driverID: queue[0], offered: offered})
+		tripMap[tripID] = queue[1:]
+		offerNext(tripID)
+	}
+	return expired
+}
+
+// recordExpired records the offers as timed out and updates the drivers'
+// stats.
+func (trip *TripService) recordExpired(ctx context.Context, expired []expiredOffer) {
+	for _, e := range expired {
+		logger.Info("Offer timed out", "trip_id", e.tripID, "driver_id", e.driverID)
+		if err := trip.recordOffer(ctx, trip.DB, e.tripID, e.driverID, models.OfferTimedOut, e.offered); err != nil {
+			logger.Error("Failed to record timed out offer", "trip_id", e.tripID, "driver_id", e.driverID, "error", err)
+			continue
+		}
+		trip.syncOfferStats(ctx, e.driverID)
+	}
+}
+
+// expireOffer moves the trip on to the next driver when the one at the head of
+// its queue let the offer time out.
+func (trip *TripService) expireOffer(ctx context.Context, tripID int) {
+	trip.recordExpired(ctx, trip.popExpired(tripID))
+}
+
+// expireOffers periodically times out every head offer nobody answered in
+// time, so trips move on and timeouts are counted even when no one asks for
+// the trip, until ctx is cancelled.
+func (trip *TripService) expireOffers(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			trip.recordExpired(ctx, trip.popExpired())
+		}
+	}
+}
+
+// recordOffer records the driver's answer to an offer through repo, which
+// should be the transaction holding the change the answer caused, if any.
+func (trip *TripService) recordOffer(ctx context.Context, repo repository.DatabaseRepo, tripID int, driverID int, outcome models.OfferOutcome, offered time.Time) error {
+	if offered.IsZero() {
+		offered = time.Now()
+	}
+	_, err := repo.RecordDriverOffer(ctx, models.DriverOffer{
+		TripID:    tripID,
+		DriverID:  driverID,
+		Outcome:   outcome,
+		OfferedAt: offered,
+		DecidedAt: time.Now(),
+	})
+	return err
+}
+
+// driverOfferStats returns the offer stats of driverIDs over the rate window.
+func (trip *TripService) driverOfferStats(ctx context.Context, driverIDs []int) (map[int]models.DriverOfferStats, error) {
+	now := time.Now()
+	return trip.DB.GetDriverOfferStats(ctx, repository.DriverOfferFilter{
+		From:      now.Add(-trip.Offers.RateWindow),
+		To:        now,
+		DriverIDs: driverIDs,
+	})
+}
+
+// syncOfferStats pushes the driver's current offer stats to their profile in
+// user-service. user-service ignores snapshots older than the one it has, so
+// a failed push is only logged; the next decided offer brings it up to date.
+func (trip *TripService) syncOfferStats(ctx context.Context, driverID int) {
+	stats, err := trip.driverOfferStats(ctx, []int{driverID})
+	if err != nil {
+		logger.Warn("Failed to compute driver offer stats", "driver_id", driverID, "error", err)
+		return
+	}
+	s := stats[driverID]
+	s.DriverID = driverID
+	windowDays := int(trip.Offers.RateWindow.Hours() / 24)
+	if _, err := trip.grpcClients.RecordDriverOfferStatsViaGRPC(ctx, s, max(windowDays, 1), time.Now()); err != nil {
+		logger.Warn("Failed to push driver offer stats", "driver_id", driverID, "error", err)
+	}
+}
+
+// DriverOfferReportFilter selects the offers decided in [From, To), optionally
+// only DriverID's. Drivers with fewer than MinOffers offers are left out of
+// the list but still count towards the summary.
+type DriverOfferReportFilter struct {
+	From      time.Time
+	To        time.Time
+	DriverID  int
+	MinOffers int
+	Limit     int
+}
+
+func (trip *TripService) normalizeOfferReportFilter(filter DriverOfferReportFilter, now time.Time) (DriverOfferReportFilter, error) {
+	if filter.To.IsZero() {
+		filter.To = now
+	}
+	if filter.From.IsZero() {
+		filter.From = filter.To.Add(-trip.Offers.RateWindow)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultOfferReportLimit
+	}
+	if filter.Limit > maxOfferReportLimit {
+		filter.Limit = maxOfferReportLimit
+	}
+
+	if !filter.From.Before(filter.To) {
+		return filter, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+	if filter.To.Sub(filter.From) > maxReportRange {
+		return filter, fmt.Errorf("%w: range is longer than %d days", ErrInvalidFilter, int(maxReportRange.Hours()/24))
+	}
+	if filter.DriverID < 0 || filter.MinOffers < 0 {
+		return filter, fmt.Errorf("%w: driver_id and min_offers can't be negative", ErrInvalidFilter)
+	}
+	return filter, nil
+}
+
+// GetDriverOfferReport lists the drivers' offer outcomes for operations, the
+// lowest acceptance rate first.
+func (trip *TripService) GetDriverOfferReport(ctx context.Context, filter DriverOfferReportFilter) (models.DriverOfferReport, error) {
+	filter, err := trip.normalizeOfferReportFilter(filter, time.Now())
+	if err != nil {
+		return models.DriverOfferReport{}, err
+	}
+
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.GetDriverOfferReport",
+		trace.WithAttributes(
+			attribute.String("report.from", filter.From.Format(time.RFC3339)),
+			attribute.String("report.to", filter.To.Format(time.RFC3339)),
+			attribute.Int("report.driver_id", filter.DriverID),
+			attribute.Int("report.min_offers", filter.MinOffers),
+		),
+	)
+	defer span.End()
+
+	repoFilter := repository.DriverOfferFilter{From: filter.From, To: filter.To}
+	if filter.DriverID > 0 {
+		repoFilter.DriverIDs = []int{filter.DriverID}
+	}
+	stats, err := trip.DB.GetDriverOfferStats(ctx, repoFilter)
+	if err != nil {
+		logger.Error(ctx, "Failed to build driver offer report", "error", err)
+		span.RecordError(err)
+		return models.DriverOfferReport{}, err
+	}
+
+	report := models.DriverOfferReport{From: filter.From, To: filter.To, Drivers: []models.DriverOfferStats{}}
+	for _, s := range stats {
+		report.Summary.Accepted += s.Accepted
+		report.Summary.Rejected += s.Rejected
+		report.Summary.TimedOut += s.TimedOut
+		report.Summary.Cancelled += s.Cancelled
+		if s.Offers >= int64(filter.MinOffers) {
+			report.Drivers = append(report.Drivers, s)
+		}
+	}
+	report.Summary.SetRates()
+	sort.Slice(report.Drivers, func(i, j int) bool {
+		a, b := report.Drivers[i], report.Drivers[j]
+		if a.AcceptanceRate != b.AcceptanceRate {
+			return a.AcceptanceRate < b.AcceptanceRate
+		}
+		if a.Offers != b.Offers {
+			return a.Offers > b.Offers
+		}
+		return a.DriverID < b.DriverID
+	})
+	if len(report.Drivers) > filter.Limit {
+		report.Drivers = report.Drivers[:filter.Limit]
+	}
+	span.SetAttributes(attribute.Int64("report.offers", report.Summary.Offers))
+	return report, nil
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+)
+
+// offerStats returns the driver's offer stats over the rate window.
+func (e *testEnv) offerStats(t *testing.T, driverID int) models.DriverOfferStats {
+	t.Helper()
+	stats, err := e.service.driverOfferStats(context.Background(), []int{driverID})
+	if err != nil {
+		t.Fatalf("driverOfferStats: %v", err)
+	}
+	return stats[driverID]
+}
+
+func TestOfferOutcomes(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+
+	if err := env.service.RejectTrip(ctx, 1, 0, trip.ID); err != nil {
+		t.Fatalf("RejectTrip: %v", err)
+	}
+	if err := env.service.AcceptTrip(ctx, 2, trip.ID); err != nil {
+		t.Fatalf("AcceptTrip: %v", err)
+	}
+	if err := env.service.CancelTrip(ctx, 2, trip.ID); err != nil {
+		t.Fatalf("CancelTrip: %v", err)
+	}
+
+	if got := env.offerStats(t, 1); got.Rejected != 1 || got.Offers != 1 || got.AcceptanceRate != 0 {
+		t.Errorf("driver 1 stats = %+v, want one rejected offer", got)
+	}
+	got := env.offerStats(t, 2)
+	if got.Accepted != 1 || got.Cancelled != 1 || got.AcceptanceRate != 1 || got.CancellationRate != 1 {
+		t.Errorf("driver 2 stats = %+v, want one accepted and cancelled offer", got)
+	}
+	pushed := env.users.offerStats[2]
+	if pushed == nil || pushed.Cancelled != 1 || pushed.WindowDays != 30 || pushed.AsOf == "" {
+		t.Errorf("stats pushed to user-service = %v, want driver 2's latest", pushed)
+	}
+	if _, ok := offeredAt[trip.ID]; ok {
+		t.Error("offer time kept for a trip that no longer needs a driver")
+	}
+}
+
+func TestPassengerCancelIsNotAnOfferOutcome(t *testing.T) {
+	env := newTestEnv(t, 1)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	if err := env.service.AcceptTrip(ctx, 1, trip.ID); err != nil {
+		t.Fatalf("AcceptTrip: %v", err)
+	}
+	if err := env.service.CancelTrip(ctx, passengerID, trip.ID); err != nil {
+		t.Fatalf("CancelTrip: %v", err)
+	}
+	if got := env.offerStats(t, 1); got.Accepted != 1 || got.Cancelled != 0 {
+		t.Errorf("driver stats = %+v, want one accepted offer and no cancellation", got)
+	}
+}
+
+func TestOfferTimeout(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+	offeredAt[trip.ID] = time.Now().Add(-defaultOfferTimeout - time.Second)
+
+	// The first driver answering too late finds the offer gone.
+	if err := env.service.RejectTrip(ctx, 1, 0, trip.ID); err == nil {
+		t.Error("RejectTrip of a timed out offer succeeded, want error")
+	}
+	suggested, err := env.service.GetSuggestedDriver(ctx, trip.ID)
+	if err != nil || suggested != 2 {
+		t.Fatalf("GetSuggestedDriver = %d, %v, want driver 2", suggested, err)
+	}
+	if time.Since(offeredAt[trip.ID]) > time.Second {
+		t.Errorf("driver 2 offered the trip at %v, want now", offeredAt[trip.ID])
+	}
+	if got := env.offerStats(t, 1); got.TimedOut != 1 || got.Offers != 1 {
+		t.Errorf("driver 1 stats = %+v, want one timed out offer", got)
+	}
+	if got := env.offerStats(t, 2); got.Offers != 0 {
+		t.Errorf("driver 2 stats = %+v, want no decided offers yet", got)
+	}
+}
+
+func TestExpireOffersWithoutPolling(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	first, second := env.createTrip(t), env.createTrip(t)
+	offeredAt[first.ID] = time.Now().Add(-defaultOfferTimeout - time.Second)
+	offeredAt[second.ID] = time.Now().Add(-defaultOfferTimeout - time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		env.service.expireOffers(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+	deadline := time.Now().Add(time.Second)
+	for env.offerStats(t, 1).TimedOut < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got := env.offerStats(t, 1); got.TimedOut != 2 {
+		t.Errorf("driver 1 stats = %+v, want both offers timed out", got)
+	}
+	for _, trip := range []int{first.ID, second.ID} {
+		if head, _, ok := queueHead(trip); !ok || head != 2 {
+			t.Errorf("trip %d offered to %d, want driver 2", trip, head)
+		}
+	}
+}
+
+func TestRankDriversByOfferHistory(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	env.service.Ranking = rankingWeights{Distance: 1, Acceptance: 1, Cancellation: 1}
+	ctx := context.Background()
+
+	// Driver 1 is nearer but has let its last offers time out.
+	for i := 0; i < 5; i++ {
+		past := env.createTrip(t)
+		if err := env.service.recordOffer(ctx, env.repo, past.ID, 1, models.OfferTimedOut, time.Time{}); err != nil {
+			t.Fatal(err)
+		}
+		dropQueue(past.ID)
+	}
+
+	trip := env.createTrip(t)
+	if got, want := tripMap[trip.ID], []int{2, 1}; !slices.Equal(got, want) {
+		t.Errorf("driver queue = %v, want %v", got, want)
+	}
+}
+
+func TestGetDriverOfferReport(t *testing.T) {
+	env := newTestEnv(t, 1, 2, 3)
+	ctx := context.Background()
+	outcomes := map[int][]models.OfferOutcome{
+		1: {models.OfferAccepted, models.OfferAccepted, models.OfferCancelled},
+		2: {models.OfferRejected, models.OfferTimedOut, models.OfferAccepted},
+		3: {models.OfferRejected},
+	}
+	for driverID, list := range outcomes {
+		for _, outcome := range list {
+			past := env.createTrip(t)
+			if err := env.service.recordOffer(ctx, env.repo, past.ID, driverID, outcome, time.Time{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	report, err := env.service.GetDriverOfferReport(ctx, DriverOfferReportFilter{MinOffers: 2})
+	if err != nil {
+		t.Fatalf("GetDriverOfferReport: %v", err)
+	}
+	if s := report.Summary; s.Offers != 6 || s.Accepted != 3 || s.Cancelled != 1 || s.AcceptanceRate != 0.5 {
+		t.Errorf("summary = %+v, want 6 offers, 3 accepted, 1 cancelled", s)
+	}
+	var order []int
+	for _, d := range report.Drivers {
+		order = append(order, d.DriverID)
+	}
+	// Driver 3 has a single offer, under min_offers.
+	if want := []int{2, 1}; !slices.Equal(order, want) {
+		t.Errorf("drivers = %v, want %v", order, want)
+	}
+
+	one, err := env.service.GetDriverOfferReport(ctx, DriverOfferReportFilter{DriverID: 3})
+	if err != nil || len(one.Drivers) != 1 || one.Summary.Offers != 1 {
+		t.Errorf("report for driver 3 = %+v, %v, want their single offer", one, err)
+	}
+
+	_, err = env.service.GetDriverOfferReport(ctx, DriverOfferReportFilter{From: time.Now(), To: time.Now().Add(-time.Hour)})
+	if !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("inverted range error = %v, want ErrInvalidFilter", err)
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/Azure/go-amqp"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/rabbitmq"
+)
+
+const (
+	outboxBatchSize      = 100
+	outboxMaxBackoff     = 5 * time.Minute
+	outboxSentRetention  = 7 * 24 * time.Hour
+	outboxCleanupEvery   = time.Hour
+	outboxPublishTimeout = 10 * time.Second
+)
+
+// OutboxRelay publishes pending outbox rows to RabbitMQ and marks them sent.
+// A row is only marked sent after the broker accepted it, so delivery is
+// at-least-once: consumers may see a duplicate (same message ID) after a crash
+// between publish and commit. Failed rows are retried with exponential backoff.
+type OutboxRelay struct {
+	DB           repository.DatabaseRepo
+	PollInterval time.Duration
+
+	conn    *amqp.Conn
+	session *amqp.Session
+	senders map[string]*amqp.Sender
+}
+
+func NewOutboxRelay(db repository.DatabaseRepo) *OutboxRelay {
+	pollInterval, err := time.ParseDuration(env.Get("OUTBOX_POLL_INTERVAL", "1s"))
+	if err != nil || pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	return &OutboxRelay{
+		DB:           db,
+		PollInterval: pollInterval,
+		senders:      make(map[string]*amqp.Sender),
+	}
+}
+
+// Run relays events until ctx is cancelled, then closes the connection.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	defer r.closeConnection()
+	ticker := time.NewTicker(r.PollInterval)
+	defer ticker.Stop()
+	lastCleanup := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			sent, err := r.relayBatch(ctx)
+			if err != nil {
+				logger.Error("Outbox relay failed", "error", err)
+				break
+			}
+			if sent < outboxBatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastCleanup) >= outboxCleanupEvery {
+			lastCleanup = time.Now()
+			deleted, err := r.DB.DeleteSentEvents(ctx, time.Now().Add(-outboxSentRetention))
+			if err != nil {
+				logger.Error("Failed to delete sent outbox events", "error", err)
+			} else if deleted > 0 {
+				logger.Info("Deleted sent outbox events", "deleted", deleted)
+			}
+		}
+	}
+}
+
+// relayBatch publishes one batch of due events and returns how many were sent.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	if err := r.connect(ctx); err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	err := r.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		events, err := tx.FetchPendingEvents(ctx, outboxBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := r.publish(ctx, event); err != nil {
+				logger.Warn("Failed to publish outbox event, will retry",
+					"event_id", event.ID,
+					"event_name", event.EventName,
+					"attempts", event.Attempts+1,
+					"error", err,
+				)
+				r.closeConnection()
+				next := time.Now().Add(outboxBackoff(event.Attempts + 1))
+				if err := tx.MarkEventFailed(ctx, event.ID, err.Error(), next); err != nil {
+					return err
+				}
+				// The connection is gone; leave the rest for the next tick.
+				return nil
+			}
+			if err := tx.MarkEventSent(ctx, event.ID); err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	return sent, err
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event models.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	defer cancel()
+
+	sender, ok := r.senders[event.Destination]
+	if !ok {
+		var err error
+		sender, err = r.session.NewSender(ctx, event.Destination, nil)
+		if err != nil {
+			return err
+		}
+		r.senders[event.Destination] = sender
+	}
+
+	msg := &amqp.Message{
+		Header: &amqp.MessageHeader{Durable: true, Priority: event.Priority},
+		Data:   [][]byte{event.Payload},
+		Properties: &amqp.MessageProperties{
+			// Stable across redeliveries so consumers can de-duplicate.
+			MessageID:   strconv.FormatInt(event.ID, 10),
+			ContentType: to("application/json"),
+		},
+	}
+	if err := sender.Send(ctx, msg, nil); err != nil {
+		return err
+	}
+
+	logger.Info("Published event to RabbitMQ",
+		"event_id", event.ID,
+		"name", event.EventName)
+	return nil
+}
+
+// connect (re)establishes the connection and publishing session if needed.
+func (r *OutboxRelay) connect(ctx context.Context) error {
+	if r.conn == nil {
+		opts := rabbitmq.DefaultConnectOptions()
+		// Run retries on the next tick, so don't block here.
+		opts.MaxRetries = 1
+		conn, err := rabbitmq.Connect(ctx, env.RabbitMQURL(), &opts)
+		if err != nil {
+			return err
+		}
+		r.conn = conn
+	}
+	if r.session == nil {
+		sessionCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+		defer cancel()
+		session, err := r.conn.NewSession(sessionCtx, nil)
+		if err != nil {
+			r.closeConnection()
+			return err
+		}
+		r.session = session
+	}
+	return nil
+}
+
+func (r *OutboxRelay) closeConnection() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for destination, sender := range r.senders {
+		sender.Close(ctx)
+		delete(r.senders, destination)
+	}
+	if r.session != nil {
+		r.session.Close(ctx)
+		r.session = nil
+	}
+	if r.conn != nil {
+		r.conn.Close()
+		r.conn = nil
+	}
+}
+
+// outboxBackoff returns the delay before retry number attempt: 1s, 2s, 4s, ...
+// capped at outboxMaxBackoff.
+func outboxBackoff(attempt int) time.Duration {
+	if attempt > 10 {
+		return outboxMaxBackoff
+	}
+	d := time.Second << (attempt - 1)
+	if d > outboxMaxBackoff {
+		return outboxMaxBackoff
+	}
+	return d
+}
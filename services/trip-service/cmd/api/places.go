@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"trip-service/internal/repository"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrPlaceNotFound = errors.New("saved place not found")
+
+// resolvePlaces replaces the coordinates of newTrip with those of the saved
+// places it names. user-service only finds places that belong to the
+// passenger, so nobody can request a trip to someone else's home.
+func (trip *TripService) resolvePlaces(ctx context.Context, newTrip *repository.NewTripDTO) error {
+	resolve := func(placeID int, lat, lng *float64) error {
+		if placeID == 0 {
+			return nil
+		}
+		resp, err := trip.grpcClients.GetSavedPlaceViaGRPC(ctx, placeID, newTrip.PassengerID)
+		if status.Code(err) == codes.NotFound || (err == nil && resp.Place == nil) {
+			return fmt.Errorf("%w: %d", ErrPlaceNotFound, placeID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get saved place %d: %w", placeID, err)
+		}
+		*lat, *lng = resp.Place.Latitude, resp.Place.Longitude
+		return nil
+	}
+
+	if err := resolve(newTrip.OriginPlaceID, &newTrip.OriginLat, &newTrip.OriginLng); err != nil {
+		return err
+	}
+	if err := resolve(newTrip.DestPlaceID, &newTrip.DestLat, &newTrip.DestLng); err != nil {
+		return err
+	}
+	if newTrip.OriginPlaceID != 0 || newTrip.DestPlaceID != 0 {
+		trace.SpanFromContext(ctx).SetAttributes(
+			attribute.Int("origin_place_id", newTrip.OriginPlaceID),
+			attribute.Int("dest_place_id", newTrip.DestPlaceID),
+			attribute.Float64("origin_lat", newTrip.OriginLat),
+			attribute.Float64("origin_lng", newTrip.OriginLng),
+			attribute.Float64("dest_lat", newTrip.DestLat),
+			attribute.Float64("dest_lng", newTrip.DestLng),
+		)
+	}
+	return nil
+}
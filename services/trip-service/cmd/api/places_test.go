@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trip-service/internal/repository"
+
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+)
+
+func TestCreateTripFromSavedPlaces(t *testing.T) {
+	const homeID, workID, otherID = 1, 2, 3
+
+	tests := []struct {
+		name          string
+		originPlaceID int
+		destPlaceID   int
+		wantErr       error
+		wantOrigin    [2]float64
+		wantDest      [2]float64
+	}{
+		{
+			name:          "both places",
+			originPlaceID: homeID,
+			destPlaceID:   workID,
+			wantOrigin:    [2]float64{10.80, 106.65},
+			wantDest:      [2]float64{10.77, 106.70},
+		},
+		{
+			name:        "destination place only",
+			destPlaceID: workID,
+			wantOrigin:  [2]float64{10.762622, 106.660172},
+			wantDest:    [2]float64{10.77, 106.70},
+		},
+		{
+			name:          "unknown place",
+			originPlaceID: 42,
+			wantErr:       ErrPlaceNotFound,
+		},
+		{
+			name:        "someone else's place",
+			destPlaceID: otherID,
+			wantErr:     ErrPlaceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, 1)
+			env.users.places = map[int32]*userpb.SavedPlace{
+				homeID:  {PlaceId: homeID, UserId: passengerID, Kind: "home", Latitude: 10.80, Longitude: 106.65},
+				workID:  {PlaceId: workID, UserId: passengerID, Kind: "work", Latitude: 10.77, Longitude: 106.70},
+				otherID: {PlaceId: otherID, UserId: strangerID, Kind: "home", Latitude: 10.70, Longitude: 106.60},
+			}
+
+			trip, _, err := env.service.CreateTrip(context.Background(), repository.NewTripDTO{
+				PassengerID:   passengerID,
+				OriginLat:     10.762622,
+				OriginLng:     106.660172,
+				OriginPlaceID: tt.originPlaceID,
+				DestPlaceID:   tt.destPlaceID,
+				PaymentMethod: "cash",
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CreateTrip error = %v, want %v", err, tt.wantErr)
+				}
+				if trips, _ := env.repo.GetTrips(context.Background(), 1, 10); len(trips) != 0 {
+					t.Fatalf("%d trips created, want none", len(trips))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTrip: %v", err)
+			}
+			got := env.getTrip(t, trip.ID)
+			if origin := [2]float64{got.OriginLat, got.OriginLng}; origin != tt.wantOrigin {
+				t.Errorf("origin = %v, want %v", origin, tt.wantOrigin)
+			}
+			if dest := [2]float64{got.DestLat, got.DestLng}; dest != tt.wantDest {
+				t.Errorf("destination = %v, want %v", dest, tt.wantDest)
+			}
+		})
+	}
+}
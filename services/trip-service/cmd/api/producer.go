@@ -2,70 +2,43 @@ package main
 
 import (
 	"context"
-	"encoding/json"
-	"time"
+	"trip-service/internal/models"
 
-	"github.com/Azure/go-amqp"
-	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/rabbitmq"
 )
 
-type EventMessage struct {
-	Name string `json:"name"`
-	Data string `json:"data"`
-}
-
-func PublishEvent(conn *amqp.Conn, eventName, eventData string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+const eventSource = "trip-service"
 
-	// Create a new session for this publish operation
-	session, err := conn.NewSession(ctx, nil)
+// newEvent builds the outbox row for an event on the logs queue. The payload is
+// the exact message body the relay will publish: an events.Envelope carrying
+// the trace context of ctx.
+func newEvent(ctx context.Context, actor events.Actor, subject events.Subject, payload events.Payload) (models.OutboxEvent, error) {
+	envelope, err := events.New(ctx, eventSource, actor, subject, payload)
 	if err != nil {
-		logger.Error("Failed to create AMQP 1.0 session", "error", err)
-		return err
+		return models.OutboxEvent{}, err
 	}
-	defer session.Close(ctx)
-
-	// Create a sender to the logs queue
-	sender, err := session.NewSender(ctx, "/queues/logs", nil)
+	body, err := envelope.Marshal()
 	if err != nil {
-		logger.Error("Failed to create RabbitMQ sender", "error", err)
-		return err
-	}
-	defer sender.Close(ctx)
-
-	// Create event message
-	event := EventMessage{
-		Name: eventName,
-		Data: eventData,
-	}
-
-	body, err := json.Marshal(event)
-	if err != nil {
-		logger.Error("Failed to marshal event", "error", err)
-		return err
-	}
-
-	// Create message
-	msg := &amqp.Message{
-		Data: [][]byte{body},
-		Properties: &amqp.MessageProperties{
-			ContentType: to("application/json"),
-		},
+		return models.OutboxEvent{}, err
 	}
+	return models.OutboxEvent{
+		EventName:   envelope.Type,
+		Destination: rabbitmq.LogsQueueAddress,
+		Payload:     body,
+	}, nil
+}
 
-	// Send the message
-	err = sender.Send(ctx, msg, nil)
+// newSafetyEvent builds the outbox row for an event on the safety queue. It is
+// relayed ahead of everything else pending and published at high priority.
+func newSafetyEvent(ctx context.Context, actor events.Actor, subject events.Subject, payload events.Payload, priority uint8) (models.OutboxEvent, error) {
+	event, err := newEvent(ctx, actor, subject, payload)
 	if err != nil {
-		logger.Error("Failed to publish event", "error", err)
-		return err
+		return models.OutboxEvent{}, err
 	}
-
-	logger.Info("Published event to RabbitMQ",
-		"name", eventName,
-		"data", eventData)
-
-	return nil
+	event.Destination = rabbitmq.SafetyQueueAddress
+	event.Priority = priority
+	return event, nil
 }
 
 // Helper function to create string pointer
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// Each factor is scaled to [0, 1] against these; anything further, slower
+	// or idler counts the same as the bound.
+	rankingMaxDistanceKm = 15.0
+	rankingMaxPickup     = 30 * time.Minute
+	rankingMaxIdle       = time.Hour
+	// Ratings are pulled towards ratingPrior by ratingPriorTrips virtual trips,
+	// so a single 5-star trip doesn't outrank a long record of 4.8.
+	ratingPrior      = 4.5
+	ratingPriorTrips = 5
+	// Likewise acceptance and cancellation rates are pulled towards these
+	// priors by offerPriorCount virtual offers, so a driver with no history
+	// ranks as an average one.
+	acceptancePrior   = 0.8
+	cancellationPrior = 0.05
+	offerPriorCount   = 5
+	// Without a route, pickup time is estimated from the straight-line
+	// distance stretched by pickupDetour at pickupSpeedKmh.
+	pickupSpeedKmh = 25.0
+	pickupDetour   = 1.3
+	// rankingRouteTimeout bounds the pickup route lookups, which run in
+	// parallel for the routed candidates.
+	rankingRouteTimeout = 2 * time.Second
+)
+
+// loadRoutedPickups reads MATCH_ROUTED_PICKUPS, how many of the nearest
+// candidates get a routed pickup time. Each costs a HERE call per match, so
+// it defaults to none and everyone ranks on the estimate.
+func loadRoutedPickups() int {
+	n, err := strconv.Atoi(env.Get("MATCH_ROUTED_PICKUPS", ""))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// rankingWeights weighs the factors of a candidate's score. Only their ratios
+// matter; a zero weight turns the factor off.
+type rankingWeights struct {
+	Distance     float64
+	PickupETA    float64
+	Rating       float64
+	Acceptance   float64
+	Cancellation float64
+	Heading      float64
+	Idle         float64
+}
+
+var defaultRankingWeights = rankingWeights{
+	Distance:     0.30,
+	PickupETA:    0.20,
+	Rating:       0.15,
+	Acceptance:   0.10,
+	Cancellation: 0.05,
+	Heading:      0.10,
+	Idle:         0.10,
+}
+
+// loadRankingWeights reads MATCH_WEIGHT_DISTANCE, MATCH_WEIGHT_PICKUP_ETA,
+// MATCH_WEIGHT_RATING, MATCH_WEIGHT_ACCEPTANCE, MATCH_WEIGHT_CANCELLATION,
+// MATCH_WEIGHT_HEADING and MATCH_WEIGHT_IDLE. Unset or invalid weights keep
+// their default.
+func loadRankingWeights() rankingWeights {
+	weights := defaultRankingWeights
+	for name, dst := range map[string]*float64{
+		"DISTANCE":     &weights.Distance,
+		"PICKUP_ETA":   &weights.PickupETA,
+		"RATING":       &weights.Rating,
+		"ACCEPTANCE":   &weights.Acceptance,
+		"CANCELLATION": &weights.Cancellation,
+		"HEADING":      &weights.Heading,
+		"IDLE":         &weights.Idle,
+	} {
+		if w, err := strconv.ParseFloat(env.Get("MATCH_WEIGHT_"+name, ""), 64); err == nil && w >= 0 {
+			*dst = w
+		}
+	}
+	return weights
+}
+
+func (w rankingWeights) total() float64 {
+	return w.Distance + w.PickupETA + w.Rating + w.Acceptance + w.Cancellation + w.Heading + w.Idle
+}
+
+// candidateScore is how a driver scored for a trip, with the inputs and the
+// normalised factors behind it so that dispatch decisions can be explained.
+type candidateScore struct {
+	DriverID int
+	// Inputs. HeadingOff is the angle between the driver's heading and the
+	// pickup, or -1 when the heading is unknown.
+	DistanceKm   float64
+	PickupETA    time.Duration
+	ETARouted    bool
+	Rating       float64
+	Acceptance   float64
+	Cancellation float64
+	HeadingOff   float64
+	Idle         time.Duration
+	// Factors in [0, 1], higher is better.
+	DistanceFactor     float64
+	PickupFactor       float64
+	RatingFactor       float64
+	AcceptanceFactor   float64
+	CancellationFactor float64
+	HeadingFactor      float64
+	IdleFactor         float64
+	Score              float64
+}
+
+func (c *candidateScore) score(w rankingWeights) {
+	c.DistanceFactor = 1 - math.Min(c.DistanceKm/rankingMaxDistanceKm, 1)
+	c.PickupFactor = 1 - math.Min(c.PickupETA.Seconds()/rankingMaxPickup.Seconds(), 1)
+	c.RatingFactor = (c.Rating - 1) / 4
+	c.AcceptanceFactor = c.Acceptance
+	c.CancellationFactor = 1 - c.Cancellation
+	c.HeadingFactor = 0.5
+	if c.HeadingOff >= 0 {
+		c.HeadingFactor = (1 + math.Cos(c.HeadingOff*math.Pi/180)) / 2
+	}
+	c.IdleFactor = math.Min(c.Idle.Seconds()/rankingMaxIdle.Seconds(), 1)
+
+	total := w.total()
+	if total == 0 {
+		c.Score = 0
+		return
+	}
+	c.Score = (w.Distance*c.DistanceFactor +
+		w.PickupETA*c.PickupFactor +
+		w.Rating*c.RatingFactor +
+		w.Acceptance*c.AcceptanceFactor +
+		w.Cancellation*c.CancellationFactor +
+		w.Heading*c.HeadingFactor +
+		w.Idle*c.IdleFactor) / total
+}
+
+func (c *candidateScore) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("driver_id", c.DriverID),
+		attribute.Float64("score", c.Score),
+		attribute.Float64("distance_km", c.DistanceKm),
+		attribute.Float64("pickup_eta_seconds", c.PickupETA.Seconds()),
+		attribute.Bool("pickup_eta_routed", c.ETARouted),
+		attribute.Float64("rating", c.Rating),
+		attribute.Float64("acceptance_rate", c.Acceptance),
+		attribute.Float64("cancellation_rate", c.Cancellation),
+		attribute.Float64("heading_off_degrees", c.HeadingOff),
+		attribute.Float64("idle_seconds", c.Idle.Seconds()),
+		attribute.Float64("factor.distance", c.DistanceFactor),
+		attribute.Float64("factor.pickup_eta", c.PickupFactor),
+		attribute.Float64("factor.rating", c.RatingFactor),
+		attribute.Float64("factor.acceptance", c.AcceptanceFactor),
+		attribute.Float64("factor.cancellation", c.CancellationFactor),
+		attribute.Float64("factor.heading", c.HeadingFactor),
+		attribute.Float64("factor.idle", c.IdleFactor),
+	}
+}
+
+// smoothedRating is the driver's average rating pulled towards ratingPrior.
+func smoothedRating(stats models.DriverStats) float64 {
+	return (stats.AvgRating*float64(stats.RatedTrips) + ratingPrior*ratingPriorTrips) /
+		float64(stats.RatedTrips+ratingPriorTrips)
+}
+
+// smoothedAcceptance is the share of offers the driver accepted, pulled
+// towards acceptancePrior.
+func smoothedAcceptance(offers models.DriverOfferStats) float64 {
+	return (float64(offers.Accepted) + acceptancePrior*offerPriorCount) /
+		float64(offers.Offers+offerPriorCount)
+}
+
+// smoothedCancellation is the share of accepted trips the driver cancelled,
+// pulled towards cancellationPrior.
+func smoothedCancellation(offers models.DriverOfferStats) float64 {
+	rate := (float64(offers.Cancelled) + cancellationPrior*offerPriorCount) /
+		float64(offers.Accepted+offerPriorCount)
+	return math.Min(rate, 1)
+}
+
+var compassPoints = map[string]float64{
+	"N": 0, "NNE": 22.5, "NE": 45, "ENE": 67.5,
+	"E": 90, "ESE": 112.5, "SE": 135, "SSE": 157.5,
+	"S": 180, "SSW": 202.5, "SW": 225, "WSW": 247.5,
+	"W": 270, "WNW": 292.5, "NW": 315, "NNW": 337.5,
+}
+
+// parseHeading reads a heading given as a compass point ("NE") or in degrees
+// clockwise from north ("45").
+func parseHeading(heading string) (float64, bool) {
+	heading = strings.ToUpper(strings.TrimSpace(heading))
+	if degrees, ok := compassPoints[heading]; ok {
+		return degrees, true
+	}
+	degrees, err := strconv.ParseFloat(heading, 64)
+	if err != nil || math.IsNaN(degrees) || math.IsInf(degrees, 0) {
+		return 0, false
+	}
+	return math.Mod(math.Mod(degrees, 360)+360, 360), true
+}
+
+// bearing is the initial compass bearing in degrees from one point to another.
+func bearing(fromLat, fromLng, toLat, toLng float64) float64 {
+	lat1, lat2 := fromLat*math.Pi/180, toLat*math.Pi/180
+	dLng := (toLng - fromLng) * math.Pi / 180
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// headingOff is the angle in [0, 180] between where the driver is heading
+// and the pickup, or -1 when their heading is unknown.
+func headingOff(location *locationpb.Location, pickupLat, pickupLng float64) float64 {
+	heading, ok := parseHeading(location.Heading)
+	if !ok {
+		return -1
+	}
+	diff := math.Abs(heading - bearing(location.Latitude, location.Longitude, pickupLat, pickupLng))
+	if diff > 180 {
+		diff = 360 - diff
+	}
+	return diff
+}
+
+// estimatedPickup is the pickup time assumed when no route is available.
+func estimatedPickup(distanceKm float64) time.Duration {
+	return time.Duration(distanceKm * pickupDetour / pickupSpeedKmh * float64(time.Hour))
+}
+
+// rankDrivers orders the candidates for the trip by score, best first; ties
+// keep the distance order location-service returned them in. nearby holds
+// each candidate's location from the search. Every candidate's breakdown is
+// recorded as an event on the span in ctx.
+func (trip *TripService) rankDrivers(ctx context.Context, tripRecord models.Trip, candidates []int, nearby map[int]*locationpb.Location) []int {
+	if len(candidates) < 2 {
+		return candidates
+	}
+	weights := trip.Ranking
+
+	stats, err := trip.DB.GetDriverStats(ctx, candidates)
+	if err != nil {
+		// Rank on location alone rather than not at all.
+		logger.Warn(ctx, "Failed to get driver stats for ranking", "trip_id", tripRecord.ID, "error", err)
+		stats = nil
+	}
+	offers, err := trip.driverOfferStats(ctx, candidates)
+	if err != nil {
+		logger.Warn(ctx, "Failed to get driver offer stats for ranking", "trip_id", tripRecord.ID, "error", err)
+		offers = nil
+	}
+
+	now := time.Now()
+	scores := make([]candidateScore, len(candidates))
+	for i, driverID := range candidates {
+		c := candidateScore{DriverID: driverID, HeadingOff: -1, Idle: rankingMaxIdle}
+		if location := nearby[driverID]; location != nil {
+			c.DistanceKm = location.Distance
+			c.HeadingOff = headingOff(location, tripRecord.OriginLat, tripRecord.OriginLng)
+		}
+		c.PickupETA = estimatedPickup(c.DistanceKm)
+		s, ok := stats[driverID]
+		c.Rating = smoothedRating(s)
+		c.Acceptance = smoothedAcceptance(offers[driverID])
+		c.Cancellation = smoothedCancellation(offers[driverID])
+		if ok && s.LastTripEndedAt.Valid {
+			c.Idle = now.Sub(s.LastTripEndedAt.Time)
+		}
+		scores[i] = c
+	}
+	if weights.PickupETA > 0 && trip.RoutedPickups > 0 {
+		trip.routePickups(ctx, tripRecord, scores, nearby, trip.RoutedPickups)
+	}
+
+	span := trace.SpanFromContext(ctx)
+	for i := range scores {
+		scores[i].score(weights)
+		span.AddEvent("match.candidate", trace.WithAttributes(scores[i].attributes()...))
+	}
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Score > scores[j].Score })
+
+	ranked := make([]int, len(scores))
+	for i, c := range scores {
+		ranked[i] = c.DriverID
+	}
+	span.SetAttributes(attribute.IntSlice("ranked_drivers", ranked))
+	return ranked
+}
+
+// routePickups replaces the estimated pickup times of the limit nearest
+// candidates with their driving times to the pickup. Lookups that fail or run
+// out of time keep the estimate.
+func (trip *TripService) routePickups(ctx context.Context, tripRecord models.Trip, scores []candidateScore, nearby map[int]*locationpb.Location, limit int) {
+	ctx, cancel := context.WithTimeout(ctx, rankingRouteTimeout)
+	defer cancel()
+	pickup := fmt.Sprintf("%f,%f", tripRecord.OriginLat, tripRecord.OriginLng)
+
+	var located []int
+	for i := range scores {
+		if nearby[scores[i].DriverID] != nil {
+			located = append(located, i)
+		}
+	}
+	sort.SliceStable(located, func(a, b int) bool { return scores[located[a]].DistanceKm < scores[located[b]].DistanceKm })
+	if len(located) > limit {
+		located = located[:limit]
+	}
+
+	var wg sync.WaitGroup
+	for _, i := range located {
+		location := nearby[scores[i].DriverID]
+		wg.Add(1)
+		go func(c *candidateScore) {
+			defer wg.Done()
+			summary, err := trip.Routes.GetRouteSummary(ctx, fmt.Sprintf("%f,%f", location.Latitude, location.Longitude), pickup)
+			if err != nil {
+				logger.Warn(ctx, "Failed to route driver to pickup, using estimate", "driver_id", c.DriverID, "error", err)
+				return
+			}
+			c.PickupETA = time.Duration(summary.Duration * float64(time.Second))
+			c.ETARouted = true
+		}(&scores[i])
+	}
+	wg.Wait()
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+	"trip-service/internal"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+)
+
+func TestHeadingOff(t *testing.T) {
+	// The pickup is due north of the driver.
+	driver := func(heading string) *locationpb.Location {
+		return &locationpb.Location{Latitude: 10.70, Longitude: 106.66, Heading: heading}
+	}
+	tests := []struct {
+		heading string
+		want    float64
+	}{
+		{heading: "N", want: 0},
+		{heading: "ne", want: 45},
+		{heading: "S", want: 180},
+		{heading: "270", want: 90},
+		{heading: "-90", want: 90},
+		{heading: "", want: -1},
+		{heading: "sideways", want: -1},
+	}
+	for _, tt := range tests {
+		got := headingOff(driver(tt.heading), 10.76, 106.66)
+		if math.Abs(got-tt.want) > 0.5 {
+			t.Errorf("headingOff(%q) = %.1f, want %.1f", tt.heading, got, tt.want)
+		}
+	}
+}
+
+func TestRankDrivers(t *testing.T) {
+	// Driver 1 is 1 km past the pickup driving away from it and rated 2;
+	// driver 2 is 3 km before it driving towards it and rated 5.
+	nearby := map[int32]*locationpb.Location{
+		1: {UserId: 1, Role: "driver", Latitude: 10.771622, Longitude: 106.660172, Heading: "N", Distance: 1},
+		2: {UserId: 2, Role: "driver", Latitude: 10.735622, Longitude: 106.660172, Heading: "N", Distance: 3},
+	}
+	tests := []struct {
+		name    string
+		weights rankingWeights
+		want    []int
+	}{
+		{name: "default weights", weights: defaultRankingWeights, want: []int{2, 1}},
+		{name: "distance only", weights: rankingWeights{Distance: 1}, want: []int{1, 2}},
+		{name: "rating only", weights: rankingWeights{Rating: 1}, want: []int{2, 1}},
+		{name: "no weights keeps search order", want: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, 1, 2)
+			env.location.nearby = nearby
+			env.service.Ranking = tt.weights
+			ctx := context.Background()
+			for driverID, rating := range map[int]int{1: 2, 2: 5} {
+				for i := 0; i < 5; i++ {
+					past, err := env.repo.CreateTrip(ctx, repository.NewTripDTO{PassengerID: strangerID}, 1000, 5)
+					if err != nil {
+						t.Fatal(err)
+					}
+					env.setTrip(t, past.ID, models.StatusCompleted, driverID)
+					if err := env.repo.ReviewTrip(ctx, past.ID, repository.ReviewDTO{Rating: rating}); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			trip := env.createTrip(t)
+			if got := tripMap[trip.ID]; !slices.Equal(got, tt.want) {
+				t.Errorf("driver queue = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCandidateScore(t *testing.T) {
+	idle := candidateScore{DistanceKm: 2, PickupETA: rankingMaxPickup, Rating: 4.5, Acceptance: acceptancePrior, Cancellation: cancellationPrior, HeadingOff: -1, Idle: 2 * rankingMaxIdle}
+	busy := idle
+	busy.Idle = 0
+	idle.score(defaultRankingWeights)
+	busy.score(defaultRankingWeights)
+	if idle.IdleFactor != 1 || busy.IdleFactor != 0 || idle.Score <= busy.Score {
+		t.Errorf("idle driver scored %.3f (idle %.2f), busy driver %.3f (idle %.2f)", idle.Score, idle.IdleFactor, busy.Score, busy.IdleFactor)
+	}
+	if idle.PickupFactor != 0 || idle.HeadingFactor != 0.5 {
+		t.Errorf("pickup factor %.2f, heading factor %.2f, want 0 and 0.5", idle.PickupFactor, idle.HeadingFactor)
+	}
+	if idle.Score < 0 || idle.Score > 1 {
+		t.Errorf("score %.3f outside [0, 1]", idle.Score)
+	}
+}
+
+// countingRoutes routes every trip in a minute and counts the lookups.
+type countingRoutes struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (r *countingRoutes) GetRouteSummary(ctx context.Context, origin, destination string) (*internal.RouteSummary, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	return &internal.RouteSummary{Duration: 60}, nil
+}
+
+func TestRoutePickups(t *testing.T) {
+	nearby := map[int32]*locationpb.Location{
+		1: {UserId: 1, Role: "driver", Latitude: 10.80, Longitude: 106.66, Distance: 4},
+		2: {UserId: 2, Role: "driver", Latitude: 10.77, Longitude: 106.66, Distance: 1},
+		3: {UserId: 3, Role: "driver", Latitude: 10.78, Longitude: 106.66, Distance: 2},
+	}
+
+	// By default only the trip itself is routed, not its candidates.
+	env := newTestEnv(t, 1, 2, 3)
+	env.location.nearby = nearby
+	routes := &countingRoutes{}
+	env.service.Routes = routes
+	env.createTrip(t)
+	if routes.calls != 1 {
+		t.Errorf("creating a trip made %d route lookups, want 1 by default", routes.calls)
+	}
+	routes.calls = 0
+
+	// Otherwise only the nearest are.
+	scores := []candidateScore{{DriverID: 1, DistanceKm: 4}, {DriverID: 2, DistanceKm: 1}, {DriverID: 3, DistanceKm: 2}, {DriverID: 4}}
+	located := make(map[int]*locationpb.Location)
+	for id, location := range nearby {
+		located[int(id)] = location
+	}
+	env.service.routePickups(context.Background(), models.Trip{OriginLat: 10.76, OriginLng: 106.66}, scores, located, 2)
+	for _, c := range scores {
+		want := c.DriverID == 2 || c.DriverID == 3
+		if c.ETARouted != want {
+			t.Errorf("driver %d routed = %v, want %v", c.DriverID, c.ETARouted, want)
+		}
+	}
+	if routes.calls != 2 {
+		t.Errorf("route lookups = %d, want 2", routes.calls)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultReportRange = 7 * 24 * time.Hour
+	maxReportRange     = 366 * 24 * time.Hour
+	// Hourly buckets are limited to about a month so a report stays small.
+	maxHourlyReportRange = 31 * 24 * time.Hour
+	// Roughly 500 m; smaller cells would return one zone per trip.
+	minZoneCellSize = 0.005
+)
+
+// ErrInvalidFilter is wrapped by errors about a report or export filter.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// normalizeReportFilter fills in defaults and rejects filters that would be
+// expensive or meaningless to aggregate.
+func normalizeReportFilter(filter repository.TripReportFilter, now time.Time) (repository.TripReportFilter, error) {
+	if filter.To.IsZero() {
+		filter.To = now
+	}
+	if filter.From.IsZero() {
+		filter.From = filter.To.Add(-defaultReportRange)
+	}
+	if filter.Interval == "" {
+		filter.Interval = models.IntervalDay
+	}
+
+	if !filter.From.Before(filter.To) {
+		return filter, fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+	switch filter.Interval {
+	case models.IntervalDay:
+		if filter.To.Sub(filter.From) > maxReportRange {
+			return filter, fmt.Errorf("%w: range is longer than %d days", ErrInvalidFilter, int(maxReportRange.Hours()/24))
+		}
+	case models.IntervalHour:
+		if filter.To.Sub(filter.From) > maxHourlyReportRange {
+			return filter, fmt.Errorf("%w: hourly reports cover at most %d days", ErrInvalidFilter, int(maxHourlyReportRange.Hours()/24))
+		}
+	default:
+		return filter, fmt.Errorf("%w: unknown interval %q", ErrInvalidFilter, filter.Interval)
+	}
+	if zone := filter.Zone; zone != nil {
+		if zone.MinLat > zone.MaxLat || zone.MinLng > zone.MaxLng ||
+			zone.MinLat < -90 || zone.MaxLat > 90 || zone.MinLng < -180 || zone.MaxLng > 180 {
+			return filter, fmt.Errorf("%w: zone is not a valid bounding box", ErrInvalidFilter)
+		}
+	}
+	if filter.ZoneCellSize < 0 || (filter.ZoneCellSize > 0 && filter.ZoneCellSize < minZoneCellSize) {
+		return filter, fmt.Errorf("%w: zone cell size must be at least %g degrees", ErrInvalidFilter, minZoneCellSize)
+	}
+	return filter, nil
+}
+
+// GetTripReport aggregates the trips matching filter for operations reporting.
+func (trip *TripService) GetTripReport(ctx context.Context, filter repository.TripReportFilter) (models.TripReport, error) {
+	filter, err := normalizeReportFilter(filter, time.Now())
+	if err != nil {
+		return models.TripReport{}, err
+	}
+
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.GetTripReport",
+		trace.WithAttributes(
+			attribute.String("report.from", filter.From.Format(time.RFC3339)),
+			attribute.String("report.to", filter.To.Format(time.RFC3339)),
+			attribute.String("report.interval", string(filter.Interval)),
+			attribute.Bool("report.zone", filter.Zone != nil),
+			attribute.Float64("report.zone_cell_size", filter.ZoneCellSize),
+		),
+	)
+	defer span.End()
+
+	report, err := trip.DB.GetTripReport(ctx, filter)
+	if err != nil {
+		logger.Error(ctx, "Failed to build trip report", "error", err)
+		span.RecordError(err)
+		return models.TripReport{}, err
+	}
+	span.SetAttributes(attribute.Int64("report.trips", report.Summary.Trips))
+	return report, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+	"trip-service/internal"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+)
+
+func TestNormalizeReportFilter(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		filter  repository.TripReportFilter
+		want    repository.TripReportFilter
+		wantErr bool
+	}{
+		{
+			name: "defaults to the last week by day",
+			want: repository.TripReportFilter{From: now.Add(-7 * 24 * time.Hour), To: now, Interval: models.IntervalDay},
+		},
+		{
+			name:    "from after to",
+			filter:  repository.TripReportFilter{From: now, To: now.Add(-time.Hour)},
+			wantErr: true,
+		},
+		{
+			name:    "hourly report over two months",
+			filter:  repository.TripReportFilter{From: now.Add(-60 * 24 * time.Hour), To: now, Interval: models.IntervalHour},
+			wantErr: true,
+		},
+		{
+			name:    "unknown interval",
+			filter:  repository.TripReportFilter{Interval: "week"},
+			wantErr: true,
+		},
+		{
+			name:    "inverted zone",
+			filter:  repository.TripReportFilter{Zone: &models.BoundingBox{MinLat: 11, MaxLat: 10, MinLng: 106, MaxLng: 107}},
+			wantErr: true,
+		},
+		{
+			name:    "zone cells too small",
+			filter:  repository.TripReportFilter{ZoneCellSize: 0.0001},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeReportFilter(tt.filter, now)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFilter) {
+					t.Fatalf("error = %v, want ErrInvalidFilter", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeReportFilter: %v", err)
+			}
+			if !got.From.Equal(tt.want.From) || !got.To.Equal(tt.want.To) || got.Interval != tt.want.Interval {
+				t.Errorf("filter = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTripReport(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	ctx := context.Background()
+
+	// Two completed trips, one cancelled before acceptance, one still requested.
+	for _, fare := range []float64{20, 40} {
+		env.service.Routes = fakeRoutes{summary: internal.RouteSummary{Distance: fare * 200, Fare: fare}}
+		trip := env.createTrip(t)
+		env.setTrip(t, trip.ID, models.StatusCompleted, 1)
+	}
+	cancelled := env.createTrip(t)
+	env.setTrip(t, cancelled.ID, models.StatusCancelled, 0)
+	env.createTrip(t)
+
+	report, err := env.service.GetTripReport(ctx, repository.TripReportFilter{ZoneCellSize: 0.1})
+	if err != nil {
+		t.Fatalf("GetTripReport: %v", err)
+	}
+	s := report.Summary
+	if s.Trips != 4 || s.Completed != 2 || s.Cancelled != 1 {
+		t.Fatalf("summary counts = %d/%d/%d, want 4/2/1", s.Trips, s.Completed, s.Cancelled)
+	}
+	if s.CompletionRate != 0.5 || s.CancellationRate != 0.25 {
+		t.Errorf("rates = %v/%v, want 0.5/0.25", s.CompletionRate, s.CancellationRate)
+	}
+	if s.Revenue != 60 || s.AvgFare != 30 || s.AvgDistance != 6000 {
+		t.Errorf("revenue, avg fare, avg distance = %v, %v, %v, want 60, 30, 6000", s.Revenue, s.AvgFare, s.AvgDistance)
+	}
+	if s.AvgWaitToAcceptSeconds < 0 || s.AvgWaitToAcceptSeconds > 5 {
+		t.Errorf("avg wait to accept = %vs, want a few milliseconds", s.AvgWaitToAcceptSeconds)
+	}
+	if len(report.Buckets) != 1 || report.Buckets[0].Trips != 4 {
+		t.Errorf("buckets = %+v, want one daily bucket with 4 trips", report.Buckets)
+	}
+	if len(report.Zones) != 1 || report.Zones[0].Trips != 4 || !report.Zones[0].Cell.Contains(10.762622, 106.660172) {
+		t.Errorf("zones = %+v, want one cell around the test origin", report.Zones)
+	}
+	if len(report.RevenueByPaymentMethod) != 1 || report.RevenueByPaymentMethod[0].Revenue != 60 {
+		t.Errorf("revenue by payment method = %+v, want cash 60", report.RevenueByPaymentMethod)
+	}
+
+	elsewhere := &models.BoundingBox{MinLat: 21, MinLng: 105, MaxLat: 21.1, MaxLng: 105.9}
+	report, err = env.service.GetTripReport(ctx, repository.TripReportFilter{Zone: elsewhere})
+	if err != nil {
+		t.Fatalf("GetTripReport: %v", err)
+	}
+	if report.Summary.Trips != 0 || len(report.Buckets) != 0 || math.IsNaN(report.Summary.CompletionRate) {
+		t.Errorf("report outside the trips' zone = %+v, want empty", report.Summary)
+	}
+}
@@ -11,29 +11,58 @@ import (
 	"trip-service/internal/models"
 	"trip-service/internal/repository"
 
-	"github.com/Azure/go-amqp"
 	"github.com/XSAM/otelsql"
 	_ "github.com/jackc/pgconn"
 	_ "github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
 	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
-	"github.com/OneKeyCoder/UIT-Go-Backend/common/rabbitmq"
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	ErrCancelNotAllowed   = errors.New("user is not authorized to cancel this trip")
+	ErrTripNotCancellable = errors.New("trip can no longer be cancelled")
+	ErrReviewNotAllowed   = errors.New("user is not authorized to review this trip")
+	ErrTripNotReviewable  = errors.New("only completed trips can be reviewed")
+)
+
 type TripService struct {
 	DB          repository.DatabaseRepo
 	grpcClients *GRPCClients
-	RabbitConn  *amqp.Conn
+	Routes      internal.RouteProvider
+	Payments    internal.PaymentProvider
+	Tips        tipPolicy
+	Chat        *chatHub
+	Incidents   *incidentRecorder
+	Ranking     rankingWeights
+	// RoutedPickups is how many of the nearest candidates get a routed
+	// pickup time when ranking; the rest use the distance estimate.
+	RoutedPickups int
+	Offers        offerPolicy
+	shareETAs     etaCache
+	// IdempotencyRetention is how long responses to keyed requests are replayed.
+	IdempotencyRetention time.Duration
 }
 
+// tripMap holds each requested trip's queue of drivers, best match first.
+// queueMu guards it.
 var tripMap = make(map[int][]int)
 
+// matchCandidates is how many of the nearest drivers are ranked for a trip.
+const matchCandidates = 10
+
+// matchRadiiKm are the radii searched for drivers, widening until one is
+// found. location-service takes radii in km; they used to be given in metres
+// (5000, 10000, 15000), which searched thousands of km around the pickup.
+var matchRadiiKm = []float64{5.0, 10.0, 15.0}
+
 func (trip *TripService) CreateTrip(ctx context.Context, newTrip repository.NewTripDTO) (models.Trip, float64, error) {
 	tracer := otel.Tracer("trip-service")
 	ctx, span := tracer.Start(ctx, "TripService.CreateTrip",
@@ -46,14 +75,27 @@ func (trip *TripService) CreateTrip(ctx context.Context, newTrip repository.NewT
 		),
 	)
 	defer span.End()
-	
+
+	className, class, err := lookupVehicleClass(newTrip.VehicleClass)
+	if err != nil {
+		return models.Trip{}, 0, err
+	}
+	newTrip.VehicleClass = className
+	span.SetAttributes(attribute.String("vehicle_class", className))
+
+	if err := trip.resolvePlaces(ctx, &newTrip); err != nil {
+		logger.Error(ctx, "Failed to resolve saved places", "error", err)
+		span.RecordError(err)
+		return models.Trip{}, 0, err
+	}
+
 	_, routeSpan := tracer.Start(ctx, "GetRouteSummary")
 	origin := fmt.Sprintf("%f,%f", newTrip.OriginLat, newTrip.OriginLng)
 	destination := fmt.Sprintf("%f,%f", newTrip.DestLat, newTrip.DestLng)
 	routeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	routeSummary, err := internal.GetRouteSummary(routeCtx, origin, destination)
+	routeSummary, err := trip.Routes.GetRouteSummary(routeCtx, origin, destination)
 	routeSpan.End()
 	if err != nil {
 		logger.Error(ctx, "Failed to get route summary from HERE API", "error", err)
@@ -61,7 +103,26 @@ func (trip *TripService) CreateTrip(ctx context.Context, newTrip repository.NewT
 		return models.Trip{}, 0, err
 	}
 	_, dbSpan := tracer.Start(ctx, "DB.CreateTrip")
-	tripRecord, err := trip.DB.CreateTrip(newTrip, routeSummary.Distance, routeSummary.Fare)
+	var tripRecord models.Trip
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		var err error
+		tripRecord, err = tx.CreateTrip(ctx, newTrip, routeSummary.Distance, class.fare(routeSummary.Fare))
+		if err != nil {
+			return err
+		}
+		return enqueueEvent(ctx, tx, events.UserActor(newTrip.PassengerID), events.TripSubject(tripRecord.ID), events.TripRequested{
+			TripID:        tripRecord.ID,
+			PassengerID:   tripRecord.PassengerID,
+			OriginLat:     tripRecord.OriginLat,
+			OriginLng:     tripRecord.OriginLng,
+			DestLat:       tripRecord.DestLat,
+			DestLng:       tripRecord.DestLng,
+			Distance:      tripRecord.Distance,
+			Fare:          tripRecord.Fare,
+			PaymentMethod: tripRecord.PaymentMethod,
+			VehicleClass:  tripRecord.VehicleClass,
+		})
+	})
 	dbSpan.End()
 	if err != nil {
 		logger.Error(ctx, "Failed to create trip in database", "error", err)
@@ -69,52 +130,60 @@ func (trip *TripService) CreateTrip(ctx context.Context, newTrip repository.NewT
 		return models.Trip{}, 0, err
 	}
 	span.SetAttributes(attribute.Int("trip_id", tripRecord.ID))
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("User %d requested a trip from (%f, %f) to (%f, %f)",
-			newTrip.PassengerID,
-			newTrip.OriginLat,
-			newTrip.OriginLng,
-			newTrip.DestLat,
-			newTrip.DestLng,
-		)
-		go PublishEvent(trip.RabbitConn, "user.createTrip", eventData)
-	}
-	err = trip.getAllAvailableDrivers(ctx, tripRecord.ID, tripRecord.PassengerID)
+	err = trip.getAllAvailableDrivers(ctx, tripRecord)
 	if err != nil {
 		logger.Error(ctx, "Failed to get available drivers", "error", err)
 		// Don't return error - trip is created, just no drivers yet
 	}
 	return tripRecord, routeSummary.Duration, nil
 }
-func (trip *TripService) AcceptTrip(driverID int, tripID int) error {
-	if _, ok := tripMap[tripID]; !ok || len(tripMap[tripID]) == 0 {
+func (trip *TripService) AcceptTrip(ctx context.Context, driverID int, tripID int) error {
+	if _, _, ok := queueHead(tripID); !ok {
 		logger.Error("No available drivers for this trip", "trip_id", tripID)
 		return errors.New("no available drivers for this trip")
 	}
-	if suggestID, err := trip.GetSuggestedDriver(tripID); err != nil || suggestID != driverID {
+	suggestID, err := trip.GetSuggestedDriver(ctx, tripID)
+	if err != nil || suggestID != driverID {
 		logger.Error("Driver is not the suggested driver for this trip", "driver_id", driverID, "trip_id", tripID)
 		return errors.New("driver is not the suggested driver for this trip")
 	}
-	err := trip.DB.AcceptTrip(tripID, driverID)
-	if err != nil {
-		logger.Error("Failed to accept trip in database", "error", err)
+	if err := trip.markDriverOnTrip(ctx, driverID, tripID); err != nil {
+		logger.Error("Driver cannot accept trip", "driver_id", driverID, "trip_id", tripID, "error", err)
 		return err
 	}
-	tripRecord, err := trip.DB.GetTrip(tripID)
+	// The offer may have timed out or been answered while location-service
+	// was called; taking the driver off the queue claims it for good.
+	offered, _, ok := popHead(tripID, driverID)
+	if !ok {
+		logger.Error("Offer is no longer open to the driver", "driver_id", driverID, "trip_id", tripID)
+		trip.releaseDriver(ctx, driverID, tripID)
+		return errors.New("driver is not the suggested driver for this trip")
+	}
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		if err := tx.AcceptTrip(ctx, tripID, driverID); err != nil {
+			return err
+		}
+		if err := trip.recordOffer(ctx, tx, tripID, driverID, models.OfferAccepted, offered); err != nil {
+			return err
+		}
+		return enqueueEvent(ctx, tx, events.DriverActor(driverID), events.TripSubject(tripID), events.TripAccepted{
+			TripID:   tripID,
+			DriverID: driverID,
+		})
+	})
 	if err != nil {
-		logger.Error("Failed to get trip from database", "error", err)
+		logger.Error("Failed to accept trip in database", "error", err)
+		trip.releaseDriver(ctx, driverID, tripID)
+		restoreHead(tripID, driverID, offered)
 		return err
 	}
 	//Thông báo
-	delete(tripMap, tripRecord.PassengerID)
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("Driver %d accepted trip %d", driverID, tripID)
-		go PublishEvent(trip.RabbitConn, "driver.acceptTrip", eventData)
-	}
+	dropQueue(tripID)
+	trip.syncOfferStats(ctx, driverID)
 	return nil
 }
-func (trip *TripService) GetTrip(userID int, tripID int) (models.Trip, error) {
-	tripRecord, err := trip.DB.GetTrip(tripID)
+func (trip *TripService) GetTrip(ctx context.Context, userID int, tripID int) (models.Trip, error) {
+	tripRecord, err := trip.DB.GetTrip(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get trip from database", "error", err)
 		return models.Trip{}, err
@@ -133,33 +202,33 @@ func (trip *TripService) GetTrip(userID int, tripID int) (models.Trip, error) {
 	return tripRecord, nil
 }
 
-func (trip *TripService) GetTripsByPassenger(passengerID int) ([]models.Trip, error) {
-	trips, err := trip.DB.GetTripsByPassenger(passengerID)
+func (trip *TripService) GetTripsByPassenger(ctx context.Context, passengerID int) ([]models.Trip, error) {
+	trips, err := trip.DB.GetTripsByPassenger(ctx, passengerID)
 	if err != nil {
 		logger.Error("Failed to get trips by passenger from database", "error", err)
 		return nil, err
 	}
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("User %d requested their trip history", passengerID)
-		go PublishEvent(trip.RabbitConn, "user.tripHistory", eventData)
+	history := events.TripHistoryViewed{UserID: passengerID, Role: "passenger", Count: len(trips)}
+	if err := enqueueEvent(ctx, trip.DB, events.UserActor(passengerID), events.UserSubject(passengerID), history); err != nil {
+		logger.Error("Failed to record trip history event", "error", err)
 	}
 	return trips, nil
 }
-func (trip *TripService) GetTripsByDriver(driverID int) ([]models.Trip, error) {
-	trips, err := trip.DB.GetTripsByDriver(driverID)
+func (trip *TripService) GetTripsByDriver(ctx context.Context, driverID int) ([]models.Trip, error) {
+	trips, err := trip.DB.GetTripsByDriver(ctx, driverID)
 	if err != nil {
 		logger.Error("Failed to get trips by driver from database", "error", err)
 		return nil, err
 	}
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("Driver %d requested their trip history", driverID)
-		go PublishEvent(trip.RabbitConn, "driver.tripHistory", eventData)
+	history := events.TripHistoryViewed{UserID: driverID, Role: "driver", Count: len(trips)}
+	if err := enqueueEvent(ctx, trip.DB, events.DriverActor(driverID), events.UserSubject(driverID), history); err != nil {
+		logger.Error("Failed to record trip history event", "error", err)
 	}
 	return trips, nil
 }
 
-func (trip *TripService) UpdateTripStatus(status models.TripStatus, tripID int, driverID int) error {
-	tripRecord, err := trip.DB.GetTrip(tripID)
+func (trip *TripService) UpdateTripStatus(ctx context.Context, status models.TripStatus, tripID int, driverID int) error {
+	tripRecord, err := trip.DB.GetTrip(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get trip from database", "error", err)
 		return err
@@ -174,50 +243,82 @@ func (trip *TripService) UpdateTripStatus(status models.TripStatus, tripID int,
 		logger.Error("Driver is not authorized to update this trip", "driver_id", driverID, "trip_id", tripID)
 		return errors.New("driver is not authorized to update this trip")
 	}
-	err = trip.DB.UpdateTripStatus(status, tripID)
+	driverCancelled := status == models.StatusCancelled && driverID != 0 &&
+		tripRecord.Status != models.StatusCancelled && tripRecord.Status != models.StatusCompleted
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		if err := tx.UpdateTripStatus(ctx, status, tripID); err != nil {
+			return err
+		}
+		if driverCancelled {
+			if err := trip.recordOffer(ctx, tx, tripID, driverID, models.OfferCancelled, tripRecord.AcceptedAt.Time); err != nil {
+				return err
+			}
+		}
+		return enqueueEvent(ctx, tx, events.DriverActor(driverID), events.TripSubject(tripID), events.TripStatusChanged{
+			TripID:   tripID,
+			DriverID: driverID,
+			Status:   string(status),
+		})
+	})
 	if err != nil {
 		logger.Error("Failed to update trip status in database", "error", err)
 		return err
 	}
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("Trip %d status updated to %s", tripID, status)
-		go PublishEvent(trip.RabbitConn, "trip.updateStatus", eventData)
+	if status == models.StatusCompleted || status == models.StatusCancelled {
+		trip.Chat.close(tripID)
+		trip.releaseDriver(ctx, driverID, tripID)
+	}
+	if driverCancelled {
+		trip.syncOfferStats(ctx, driverID)
 	}
 	return nil
 }
 
-func (trip *TripService) GetAllTrips(page int, limit int) ([]models.Trip, error) {
-	trips, err := trip.DB.GetTrips(page, limit)
+func (trip *TripService) GetAllTrips(ctx context.Context, page int, limit int) ([]models.Trip, error) {
+	trips, err := trip.DB.GetTrips(ctx, page, limit)
 	if err != nil {
 		logger.Error("Failed to get app trips from database", "error", err)
 		return nil, err
 	}
 	return trips, nil
 }
-func (trip *TripService) ReviewTrip(userID int, tripID int, review repository.ReviewDTO) error {
-	record, err := trip.DB.GetTrip(tripID)
+// ReviewTrip records the passenger's review of a completed trip. Reviews of
+// trips that never finished would rate a driver for a ride that didn't happen,
+// so anything but COMPLETED is refused.
+func (trip *TripService) ReviewTrip(ctx context.Context, userID int, tripID int, review repository.ReviewDTO) error {
+	record, err := trip.DB.GetTrip(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get trip from database", "error", err)
 		return errors.New("trip not found")
 	}
 	if record.PassengerID != userID {
 		logger.Error("User is not authorized to review this trip", "user_id", userID, "trip_id", tripID)
-		return errors.New("user is not authorized to review this trip")
+		return ErrReviewNotAllowed
+	}
+	if record.Status != models.StatusCompleted {
+		logger.Error("Trip is not completed", "trip_id", tripID, "status", string(record.Status))
+		return ErrTripNotReviewable
 	}
-	err = trip.DB.ReviewTrip(tripID, review)
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		if err := tx.ReviewTrip(ctx, tripID, review); err != nil {
+			return err
+		}
+		return enqueueEvent(ctx, tx, events.UserActor(userID), events.TripSubject(tripID), events.TripReviewed{
+			TripID:      tripID,
+			PassengerID: userID,
+			Rating:      review.Rating,
+			Comment:     review.Comment,
+		})
+	})
 	if err != nil {
 		logger.Error("Failed to review trip in database", "error", err)
 		return err
 	}
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("User %d reviewed trip %d", userID, tripID)
-		go PublishEvent(trip.RabbitConn, "user.tripHistory", eventData)
-	}
 	return nil
 }
 
-func (trip *TripService) GetReview(tripID int, userID int) (repository.ReviewDTO, error) {
-	review, err := trip.DB.GetReview(tripID)
+func (trip *TripService) GetReview(ctx context.Context, tripID int, userID int) (repository.ReviewDTO, error) {
+	review, err := trip.DB.GetReview(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get review from database", "error", err)
 		return repository.ReviewDTO{}, err
@@ -229,59 +330,117 @@ func (trip *TripService) GetReview(tripID int, userID int) (repository.ReviewDTO
 	return review, nil
 }
 
-func (trip *TripService) CancelTrip(userID int, tripID int) error {
-	err := trip.DB.CancelTrip(userID, tripID)
+// CancelTrip cancels the trip for its passenger or the driver who accepted it;
+// anyone else could otherwise cancel other people's trips. Finished trips keep
+// their outcome: a completed trip was paid for, and cancelling twice would
+// overwrite who cancelled first.
+func (trip *TripService) CancelTrip(ctx context.Context, userID int, tripID int) error {
+	tripRecord, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		logger.Error("Failed to get trip from database", "error", err)
+		return err
+	}
+	if tripRecord.PassengerID != userID && (!tripRecord.DriverID.Valid || int(tripRecord.DriverID.Int32) != userID) {
+		logger.Error("User is not authorized to cancel this trip", "user_id", userID, "trip_id", tripID)
+		return ErrCancelNotAllowed
+	}
+	if tripRecord.Status == models.StatusCompleted || tripRecord.Status == models.StatusCancelled {
+		logger.Error("Trip can no longer be cancelled", "trip_id", tripID, "status", string(tripRecord.Status))
+		return ErrTripNotCancellable
+	}
+	// A driver backing out of a trip they accepted counts against them.
+	driverCancelled := tripRecord.DriverID.Valid && int(tripRecord.DriverID.Int32) == userID
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		if err := tx.CancelTrip(ctx, userID, tripID); err != nil {
+			return err
+		}
+		if driverCancelled {
+			if err := trip.recordOffer(ctx, tx, tripID, userID, models.OfferCancelled, tripRecord.AcceptedAt.Time); err != nil {
+				return err
+			}
+		}
+		return enqueueEvent(ctx, tx, events.UserActor(userID), events.TripSubject(tripID), events.TripCancelled{
+			TripID:      tripID,
+			CancelledBy: userID,
+		})
+	})
 	if err != nil {
 		logger.Error("Failed to cancel trip in database", "error", err)
 		return err
 	}
+	dropQueue(tripID)
+	trip.Chat.close(tripID)
+	if tripRecord.DriverID.Valid {
+		trip.releaseDriver(ctx, int(tripRecord.DriverID.Int32), tripID)
+	}
+	if driverCancelled {
+		trip.syncOfferStats(ctx, userID)
+	}
 	return nil
 }
 
-func (trip *TripService) getAllAvailableDrivers(ctx context.Context, tripID int, userID int) error {
+// getAllAvailableDrivers queues the drivers who can take the trip, best match
+// first, searching ever wider around the pickup point until someone is found.
+func (trip *TripService) getAllAvailableDrivers(ctx context.Context, tripRecord models.Trip) error {
+	tripID, userID, vehicleClass := tripRecord.ID, tripRecord.PassengerID, tripRecord.VehicleClass
 	tracer := otel.Tracer("trip-service")
 	ctx, span := tracer.Start(ctx, "TripService.getAllAvailableDrivers",
 		trace.WithAttributes(
 			attribute.Int("trip_id", tripID),
 			attribute.Int("user_id", userID),
+			attribute.String("vehicle_class", vehicleClass),
 		),
 	)
 	defer span.End()
 	
-	radiusList := []float64{5000.0, 10000.0, 15000.0}
-
-	for i, radius := range radiusList {
-		_, searchSpan := tracer.Start(ctx, fmt.Sprintf("FindNearestUsers.radius_%d", i+1),
-			trace.WithAttributes(attribute.Float64("radius_meters", radius)),
+	for i, radius := range matchRadiiKm {
+		_, searchSpan := tracer.Start(ctx, fmt.Sprintf("SearchLocations.radius_%d", i+1),
+			trace.WithAttributes(attribute.Float64("radius_km", radius)),
 		)
 		
 		grpcCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		locations, err := trip.grpcClients.FindNearestUsersViaGRPC(grpcCtx, userID, 5, radius)
+		locations, err := trip.grpcClients.SearchDriversViaGRPC(grpcCtx, tripRecord.OriginLat, tripRecord.OriginLng, radius, matchCandidates)
 		cancel()
 		searchSpan.End()
 		
 		if err != nil {
-			logger.Error(ctx, "Failed to get nearest users via gRPC", "radius", radius, "error", err)
+			logger.Error(ctx, "Failed to search drivers via gRPC", "radius", radius, "error", err)
 			searchSpan.RecordError(err)
 			continue
 		}
 
 		if len(locations.Locations) > 0 {
-			seen := make(map[int]bool)
+			nearby := make(map[int]*locationpb.Location)
+			var candidates []int
 			for _, loc := range locations.Locations {
-				if !seen[int(loc.UserId)] {
-					tripMap[tripID] = append(tripMap[tripID], int(loc.UserId))
-					seen[int(loc.UserId)] = true
+				if _, seen := nearby[int(loc.UserId)]; !seen {
+					candidates = append(candidates, int(loc.UserId))
+					nearby[int(loc.UserId)] = loc
 				}
 			}
+			// Without the block list we can't tell who is safe to offer the
+			// trip to, so offer it to nobody and let the next lookup retry.
+			allowed, err := trip.filterBlockedDrivers(ctx, userID, candidates)
+			if err != nil {
+				logger.Error(ctx, "Failed to filter blocked drivers", "user_id", userID, "error", err)
+				span.RecordError(err)
+				return err
+			}
+			allowed = trip.filterDriversByVehicle(ctx, vehicleClass, allowed)
+			if len(allowed) == 0 {
+				logger.Info(ctx, "No nearby driver can take the trip", "user_id", userID, "radius", radius, "vehicle_class", vehicleClass)
+				continue
+			}
+			allowed = trip.rankDrivers(ctx, tripRecord, allowed, nearby)
+			found := enqueueDrivers(tripID, allowed)
 
 			logger.Info(ctx, "Found nearby drivers",
 				"user_id", userID,
 				"radius", radius,
-				"found", len(tripMap[tripID]),
+				"found", found,
 			)
 			span.SetAttributes(
-				attribute.Int("drivers_found", len(tripMap[tripID])),
+				attribute.Int("drivers_found", found),
 				attribute.Float64("search_radius", radius),
 			)
 			return nil
@@ -291,8 +450,26 @@ func (trip *TripService) getAllAvailableDrivers(ctx context.Context, tripID int,
 	span.SetAttributes(attribute.Int("drivers_found", 0))
 	return nil
 }
-func (trip *TripService) GetSuggestedDriver(tripID int) (int, error) {
-	tripRecord, err := trip.DB.GetTrip(tripID)
+
+// filterBlockedDrivers drops the candidates that the passenger blocked or that
+// blocked the passenger, keeping the order of the rest.
+func (trip *TripService) filterBlockedDrivers(ctx context.Context, passengerID int, candidates []int) ([]int, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	resp, err := trip.grpcClients.FilterBlockedUsersViaGRPC(ctx, passengerID, candidates)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make([]int, 0, len(resp.AllowedIds))
+	for _, id := range resp.AllowedIds {
+		allowed = append(allowed, int(id))
+	}
+	return allowed, nil
+}
+
+func (trip *TripService) GetSuggestedDriver(ctx context.Context, tripID int) (int, error) {
+	tripRecord, err := trip.DB.GetTrip(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get trip from database", "error", err)
 		return 0, err
@@ -301,50 +478,62 @@ func (trip *TripService) GetSuggestedDriver(tripID int) (int, error) {
 		logger.Error("Trip is not in requested status", "trip_id", tripID, "status", string(tripRecord.Status))
 		return 0, errors.New("trip is not in requested status")
 	}
-	if _, ok := tripMap[tripID]; !ok || len(tripMap[tripID]) == 0 {
-		err := trip.getAllAvailableDrivers(context.TODO(), tripID, tripID)
+	trip.expireOffer(ctx, tripID)
+	if _, _, ok := queueHead(tripID); !ok {
+		err := trip.getAllAvailableDrivers(ctx, tripRecord)
 		if err != nil {
 			return 0, err
 		}
 	}
-	if len(tripMap[tripID]) == 0 {
+	suggestedDriverID, _, ok := queueHead(tripID)
+	if !ok {
 		return 0, errors.New("no available drivers found")
 	}
-	suggestedDriverID := tripMap[tripID][0]
 	return suggestedDriverID, nil
 }
 
-func (trip *TripService) RejectTrip(driverID int, passengerID int, tripID int) error {
-	tripRecord, err := trip.DB.GetTrip(tripID)
+// RejectTrip passes a requested trip on to the next suggested driver. Only the
+// driver currently suggested for the trip may reject it. The trip record names
+// the passenger; passengerID is only checked against it when a client still
+// sends one, and 0 skips the check.
+func (trip *TripService) RejectTrip(ctx context.Context, driverID int, passengerID int, tripID int) error {
+	tripRecord, err := trip.DB.GetTrip(ctx, tripID)
 	if err != nil {
 		logger.Error("Failed to get trip from database", "error", err)
 		return err
 	}
-	var driver int
-	if tripRecord.DriverID.Valid {
-		driver = int(tripRecord.DriverID.Int32)
-	} else {
-		driver = 0
-	}
-	if driver != driverID {
-		logger.Error("Driver is not authorized to reject this trip", "driver_id", driverID, "trip_id", tripID)
-		return errors.New("driver is not authorized to reject this trip")
+	if tripRecord.Status != models.StatusRequested {
+		logger.Error("Trip is not in requested status", "trip_id", tripID, "status", string(tripRecord.Status))
+		return errors.New("trip is not in requested status")
 	}
-	if tripRecord.PassengerID != passengerID {
+	if passengerID != 0 && tripRecord.PassengerID != passengerID {
 		logger.Error("Passenger ID does not match trip record", "passenger_id", passengerID, "trip_id", tripID)
 		return errors.New("passenger ID does not match trip record")
 	}
+	// An offer that already timed out can't be rejected any more.
+	trip.expireOffer(ctx, tripID)
+	offered, remaining, ok := popHead(tripID, driverID)
+	if !ok {
+		logger.Error("Driver is not authorized to reject this trip", "driver_id", driverID, "trip_id", tripID)
+		return errors.New("driver is not authorized to reject this trip")
+	}
 	//Thông báo
-	if len(tripMap[passengerID]) > 0 {
-		tripMap[passengerID] = tripMap[passengerID][1:]
-	} else {
-		logger.Warn("No more drivers available", "passenger_id", passengerID)
-		return errors.New("no more drivers available")
+	if remaining == 0 {
+		logger.Warn("No more drivers available", "trip_id", tripID)
 	}
-	if trip.RabbitConn != nil {
-		eventData := fmt.Sprintf("Driver %d rejected trip %d", driverID, tripID)
-		go PublishEvent(trip.RabbitConn, "driver.rejectTrip", eventData)
+	rejected := events.TripRejected{TripID: tripID, DriverID: driverID, PassengerID: tripRecord.PassengerID}
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		if err := trip.recordOffer(ctx, tx, tripID, driverID, models.OfferRejected, offered); err != nil {
+			return err
+		}
+		return enqueueEvent(ctx, tx, events.DriverActor(driverID), events.TripSubject(tripID), rejected)
+	})
+	if err != nil {
+		logger.Error("Failed to record rejected offer", "error", err)
+		restoreHead(tripID, driverID, offered)
+		return err
 	}
+	trip.syncOfferStats(ctx, driverID)
 	return nil
 }
 func (trip *TripService) InitializeServices() {
@@ -353,20 +542,46 @@ func (trip *TripService) InitializeServices() {
 		logger.Fatal("Cannot connect to database", "error", err)
 	}
 	logger.Info("Connected to database")
+	if err := migrateOnStartup(conn); err != nil {
+		logger.Fatal("Database schema is not ready", "error", err)
+	}
 	trip.grpcClients = &GRPCClients{}
 	if trip.grpcClients, err = trip.grpcClients.InitGRPCClients(); err != nil {
 		logger.Fatal("Cannot initialize gRPC clients", "error", err)
 	}
+	trip.Routes = internal.HereRouteProvider{}
+	// No payment gateway is integrated yet: Payments stays nil, which keeps
+	// tipping disabled.
+	trip.Tips = loadTipPolicy()
+	trip.Ranking = loadRankingWeights()
+	trip.RoutedPickups = loadRoutedPickups()
+	trip.Offers = loadOfferPolicy()
+	trip.Chat = newChatHub()
+	trip.Incidents = newIncidentRecorder(incidentTrackInterval())
 	trip.DB = &repository.PostgresDBRepo{
-		DB: conn,
+		DB:      conn,
+		Timeout: dbQueryTimeout(),
+	}
+	trip.IdempotencyRetention = idempotencyRetention()
+}
+
+// dbQueryTimeout reads DB_QUERY_TIMEOUT, the upper bound for a single query.
+func dbQueryTimeout() time.Duration {
+	d, err := time.ParseDuration(env.Get("DB_QUERY_TIMEOUT", ""))
+	if err != nil || d <= 0 {
+		return repository.DefaultTimeout
 	}
-	rabbitConn, err := rabbitmq.ConnectSimple(env.RabbitMQURL())
+	return d
+}
+
+// enqueueEvent records an event in the outbox through repo, which should be the
+// transaction that also holds the change the event describes.
+func enqueueEvent(ctx context.Context, repo repository.DatabaseRepo, actor events.Actor, subject events.Subject, payload events.Payload) error {
+	event, err := newEvent(ctx, actor, subject, payload)
 	if err != nil {
-		logger.Error("Failed to connect to RabbitMQ, continuing without events", "error", err)
-	} else {
-		logger.Info("Connected to RabbitMQ")
+		return err
 	}
-	trip.RabbitConn = rabbitConn
+	return repo.EnqueueEvent(ctx, event)
 }
 
 func (trip *TripService) connectToDB() (*sql.DB, error) {
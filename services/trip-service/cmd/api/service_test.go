@@ -0,0 +1,1073 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+	"trip-service/internal"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/trip"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLocationClient answers SearchLocations with a fixed list of drivers,
+// GetLocation from positions and keeps onTrip like location-service's driver
+// availability. Other LocationServiceClient methods are not used by
+// TripService and panic.
+type fakeLocationClient struct {
+	locationpb.LocationServiceClient
+	drivers []int
+	// nearby overrides what the search reports about a driver, such as their
+	// distance and heading.
+	nearby map[int32]*locationpb.Location
+	err    error
+	// searches records each search made.
+	searches []*locationpb.SearchLocationsRequest
+	// onTrip maps drivers to the trip they're on; availabilityErr fails
+	// marking and releasing.
+	onTrip          map[int32]int32
+	availabilityErr error
+	// onMark runs inside MarkDriverOnTrip, standing in for whatever else
+	// happens while trip-service waits on it.
+	onMark func()
+
+	mu        sync.Mutex
+	positions map[int32]*locationpb.Location
+}
+
+func (f *fakeLocationClient) MarkDriverOnTrip(ctx context.Context, in *locationpb.DriverTripRequest, opts ...grpc.CallOption) (*locationpb.DriverAvailabilityResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.onMark != nil {
+		f.onMark()
+	}
+	if f.availabilityErr != nil {
+		return nil, f.availabilityErr
+	}
+	if current, ok := f.onTrip[in.DriverId]; ok && current != in.TripId {
+		return nil, status.Errorf(codes.FailedPrecondition, "driver is on a trip: %d", current)
+	}
+	if f.onTrip == nil {
+		f.onTrip = make(map[int32]int32)
+	}
+	f.onTrip[in.DriverId] = in.TripId
+	return &locationpb.DriverAvailabilityResponse{Success: true}, nil
+}
+
+func (f *fakeLocationClient) ReleaseDriver(ctx context.Context, in *locationpb.DriverTripRequest, opts ...grpc.CallOption) (*locationpb.DriverAvailabilityResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.availabilityErr != nil {
+		return nil, f.availabilityErr
+	}
+	if f.onTrip[in.DriverId] == in.TripId {
+		delete(f.onTrip, in.DriverId)
+	}
+	return &locationpb.DriverAvailabilityResponse{Success: true}, nil
+}
+
+// driverTrip returns the trip driverID is on, or 0.
+func (f *fakeLocationClient) driverTrip(driverID int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return int(f.onTrip[int32(driverID)])
+}
+
+func (f *fakeLocationClient) setPosition(location *locationpb.Location) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.positions == nil {
+		f.positions = make(map[int32]*locationpb.Location)
+	}
+	f.positions[location.UserId] = location
+}
+
+func (f *fakeLocationClient) GetLocation(ctx context.Context, in *locationpb.GetLocationRequest, opts ...grpc.CallOption) (*locationpb.GetLocationResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	location, ok := f.positions[in.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "location not found")
+	}
+	return &locationpb.GetLocationResponse{Success: true, Location: location}, nil
+}
+
+// fakeUserClient answers GetVehiclesByUserId from vehicles, GetUserById from
+// users, GetSavedPlace from places and FilterBlockedUsers from blocks, and
+// keeps the latest offer stats pushed for each driver in offerStats.
+type fakeUserClient struct {
+	userpb.UserServiceClient
+	vehicles map[int32][]*userpb.Vehicle
+	users    map[int32]*userpb.User
+	places   map[int32]*userpb.SavedPlace
+	// blocks holds {blocker, blocked} pairs; blockErr fails FilterBlockedUsers.
+	blocks     [][2]int32
+	blockErr   error
+	credits    []*userpb.CreditDriverEarningRequest
+	offerStats map[int32]*userpb.DriverOfferStats
+	err        error
+}
+
+func (f *fakeUserClient) RecordDriverOfferStats(ctx context.Context, in *userpb.RecordDriverOfferStatsRequest, opts ...grpc.CallOption) (*userpb.RecordDriverOfferStatsResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.offerStats == nil {
+		f.offerStats = make(map[int32]*userpb.DriverOfferStats)
+	}
+	f.offerStats[in.Stats.DriverId] = in.Stats
+	return &userpb.RecordDriverOfferStatsResponse{Success: true, Applied: true}, nil
+}
+
+func (f *fakeUserClient) CreditDriverEarning(ctx context.Context, in *userpb.CreditDriverEarningRequest, opts ...grpc.CallOption) (*userpb.CreditDriverEarningResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, credit := range f.credits {
+		if credit.TripId == in.TripId && credit.Kind == in.Kind {
+			return &userpb.CreditDriverEarningResponse{Success: true}, nil
+		}
+	}
+	f.credits = append(f.credits, in)
+	return &userpb.CreditDriverEarningResponse{Success: true, Credited: true}, nil
+}
+
+func (f *fakeUserClient) GetUserById(ctx context.Context, in *userpb.GetUserByIdRequest, opts ...grpc.CallOption) (*userpb.GetUserByIdResponse, error) {
+	user, ok := f.users[in.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &userpb.GetUserByIdResponse{Success: true, User: user}, nil
+}
+
+func (f *fakeUserClient) GetSavedPlace(ctx context.Context, in *userpb.GetSavedPlaceRequest, opts ...grpc.CallOption) (*userpb.SavedPlaceResponse, error) {
+	place, ok := f.places[in.PlaceId]
+	if !ok || place.UserId != in.UserId {
+		return nil, status.Error(codes.NotFound, "saved place not found")
+	}
+	return &userpb.SavedPlaceResponse{Success: true, Place: place}, nil
+}
+
+func (f *fakeUserClient) FilterBlockedUsers(ctx context.Context, in *userpb.FilterBlockedUsersRequest, opts ...grpc.CallOption) (*userpb.FilterBlockedUsersResponse, error) {
+	if f.blockErr != nil {
+		return nil, f.blockErr
+	}
+	resp := &userpb.FilterBlockedUsersResponse{Success: true}
+	for _, id := range in.CandidateIds {
+		blocked := false
+		for _, pair := range f.blocks {
+			if pair == [2]int32{in.UserId, id} || pair == [2]int32{id, in.UserId} {
+				blocked = true
+			}
+		}
+		if !blocked {
+			resp.AllowedIds = append(resp.AllowedIds, id)
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeUserClient) GetVehiclesByUserId(ctx context.Context, in *userpb.GetVehiclesByUserIdRequest, opts ...grpc.CallOption) (*userpb.GetVehiclesByUserIdResponse, error) {
+	vehicles := f.vehicles[in.UserId]
+	return &userpb.GetVehiclesByUserIdResponse{Success: true, Vehicles: vehicles, TotalCount: int32(len(vehicles))}, nil
+}
+
+func (f *fakeLocationClient) SearchLocations(ctx context.Context, in *locationpb.SearchLocationsRequest, opts ...grpc.CallOption) (*locationpb.SearchLocationsResponse, error) {
+	f.searches = append(f.searches, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &locationpb.SearchLocationsResponse{Success: true}
+	for _, id := range f.drivers {
+		if location, ok := f.nearby[int32(id)]; ok {
+			resp.Locations = append(resp.Locations, location)
+			continue
+		}
+		resp.Locations = append(resp.Locations, &locationpb.Location{UserId: int32(id), Role: "driver"})
+	}
+	return resp, nil
+}
+
+// checkSearches fails t unless every search looked for drivers around the
+// trip's pickup point.
+func (f *fakeLocationClient) checkSearches(t *testing.T, trip models.Trip) {
+	t.Helper()
+	for _, in := range f.searches {
+		if in.Latitude != trip.OriginLat || in.Longitude != trip.OriginLng || in.Role != "driver" {
+			t.Errorf("searched %s around (%v, %v), want drivers around pickup (%v, %v)",
+				in.Role, in.Latitude, in.Longitude, trip.OriginLat, trip.OriginLng)
+		}
+	}
+}
+
+// fakePayments stands in for a payment gateway that charges cards, and
+// remembers the charges.
+type fakePayments struct {
+	charges []internal.Charge
+}
+
+func (f *fakePayments) Charge(ctx context.Context, charge internal.Charge) (string, error) {
+	if charge.Method != "card" {
+		return "", fmt.Errorf("%w: %s", internal.ErrPaymentMethodUnsupported, charge.Method)
+	}
+	f.charges = append(f.charges, charge)
+	return fmt.Sprintf("charge_%d", len(f.charges)), nil
+}
+
+// failingTx is the repository with a database whose transactions fail.
+type failingTx struct {
+	*repository.MemoryDBRepo
+}
+
+func (failingTx) WithTx(ctx context.Context, fn func(repo repository.DatabaseRepo) error) error {
+	return errors.New("database is down")
+}
+
+type fakeRoutes struct {
+	summary internal.RouteSummary
+	err     error
+}
+
+func (f fakeRoutes) GetRouteSummary(ctx context.Context, origin, destination string) (*internal.RouteSummary, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	summary := f.summary
+	return &summary, nil
+}
+
+const (
+	passengerID = 100
+	strangerID  = 999
+)
+
+var defaultRoute = internal.RouteSummary{Distance: 4200, Duration: 600, Fare: 21}
+
+type testEnv struct {
+	service  *TripService
+	repo     *repository.MemoryDBRepo
+	location *fakeLocationClient
+	users    *fakeUserClient
+	payments *fakePayments
+}
+
+func standardCar(driverID int) *userpb.Vehicle {
+	return &userpb.Vehicle{
+		VehicleId:    int32(driverID),
+		DriverId:     int32(driverID),
+		LicensePlate: "51A-000.00",
+		VehicleType:  "sedan",
+		Seats:        4,
+		Status:       "active",
+		VerifiedAt:   "2024-12-01T00:00:00Z",
+	}
+}
+
+// newTestEnv returns a TripService over an in-memory repository whose location
+// search finds drivers, in order of distance.
+func newTestEnv(t *testing.T, drivers ...int) *testEnv {
+	t.Helper()
+	tripMap = make(map[int][]int)
+	offeredAt = make(map[int]time.Time)
+	t.Cleanup(func() {
+		tripMap = make(map[int][]int)
+		offeredAt = make(map[int]time.Time)
+	})
+
+	repo := repository.NewMemoryDBRepo()
+	location := &fakeLocationClient{drivers: drivers}
+	// Every driver has an active, verified 4-seat car unless a test says
+	// otherwise.
+	users := &fakeUserClient{vehicles: make(map[int32][]*userpb.Vehicle)}
+	for _, id := range drivers {
+		users.vehicles[int32(id)] = []*userpb.Vehicle{standardCar(id)}
+	}
+	payments := &fakePayments{}
+	incidents := newIncidentRecorder(10 * time.Millisecond)
+	t.Cleanup(incidents.stopAll)
+	return &testEnv{
+		service: &TripService{
+			DB:          repo,
+			grpcClients: &GRPCClients{LocationClient: location, UserClient: users},
+			Routes:      fakeRoutes{summary: defaultRoute},
+			Payments:    payments,
+			Tips:        tipPolicy{Window: defaultTipWindow, MaxFareRatio: defaultTipMaxFareRatio},
+			Chat:        newChatHub(),
+			Incidents:   incidents,
+			Ranking:     defaultRankingWeights,
+			Offers:      offerPolicy{Timeout: defaultOfferTimeout, RateWindow: defaultOfferRateWindow},
+		},
+		repo:     repo,
+		location: location,
+		users:    users,
+		payments: payments,
+	}
+}
+
+func (e *testEnv) createTrip(t *testing.T) models.Trip {
+	t.Helper()
+	trip, _, err := e.service.CreateTrip(context.Background(), repository.NewTripDTO{
+		PassengerID:   passengerID,
+		OriginLat:     10.762622,
+		OriginLng:     106.660172,
+		DestLat:       10.776889,
+		DestLng:       106.700806,
+		PaymentMethod: "cash",
+	})
+	if err != nil {
+		t.Fatalf("CreateTrip: %v", err)
+	}
+	return trip
+}
+
+// setTrip moves a trip straight to status with driverID assigned, bypassing
+// the matching flow.
+func (e *testEnv) setTrip(t *testing.T, tripID int, status models.TripStatus, driverID int) {
+	t.Helper()
+	ctx := context.Background()
+	if driverID != 0 {
+		if err := e.repo.AcceptTrip(ctx, tripID, driverID); err != nil {
+			t.Fatalf("AcceptTrip: %v", err)
+		}
+	}
+	if err := e.repo.UpdateTripStatus(ctx, status, tripID); err != nil {
+		t.Fatalf("UpdateTripStatus: %v", err)
+	}
+}
+
+func (e *testEnv) getTrip(t *testing.T, tripID int) models.Trip {
+	t.Helper()
+	trip, err := e.repo.GetTrip(context.Background(), tripID)
+	if err != nil {
+		t.Fatalf("GetTrip: %v", err)
+	}
+	return trip
+}
+
+// eventNames lists the event types waiting in the outbox, oldest first.
+func (e *testEnv) eventNames(t *testing.T) []string {
+	t.Helper()
+	pending, err := e.repo.FetchPendingEvents(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("FetchPendingEvents: %v", err)
+	}
+	names := make([]string, 0, len(pending))
+	for _, event := range pending {
+		names = append(names, event.EventName)
+	}
+	return names
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCreateTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		drivers     []int
+		locationErr error
+		routeErr    error
+		blocks      [][2]int32
+		blockErr    error
+		wantErr     bool
+		wantQueue   []int
+		wantEvents  []string
+	}{
+		{
+			name:       "queues nearby drivers",
+			drivers:    []int{1, 2, 3},
+			wantQueue:  []int{1, 2, 3},
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:       "skips duplicate drivers",
+			drivers:    []int{1, 2, 1},
+			wantQueue:  []int{1, 2},
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:       "no drivers nearby",
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:       "skips drivers blocked either way",
+			drivers:    []int{1, 2, 3},
+			blocks:     [][2]int32{{passengerID, 2}, {3, passengerID}, {1, strangerID}},
+			wantQueue:  []int{1},
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:       "all nearby drivers blocked",
+			drivers:    []int{1},
+			blocks:     [][2]int32{{passengerID, 1}},
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:       "block list unavailable",
+			drivers:    []int{1, 2},
+			blockErr:   errors.New("unavailable"),
+			wantEvents: []string{"trip.requested"},
+		},
+		{
+			name:        "location service down",
+			locationErr: errors.New("unavailable"),
+			wantEvents:  []string{"trip.requested"},
+		},
+		{
+			name:       "route lookup fails",
+			drivers:    []int{1},
+			routeErr:   errors.New("HERE API error"),
+			wantErr:    true,
+			wantEvents: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, tt.drivers...)
+			env.location.err = tt.locationErr
+			env.users.blocks = tt.blocks
+			env.users.blockErr = tt.blockErr
+			env.service.Routes = fakeRoutes{summary: defaultRoute, err: tt.routeErr}
+
+			trip, duration, err := env.service.CreateTrip(context.Background(), repository.NewTripDTO{
+				PassengerID:   passengerID,
+				OriginLat:     10.762622,
+				OriginLng:     106.660172,
+				PaymentMethod: "cash",
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("CreateTrip succeeded, want error")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("CreateTrip: %v", err)
+				}
+				if trip.Status != models.StatusRequested || trip.PassengerID != passengerID {
+					t.Errorf("trip = %+v, want REQUESTED trip for passenger %d", trip, passengerID)
+				}
+				if trip.Distance != defaultRoute.Distance || trip.Fare != defaultRoute.Fare || duration != defaultRoute.Duration {
+					t.Errorf("distance, fare, duration = %v, %v, %v, want route summary %+v", trip.Distance, trip.Fare, duration, defaultRoute)
+				}
+				if !equalInts(tripMap[trip.ID], tt.wantQueue) {
+					t.Errorf("driver queue = %v, want %v", tripMap[trip.ID], tt.wantQueue)
+				}
+				env.location.checkSearches(t, trip)
+			}
+			if names := env.eventNames(t); !equalStrings(names, tt.wantEvents) {
+				t.Errorf("outbox events = %v, want %v", names, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestGetSuggestedDriver(t *testing.T) {
+	tests := []struct {
+		name    string
+		drivers []int
+		setup   func(t *testing.T, env *testEnv, tripID int)
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "nearest driver first",
+			drivers: []int{7, 8},
+			want:    7,
+		},
+		{
+			name:    "searches again when the queue is empty",
+			drivers: []int{7, 8},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				delete(tripMap, tripID)
+				env.location.searches = nil
+			},
+			want: 7,
+		},
+		{
+			name:    "no drivers",
+			wantErr: true,
+		},
+		{
+			name:    "trip already accepted",
+			drivers: []int{7},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.setTrip(t, tripID, models.StatusAccepted, 7)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown trip",
+			drivers: []int{7},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				delete(tripMap, tripID)
+				env.service.DB = repository.NewMemoryDBRepo()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, tt.drivers...)
+			trip := env.createTrip(t)
+			if tt.setup != nil {
+				tt.setup(t, env, trip.ID)
+			}
+
+			got, err := env.service.GetSuggestedDriver(context.Background(), trip.ID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GetSuggestedDriver = %d, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetSuggestedDriver: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GetSuggestedDriver = %d, want %d", got, tt.want)
+			}
+			env.location.checkSearches(t, trip)
+		})
+	}
+}
+
+func TestAcceptTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		drivers  []int
+		setup    func(t *testing.T, env *testEnv, tripID int)
+		driverID int
+		wantErr  bool
+	}{
+		{
+			name:     "suggested driver",
+			drivers:  []int{1, 2},
+			driverID: 1,
+		},
+		{
+			name:     "driver further down the queue",
+			drivers:  []int{1, 2},
+			driverID: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "driver not in the queue",
+			drivers:  []int{1, 2},
+			driverID: strangerID,
+			wantErr:  true,
+		},
+		{
+			name:     "no drivers found",
+			driverID: 1,
+			wantErr:  true,
+		},
+		{
+			name:    "trip cancelled",
+			drivers: []int{1},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.setTrip(t, tripID, models.StatusCancelled, 0)
+			},
+			driverID: 1,
+			wantErr:  true,
+		},
+		{
+			name:    "driver already on another trip",
+			drivers: []int{1},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.location.onTrip = map[int32]int32{1: int32(tripID + 1)}
+			},
+			driverID: 1,
+			wantErr:  true,
+		},
+		{
+			name:    "offer expires while marking the driver on trip",
+			drivers: []int{1, 2},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.location.onMark = func() {
+					offeredAt[tripID] = time.Now().Add(-defaultOfferTimeout - time.Second)
+					env.service.recordExpired(context.Background(), env.service.popExpired())
+				}
+			},
+			driverID: 1,
+			wantErr:  true,
+		},
+		{
+			name:    "database fails",
+			drivers: []int{1, 2},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.service.DB = failingTx{env.repo}
+			},
+			driverID: 1,
+			wantErr:  true,
+		},
+		{
+			// The trip record decides who drives; availability catches up
+			// when the driver next goes online.
+			name:    "availability unavailable",
+			drivers: []int{1},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.location.availabilityErr = status.Error(codes.Unavailable, "location-service down")
+			},
+			driverID: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, tt.drivers...)
+			trip := env.createTrip(t)
+			if tt.setup != nil {
+				tt.setup(t, env, trip.ID)
+			}
+			before := env.getTrip(t, trip.ID)
+
+			err := env.service.AcceptTrip(context.Background(), tt.driverID, trip.ID)
+			after := env.getTrip(t, trip.ID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("AcceptTrip succeeded, want error")
+				}
+				if after.Status != before.Status || after.DriverID != before.DriverID {
+					t.Errorf("failed accept changed trip from %s/%v to %s/%v", before.Status, before.DriverID, after.Status, after.DriverID)
+				}
+				if env.location.driverTrip(tt.driverID) == trip.ID {
+					t.Errorf("failed accept left driver %d on trip %d", tt.driverID, trip.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AcceptTrip: %v", err)
+			}
+			if env.location.availabilityErr == nil && env.location.driverTrip(tt.driverID) != trip.ID {
+				t.Errorf("driver %d is on trip %d, want %d", tt.driverID, env.location.driverTrip(tt.driverID), trip.ID)
+			}
+			if after.Status != models.StatusAccepted || !after.DriverID.Valid || int(after.DriverID.Int32) != tt.driverID {
+				t.Errorf("trip = %s/%v, want ACCEPTED by driver %d", after.Status, after.DriverID, tt.driverID)
+			}
+			if _, ok := tripMap[trip.ID]; ok {
+				t.Errorf("driver queue for accepted trip = %v, want removed", tripMap[trip.ID])
+			}
+			want := []string{"trip.requested", "trip.accepted"}
+			if names := env.eventNames(t); !equalStrings(names, want) {
+				t.Errorf("outbox events = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestRejectTrip(t *testing.T) {
+	tests := []struct {
+		name        string
+		drivers     []int
+		setup       func(t *testing.T, env *testEnv, tripID int)
+		driverID    int
+		passengerID int
+		wantErr     bool
+		wantQueue   []int
+	}{
+		{
+			name:      "suggested driver passes to the next one",
+			drivers:   []int{1, 2, 3},
+			driverID:  1,
+			wantQueue: []int{2, 3},
+		},
+		{
+			name:        "client still sends the passenger",
+			drivers:     []int{1, 2},
+			driverID:    1,
+			passengerID: passengerID,
+			wantQueue:   []int{2},
+		},
+		{
+			name:        "passenger of another trip",
+			drivers:     []int{1, 2},
+			driverID:    1,
+			passengerID: strangerID,
+			wantErr:     true,
+			wantQueue:   []int{1, 2},
+		},
+		{
+			name:      "last driver empties the queue",
+			drivers:   []int{1},
+			driverID:  1,
+			wantQueue: []int{},
+		},
+		{
+			name:      "driver who was not suggested",
+			drivers:   []int{1, 2},
+			driverID:  2,
+			wantErr:   true,
+			wantQueue: []int{1, 2},
+		},
+		{
+			name:      "no drivers found",
+			driverID:  1,
+			wantErr:   true,
+			wantQueue: []int{},
+		},
+		{
+			name:    "trip already accepted",
+			drivers: []int{1, 2},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.setTrip(t, tripID, models.StatusAccepted, 1)
+			},
+			driverID:  1,
+			wantErr:   true,
+			wantQueue: []int{1, 2},
+		},
+		{
+			// The driver stays suggested so they can answer again.
+			name:    "database fails",
+			drivers: []int{1, 2},
+			setup: func(t *testing.T, env *testEnv, tripID int) {
+				env.service.DB = failingTx{env.repo}
+			},
+			driverID:  1,
+			wantErr:   true,
+			wantQueue: []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, tt.drivers...)
+			trip := env.createTrip(t)
+			if tt.setup != nil {
+				tt.setup(t, env, trip.ID)
+			}
+
+			err := env.service.RejectTrip(context.Background(), tt.driverID, tt.passengerID, trip.ID)
+			if tt.wantErr && err == nil {
+				t.Fatal("RejectTrip succeeded, want error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("RejectTrip: %v", err)
+			}
+			if !equalInts(tripMap[trip.ID], tt.wantQueue) {
+				t.Errorf("driver queue = %v, want %v", tripMap[trip.ID], tt.wantQueue)
+			}
+			want := []string{"trip.requested"}
+			if !tt.wantErr {
+				want = append(want, "trip.rejected")
+			}
+			if names := env.eventNames(t); !equalStrings(names, want) {
+				t.Errorf("outbox events = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestRejectThenAccept(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+
+	if err := env.service.RejectTrip(ctx, 1, 0, trip.ID); err != nil {
+		t.Fatalf("RejectTrip: %v", err)
+	}
+	if err := env.service.AcceptTrip(ctx, 1, trip.ID); err == nil {
+		t.Error("driver who rejected the trip could still accept it")
+	}
+	if got, err := env.service.GetSuggestedDriver(ctx, trip.ID); err != nil || got != 2 {
+		t.Fatalf("GetSuggestedDriver = %d, %v, want 2", got, err)
+	}
+	if err := env.service.AcceptTrip(ctx, 2, trip.ID); err != nil {
+		t.Fatalf("AcceptTrip: %v", err)
+	}
+	if record := env.getTrip(t, trip.ID); int(record.DriverID.Int32) != 2 {
+		t.Errorf("driver = %v, want 2", record.DriverID)
+	}
+}
+
+func TestTripStatusFromProto(t *testing.T) {
+	tests := []struct {
+		in   pb.TripStatus
+		want models.TripStatus
+		ok   bool
+	}{
+		{in: pb.TripStatus_STARTED, want: models.StatusStarted, ok: true},
+		{in: pb.TripStatus_CANCELLED, want: models.StatusCancelled, ok: true},
+		{in: pb.TripStatus_STATUS_UNKNOWN},
+		{in: pb.TripStatus(42)},
+	}
+	for _, tt := range tests {
+		got, ok := tripStatusFromProto(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("tripStatusFromProto(%v) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestUpdateTripStatus(t *testing.T) {
+	const driverID = 1
+	tests := []struct {
+		name     string
+		assign   bool
+		driverID int
+		status   models.TripStatus
+		wantErr  bool
+	}{
+		{name: "assigned driver starts the trip", assign: true, driverID: driverID, status: models.StatusStarted},
+		{name: "assigned driver completes the trip", assign: true, driverID: driverID, status: models.StatusCompleted},
+		{name: "another driver", assign: true, driverID: strangerID, status: models.StatusStarted, wantErr: true},
+		{name: "passenger", assign: true, driverID: passengerID, status: models.StatusCompleted, wantErr: true},
+		{name: "no driver assigned yet", driverID: driverID, status: models.StatusStarted, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, driverID)
+			trip := env.createTrip(t)
+			if tt.assign {
+				env.setTrip(t, trip.ID, models.StatusAccepted, driverID)
+			}
+			before := env.getTrip(t, trip.ID)
+
+			err := env.service.UpdateTripStatus(context.Background(), tt.status, trip.ID, tt.driverID)
+			after := env.getTrip(t, trip.ID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("UpdateTripStatus succeeded, want error")
+				}
+				if after.Status != before.Status {
+					t.Errorf("status = %s, want unchanged %s", after.Status, before.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateTripStatus: %v", err)
+			}
+			if after.Status != tt.status {
+				t.Errorf("status = %s, want %s", after.Status, tt.status)
+			}
+			want := []string{"trip.requested", "trip.status_changed"}
+			if names := env.eventNames(t); !equalStrings(names, want) {
+				t.Errorf("outbox events = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestCancelTrip(t *testing.T) {
+	const driverID = 1
+	tests := []struct {
+		name    string
+		status  models.TripStatus
+		assign  bool
+		userID  int
+		wantErr error
+	}{
+		{name: "passenger cancels a request", status: models.StatusRequested, userID: passengerID},
+		{name: "passenger cancels an accepted trip", status: models.StatusAccepted, assign: true, userID: passengerID},
+		{name: "assigned driver cancels", status: models.StatusStarted, assign: true, userID: driverID},
+		{name: "suggested driver who has not accepted", status: models.StatusRequested, userID: driverID, wantErr: ErrCancelNotAllowed},
+		{name: "unrelated user", status: models.StatusAccepted, assign: true, userID: strangerID, wantErr: ErrCancelNotAllowed},
+		{name: "completed trip", status: models.StatusCompleted, assign: true, userID: passengerID, wantErr: ErrTripNotCancellable},
+		{name: "already cancelled", status: models.StatusCancelled, userID: passengerID, wantErr: ErrTripNotCancellable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, driverID)
+			trip := env.createTrip(t)
+			assigned := 0
+			if tt.assign {
+				assigned = driverID
+			}
+			env.setTrip(t, trip.ID, tt.status, assigned)
+			if tt.assign {
+				env.location.onTrip = map[int32]int32{driverID: int32(trip.ID)}
+			}
+
+			err := env.service.CancelTrip(context.Background(), tt.userID, trip.ID)
+			after := env.getTrip(t, trip.ID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CancelTrip err = %v, want %v", err, tt.wantErr)
+				}
+				if after.Status != tt.status {
+					t.Errorf("status = %s, want unchanged %s", after.Status, tt.status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CancelTrip: %v", err)
+			}
+			if after.Status != models.StatusCancelled || after.CancelByUserID != (sql.NullInt64{Int64: int64(tt.userID), Valid: true}) {
+				t.Errorf("trip = %s cancelled by %v, want CANCELLED by %d", after.Status, after.CancelByUserID, tt.userID)
+			}
+			if _, ok := tripMap[trip.ID]; ok {
+				t.Errorf("driver queue for cancelled trip = %v, want removed", tripMap[trip.ID])
+			}
+			if onTrip := env.location.driverTrip(driverID); onTrip != 0 {
+				t.Errorf("driver still on trip %d after cancelling", onTrip)
+			}
+			want := []string{"trip.requested", "trip.cancelled"}
+			if names := env.eventNames(t); !equalStrings(names, want) {
+				t.Errorf("outbox events = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestReviewTrip(t *testing.T) {
+	const driverID = 1
+	review := repository.ReviewDTO{Rating: 5, Comment: "smooth ride"}
+	tests := []struct {
+		name    string
+		status  models.TripStatus
+		userID  int
+		wantErr error
+	}{
+		{name: "passenger reviews a completed trip", status: models.StatusCompleted, userID: passengerID},
+		{name: "trip still in progress", status: models.StatusStarted, userID: passengerID, wantErr: ErrTripNotReviewable},
+		{name: "cancelled trip", status: models.StatusCancelled, userID: passengerID, wantErr: ErrTripNotReviewable},
+		{name: "driver reviews", status: models.StatusCompleted, userID: driverID, wantErr: ErrReviewNotAllowed},
+		{name: "unrelated user", status: models.StatusCompleted, userID: strangerID, wantErr: ErrReviewNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, driverID)
+			ctx := context.Background()
+			trip := env.createTrip(t)
+			env.setTrip(t, trip.ID, tt.status, driverID)
+
+			err := env.service.ReviewTrip(ctx, tt.userID, trip.ID, review)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ReviewTrip err = %v, want %v", err, tt.wantErr)
+				}
+				if _, err := env.service.GetReview(ctx, trip.ID, passengerID); err == nil {
+					t.Error("GetReview found a review after a rejected ReviewTrip")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReviewTrip: %v", err)
+			}
+			got, err := env.service.GetReview(ctx, trip.ID, passengerID)
+			if err != nil {
+				t.Fatalf("GetReview: %v", err)
+			}
+			if got.Rating != review.Rating || got.Comment != review.Comment || got.PassengerID != passengerID {
+				t.Errorf("GetReview = %+v, want %+v from passenger %d", got, review, passengerID)
+			}
+			if _, err := env.service.GetReview(ctx, trip.ID, strangerID); err == nil {
+				t.Error("GetReview by an unrelated user succeeded, want error")
+			}
+			want := []string{"trip.requested", "trip.reviewed"}
+			if names := env.eventNames(t); !equalStrings(names, want) {
+				t.Errorf("outbox events = %v, want %v", names, want)
+			}
+		})
+	}
+}
+
+func TestGetTripAuthorization(t *testing.T) {
+	const driverID = 1
+	tests := []struct {
+		name    string
+		assign  bool
+		userID  int
+		wantErr bool
+	}{
+		{name: "passenger", userID: passengerID},
+		{name: "assigned driver", assign: true, userID: driverID},
+		{name: "suggested driver before accepting", userID: driverID, wantErr: true},
+		{name: "unrelated user", assign: true, userID: strangerID, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, driverID)
+			trip := env.createTrip(t)
+			if tt.assign {
+				env.setTrip(t, trip.ID, models.StatusAccepted, driverID)
+			}
+
+			got, err := env.service.GetTrip(context.Background(), tt.userID, trip.ID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GetTrip = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTrip: %v", err)
+			}
+			if got.ID != trip.ID {
+				t.Errorf("GetTrip returned trip %d, want %d", got.ID, trip.ID)
+			}
+		})
+	}
+}
+
+func TestTripLifecycle(t *testing.T) {
+	env := newTestEnv(t, 1, 2)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+
+	suggested, err := env.service.GetSuggestedDriver(ctx, trip.ID)
+	if err != nil {
+		t.Fatalf("GetSuggestedDriver: %v", err)
+	}
+	if err := env.service.AcceptTrip(ctx, suggested, trip.ID); err != nil {
+		t.Fatalf("AcceptTrip: %v", err)
+	}
+	for _, status := range []models.TripStatus{models.StatusStarted, models.StatusCompleted} {
+		if err := env.service.UpdateTripStatus(ctx, status, trip.ID, suggested); err != nil {
+			t.Fatalf("UpdateTripStatus(%s): %v", status, err)
+		}
+	}
+	if onTrip := env.location.driverTrip(suggested); onTrip != 0 {
+		t.Errorf("driver %d still on trip %d after completing it", suggested, onTrip)
+	}
+	if err := env.service.ReviewTrip(ctx, passengerID, trip.ID, repository.ReviewDTO{Rating: 4}); err != nil {
+		t.Fatalf("ReviewTrip: %v", err)
+	}
+	if err := env.service.CancelTrip(ctx, passengerID, trip.ID); err == nil {
+		t.Error("CancelTrip on a completed trip succeeded, want error")
+	}
+
+	byDriver, err := env.service.GetTripsByDriver(ctx, suggested)
+	if err != nil || len(byDriver) != 1 || byDriver[0].ID != trip.ID {
+		t.Errorf("GetTripsByDriver = %v, %v, want trip %d", byDriver, err, trip.ID)
+	}
+	want := []string{
+		"trip.requested",
+		"trip.accepted",
+		"trip.status_changed",
+		"trip.status_changed",
+		"trip.reviewed",
+		"trip.history_viewed",
+	}
+	if names := env.eventNames(t); !equalStrings(names, want) {
+		t.Errorf("outbox events = %v, want %v", names, want)
+	}
+}
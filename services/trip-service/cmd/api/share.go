@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultShareTTL = 4 * time.Hour
+	maxShareTTL     = 24 * time.Hour
+	// maxActiveShareLinks bounds how many usable links a trip can have.
+	maxActiveShareLinks = 10
+	// shareLookupTimeout bounds the driver, vehicle and location lookups of a
+	// share view; whatever doesn't arrive in time is left out.
+	shareLookupTimeout = 3 * time.Second
+	// shareETACacheTTL is how long a computed ETA is reused. Share views are
+	// polled by anonymous viewers and every fresh ETA is a routing call.
+	shareETACacheTTL = 30 * time.Second
+)
+
+var (
+	ErrShareNotFound   = errors.New("share link not found")
+	ErrShareExpired    = errors.New("share link has expired")
+	ErrShareNotAllowed = errors.New("only the trip's passenger can manage share links")
+	ErrShareTripEnded  = errors.New("trip has already ended")
+	ErrInvalidShare    = errors.New("invalid share link")
+)
+
+// newShareToken returns a random URL-safe token and the hash stored for it.
+func newShareToken() (string, string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashShareToken(token), nil
+}
+
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func tripEnded(record models.Trip) bool {
+	return record.Status == models.StatusCompleted || record.Status == models.StatusCancelled
+}
+
+// sharedTrip loads the trip and checks that userID is its passenger.
+func (trip *TripService) sharedTrip(ctx context.Context, tripID int, userID int) (models.Trip, error) {
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return models.Trip{}, err
+	}
+	if record.PassengerID != userID {
+		return models.Trip{}, ErrShareNotAllowed
+	}
+	return record, nil
+}
+
+// CreateShareLink mints a token for the trip's read-only live view. The link
+// lasts ttl (0 for the default, at most maxShareTTL) or until the trip ends,
+// whichever comes first. The token is returned once and never stored.
+func (trip *TripService) CreateShareLink(ctx context.Context, tripID int, userID int, ttl time.Duration) (models.ShareLink, string, error) {
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.CreateShareLink",
+		trace.WithAttributes(
+			attribute.Int("trip_id", tripID),
+			attribute.Int("user_id", userID),
+		),
+	)
+	defer span.End()
+
+	if ttl < 0 {
+		return models.ShareLink{}, "", fmt.Errorf("%w: ttl must not be negative", ErrInvalidShare)
+	}
+	if ttl == 0 {
+		ttl = defaultShareTTL
+	}
+	if ttl > maxShareTTL {
+		ttl = maxShareTTL
+	}
+	record, err := trip.sharedTrip(ctx, tripID, userID)
+	if err != nil {
+		return models.ShareLink{}, "", err
+	}
+	if tripEnded(record) {
+		return models.ShareLink{}, "", ErrShareTripEnded
+	}
+
+	now := time.Now()
+	links, err := trip.DB.GetShareLinks(ctx, tripID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get share links", "trip_id", tripID, "error", err)
+		return models.ShareLink{}, "", err
+	}
+	active := 0
+	for _, link := range links {
+		if link.Active(now) {
+			active++
+		}
+	}
+	if active >= maxActiveShareLinks {
+		return models.ShareLink{}, "", fmt.Errorf("%w: at most %d active links per trip", ErrInvalidShare, maxActiveShareLinks)
+	}
+
+	token, tokenHash, err := newShareToken()
+	if err != nil {
+		return models.ShareLink{}, "", err
+	}
+	link, err := trip.DB.CreateShareLink(ctx, models.ShareLink{
+		TripID:    tripID,
+		CreatedBy: userID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to create share link", "trip_id", tripID, "error", err)
+		span.RecordError(err)
+		return models.ShareLink{}, "", err
+	}
+	span.SetAttributes(attribute.Int64("share_id", link.ID))
+	return link, token, nil
+}
+
+// ListShareLinks returns every link of the trip, revoked and expired ones
+// included.
+func (trip *TripService) ListShareLinks(ctx context.Context, tripID int, userID int) ([]models.ShareLink, error) {
+	if _, err := trip.sharedTrip(ctx, tripID, userID); err != nil {
+		return nil, err
+	}
+	links, err := trip.DB.GetShareLinks(ctx, tripID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get share links", "trip_id", tripID, "error", err)
+		return nil, err
+	}
+	return links, nil
+}
+
+func (trip *TripService) RevokeShareLink(ctx context.Context, tripID int, userID int, shareID int64) error {
+	if _, err := trip.sharedTrip(ctx, tripID, userID); err != nil {
+		return err
+	}
+	revoked, err := trip.DB.RevokeShareLink(ctx, tripID, shareID, time.Now())
+	if err != nil {
+		logger.Error(ctx, "Failed to revoke share link", "trip_id", tripID, "share_id", shareID, "error", err)
+		return err
+	}
+	if !revoked {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// GetSharedTrip builds the public view for a share token: status, the
+// driver's first name, vehicle and live position, and an ETA to the pickup
+// (while the driver is on the way) or to the destination (once started).
+func (trip *TripService) GetSharedTrip(ctx context.Context, token string) (models.SharedTripView, error) {
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.GetSharedTrip")
+	defer span.End()
+
+	if token == "" {
+		return models.SharedTripView{}, ErrShareNotFound
+	}
+	link, err := trip.DB.GetShareLinkByTokenHash(ctx, hashShareToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.SharedTripView{}, ErrShareNotFound
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to look up share link", "error", err)
+		return models.SharedTripView{}, err
+	}
+	span.SetAttributes(attribute.Int("trip_id", link.TripID), attribute.Int64("share_id", link.ID))
+	if !link.Active(time.Now()) {
+		return models.SharedTripView{}, ErrShareExpired
+	}
+	record, err := trip.DB.GetTrip(ctx, link.TripID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get shared trip", "trip_id", link.TripID, "error", err)
+		return models.SharedTripView{}, err
+	}
+	// Links die with the trip.
+	if tripEnded(record) {
+		return models.SharedTripView{}, ErrShareExpired
+	}
+
+	view := models.SharedTripView{Status: record.Status, ExpiresAt: link.ExpiresAt}
+	if !record.DriverID.Valid {
+		return view, nil
+	}
+	driverID := int(record.DriverID.Int32)
+
+	lookupCtx, cancel := context.WithTimeout(ctx, shareLookupTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		resp, err := trip.grpcClients.GetUserByIdViaGRPC(lookupCtx, driverID)
+		if err != nil || resp.User == nil {
+			logger.Warn(ctx, "No driver name for share view", "trip_id", record.ID, "error", err)
+			return
+		}
+		view.DriverFirstName = resp.User.FirstName
+	}()
+	go func() {
+		defer wg.Done()
+		vehicle, err := trip.driverVehicle(lookupCtx, driverID)
+		if err != nil || vehicle == nil {
+			logger.Warn(ctx, "No vehicle for share view", "trip_id", record.ID, "error", err)
+			return
+		}
+		view.LicensePlate = vehicle.LicensePlate
+		view.VehicleType = vehicle.VehicleType
+	}()
+	go func() {
+		defer wg.Done()
+		resp, err := trip.grpcClients.GetLocationViaGRPC(lookupCtx, driverID)
+		if err != nil || resp.Location == nil {
+			return
+		}
+		view.DriverPosition = &models.SharedPosition{
+			Latitude:   resp.Location.Latitude,
+			Longitude:  resp.Location.Longitude,
+			Heading:    resp.Location.Heading,
+			ReportedAt: resp.Location.Timestamp,
+		}
+	}()
+	wg.Wait()
+
+	if view.DriverPosition != nil {
+		view.ETATarget = "destination"
+		targetLat, targetLng := record.DestLat, record.DestLng
+		if record.Status == models.StatusAccepted {
+			view.ETATarget = "pickup"
+			targetLat, targetLng = record.OriginLat, record.OriginLng
+		}
+		view.ETASeconds = trip.shareETA(ctx, record.ID, view.ETATarget, *view.DriverPosition, targetLat, targetLng)
+	}
+	return view, nil
+}
+
+type etaEntry struct {
+	seconds int
+	at      time.Time
+}
+
+// etaCache remembers recent ETAs per trip and target. The zero value is ready
+// to use.
+type etaCache struct {
+	mu      sync.Mutex
+	entries map[string]etaEntry
+}
+
+func (c *etaCache) get(key string, now time.Time) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || now.Sub(entry.at) > shareETACacheTTL {
+		return 0, false
+	}
+	return entry.seconds, true
+}
+
+func (c *etaCache) put(key string, seconds int, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]etaEntry)
+	}
+	// Drop stale entries now and then so finished trips don't pile up.
+	if len(c.entries) >= 1024 {
+		for k, entry := range c.entries {
+			if now.Sub(entry.at) > shareETACacheTTL {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = etaEntry{seconds: seconds, at: now}
+}
+
+// shareETA returns the driving time in seconds from the driver's position to
+// the target, or 0 if the route can't be computed.
+func (trip *TripService) shareETA(ctx context.Context, tripID int, target string, from models.SharedPosition, lat, lng float64) int {
+	key := fmt.Sprintf("%d:%s", tripID, target)
+	now := time.Now()
+	if seconds, ok := trip.shareETAs.get(key, now); ok {
+		return seconds
+	}
+	routeCtx, cancel := context.WithTimeout(ctx, shareLookupTimeout)
+	defer cancel()
+	summary, err := trip.Routes.GetRouteSummary(routeCtx,
+		fmt.Sprintf("%f,%f", from.Latitude, from.Longitude),
+		fmt.Sprintf("%f,%f", lat, lng),
+	)
+	if err != nil {
+		logger.Warn(ctx, "Failed to compute share ETA", "trip_id", tripID, "error", err)
+		return 0
+	}
+	seconds := int(summary.Duration)
+	trip.shareETAs.put(key, seconds, now)
+	return seconds
+}
+
+// driverVehicle returns the vehicle to show for a driver, or nil if they have
+// none. Trips don't record which vehicle was used, so a verified one wins.
+func (trip *TripService) driverVehicle(ctx context.Context, driverID int) (*userpb.Vehicle, error) {
+	resp, err := trip.grpcClients.GetVehiclesByUserIdViaGRPC(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Vehicles) == 0 {
+		return nil, nil
+	}
+	for _, vehicle := range resp.Vehicles {
+		if vehicleVerified(vehicle) {
+			return vehicle, nil
+		}
+	}
+	return resp.Vehicles[0], nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+
+	locationpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/location"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+)
+
+const shareDriverID = 8
+
+func TestCreateShareLinkRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  models.TripStatus
+		user    int
+		ttl     time.Duration
+		wantErr error
+	}{
+		{name: "stranger", status: models.StatusStarted, user: strangerID, wantErr: ErrShareNotAllowed},
+		{name: "driver", status: models.StatusStarted, user: shareDriverID, wantErr: ErrShareNotAllowed},
+		{name: "completed trip", status: models.StatusCompleted, user: passengerID, wantErr: ErrShareTripEnded},
+		{name: "negative ttl", status: models.StatusStarted, user: passengerID, ttl: -time.Minute, wantErr: ErrInvalidShare},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			trip := env.createTrip(t)
+			env.setTrip(t, trip.ID, tt.status, shareDriverID)
+
+			if _, _, err := env.service.CreateShareLink(context.Background(), trip.ID, tt.user, tt.ttl); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateShareLink error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetSharedTrip(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+
+	link, token, err := env.service.CreateShareLink(ctx, trip.ID, passengerID, 48*time.Hour)
+	if err != nil {
+		t.Fatalf("CreateShareLink: %v", err)
+	}
+	if token == "" || link.TokenHash == token {
+		t.Fatalf("token %q, stored hash %q", token, link.TokenHash)
+	}
+	if ttl := link.ExpiresAt.Sub(link.CreatedAt); ttl > maxShareTTL+time.Second {
+		t.Errorf("link lasts %v, want at most %v", ttl, maxShareTTL)
+	}
+
+	// Before a driver accepts there's only the status to show.
+	view, err := env.service.GetSharedTrip(ctx, token)
+	if err != nil || view.Status != models.StatusRequested || view.DriverPosition != nil || view.DriverFirstName != "" {
+		t.Fatalf("view = %+v, %v", view, err)
+	}
+
+	env.setTrip(t, trip.ID, models.StatusAccepted, shareDriverID)
+	env.users.users = map[int32]*userpb.User{
+		shareDriverID: {UserId: shareDriverID, FirstName: "Minh", LastName: "Nguyen", Email: "minh@example.com"},
+	}
+	env.users.vehicles = map[int32][]*userpb.Vehicle{
+		shareDriverID: {{VehicleId: 3, DriverId: shareDriverID, LicensePlate: "59C-456.78", VehicleType: "bike"}},
+	}
+	env.location.setPosition(&locationpb.Location{UserId: shareDriverID, Latitude: 10.75, Longitude: 106.65, Heading: "N", Timestamp: "2025-01-01T10:00:00Z"})
+
+	view, err = env.service.GetSharedTrip(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if view.Status != models.StatusAccepted || view.DriverFirstName != "Minh" || view.LicensePlate != "59C-456.78" || view.VehicleType != "bike" {
+		t.Errorf("view = %+v", view)
+	}
+	if view.DriverPosition == nil || view.DriverPosition.Latitude != 10.75 || view.DriverPosition.ReportedAt != "2025-01-01T10:00:00Z" {
+		t.Errorf("driver position = %+v", view.DriverPosition)
+	}
+	if view.ETATarget != "pickup" || view.ETASeconds != int(defaultRoute.Duration) {
+		t.Errorf("eta = %d to %q, want %v to pickup", view.ETASeconds, view.ETATarget, defaultRoute.Duration)
+	}
+
+	// The ETA is cached, so a routing outage doesn't show right away.
+	env.service.Routes = fakeRoutes{err: errors.New("routing down")}
+	if view, err := env.service.GetSharedTrip(ctx, token); err != nil || view.ETASeconds != int(defaultRoute.Duration) {
+		t.Errorf("cached eta = %d, %v", view.ETASeconds, err)
+	}
+	// A different target isn't cached and the ETA is simply left out.
+	env.setTrip(t, trip.ID, models.StatusStarted, shareDriverID)
+	if view, err := env.service.GetSharedTrip(ctx, token); err != nil || view.ETATarget != "destination" || view.ETASeconds != 0 {
+		t.Errorf("view without route = %+v, %v", view, err)
+	}
+
+	if _, err := env.service.GetSharedTrip(ctx, token+"x"); !errors.Is(err, ErrShareNotFound) {
+		t.Errorf("unknown token error = %v, want %v", err, ErrShareNotFound)
+	}
+
+	// The link dies with the trip.
+	if err := env.service.UpdateTripStatus(ctx, models.StatusCompleted, trip.ID, shareDriverID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.service.GetSharedTrip(ctx, token); !errors.Is(err, ErrShareExpired) {
+		t.Errorf("completed trip error = %v, want %v", err, ErrShareExpired)
+	}
+}
+
+func TestRevokeShareLink(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.createTrip(t)
+
+	link, token, err := env.service.CreateShareLink(ctx, trip.ID, passengerID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl := link.ExpiresAt.Sub(link.CreatedAt); ttl < defaultShareTTL-time.Second || ttl > defaultShareTTL+time.Second {
+		t.Errorf("default link lasts %v, want %v", ttl, defaultShareTTL)
+	}
+	if err := env.service.RevokeShareLink(ctx, trip.ID, strangerID, link.ID); !errors.Is(err, ErrShareNotAllowed) {
+		t.Errorf("stranger revoke error = %v, want %v", err, ErrShareNotAllowed)
+	}
+	if err := env.service.RevokeShareLink(ctx, trip.ID, passengerID, link.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.service.RevokeShareLink(ctx, trip.ID, passengerID, link.ID); !errors.Is(err, ErrShareNotFound) {
+		t.Errorf("second revoke error = %v, want %v", err, ErrShareNotFound)
+	}
+	if _, err := env.service.GetSharedTrip(ctx, token); !errors.Is(err, ErrShareExpired) {
+		t.Errorf("revoked link error = %v, want %v", err, ErrShareExpired)
+	}
+
+	links, err := env.service.ListShareLinks(ctx, trip.ID, passengerID)
+	if err != nil || len(links) != 1 || !links[0].RevokedAt.Valid {
+		t.Errorf("links = %+v, %v", links, err)
+	}
+
+	for i := 0; i < maxActiveShareLinks; i++ {
+		if _, _, err := env.service.CreateShareLink(ctx, trip.ID, passengerID, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, err := env.service.CreateShareLink(ctx, trip.ID, passengerID, 0); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("creating link %d error = %v, want %v", maxActiveShareLinks+1, err, ErrInvalidShare)
+	}
+}
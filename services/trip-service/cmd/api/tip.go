@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"trip-service/internal"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/env"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultTipWindow       = 24 * time.Hour
+	defaultTipMaxFareRatio = 0.5
+	// tipCreditBatchSize and tipCreditGrace bound the retry of credits that
+	// didn't reach user-service. The grace period leaves fresh tips to the
+	// request that created them.
+	tipCreditBatchSize = 100
+	tipCreditGrace     = time.Minute
+	tipEarningKind     = "tip"
+)
+
+var (
+	ErrInvalidTip         = errors.New("invalid tip")
+	ErrTipNotAllowed      = errors.New("only the trip's passenger can tip")
+	ErrTipWindowClosed    = errors.New("trip can no longer be tipped")
+	ErrTipExists          = errors.New("trip has already been tipped")
+	ErrTipNotChargeable   = errors.New("trip's payment method can't be charged for a tip")
+	ErrTipsUnavailable    = errors.New("tipping is unavailable until a payment provider is configured")
+	ErrReceiptUnavailable = errors.New("receipt is only available for completed trips")
+)
+
+// tipPolicy is how long after completion a trip can be tipped, and the
+// largest tip as a fraction of the fare.
+type tipPolicy struct {
+	Window       time.Duration
+	MaxFareRatio float64
+}
+
+// loadTipPolicy reads TIP_WINDOW and TIP_MAX_FARE_RATIO.
+func loadTipPolicy() tipPolicy {
+	policy := tipPolicy{Window: defaultTipWindow, MaxFareRatio: defaultTipMaxFareRatio}
+	if d, err := time.ParseDuration(env.Get("TIP_WINDOW", "")); err == nil && d > 0 {
+		policy.Window = d
+	}
+	if r, err := strconv.ParseFloat(env.Get("TIP_MAX_FARE_RATIO", ""), 64); err == nil && r > 0 {
+		policy.MaxFareRatio = r
+	}
+	return policy
+}
+
+// tipDeadline returns when the trip stops accepting tips, if it ever did.
+func (p tipPolicy) tipDeadline(record models.Trip) (time.Time, bool) {
+	if record.Status != models.StatusCompleted || !record.CompletedAt.Valid {
+		return time.Time{}, false
+	}
+	return record.CompletedAt.Time.Add(p.Window), true
+}
+
+// maxTip is the largest tip allowed for the fare, rounded down to the cent.
+func (p tipPolicy) maxTip(fare float64) float64 {
+	return math.Floor(fare*p.MaxFareRatio*100) / 100
+}
+
+// AddTip charges a tip to the trip's payment method and credits it in full to
+// the driver. A trip is tipped at most once, by its passenger, within the tip
+// window after completion. Without a payment provider nothing can be charged,
+// so tipping is refused rather than crediting money that was never taken.
+func (trip *TripService) AddTip(ctx context.Context, tripID int, passengerID int, amount float64) (models.Receipt, error) {
+	ctx, span := otel.Tracer("trip-service").Start(ctx, "TripService.AddTip",
+		trace.WithAttributes(
+			attribute.Int("trip_id", tripID),
+			attribute.Int("passenger_id", passengerID),
+			attribute.Float64("tip.amount", amount),
+		),
+	)
+	defer span.End()
+
+	if trip.Payments == nil {
+		return models.Receipt{}, ErrTipsUnavailable
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return models.Receipt{}, fmt.Errorf("%w: amount must be a number", ErrInvalidTip)
+	}
+	amount = math.Round(amount*100) / 100
+	if amount <= 0 {
+		return models.Receipt{}, fmt.Errorf("%w: amount must be at least 0.01", ErrInvalidTip)
+	}
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return models.Receipt{}, err
+	}
+	if record.PassengerID != passengerID {
+		return models.Receipt{}, ErrTipNotAllowed
+	}
+	deadline, ok := trip.Tips.tipDeadline(record)
+	if !ok || !record.DriverID.Valid || time.Now().After(deadline) {
+		return models.Receipt{}, ErrTipWindowClosed
+	}
+	if limit := trip.Tips.maxTip(record.Fare); amount > limit {
+		return models.Receipt{}, fmt.Errorf("%w: at most %.2f for this trip", ErrInvalidTip, limit)
+	}
+	if _, err := trip.DB.GetTip(ctx, tripID); err == nil {
+		return models.Receipt{}, ErrTipExists
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return models.Receipt{}, err
+	}
+
+	// The key makes a retried or racing tip charge only once.
+	chargeRef, err := trip.Payments.Charge(ctx, internal.Charge{
+		UserID:         passengerID,
+		Method:         record.PaymentMethod,
+		Amount:         amount,
+		Description:    fmt.Sprintf("Tip for trip %d", tripID),
+		IdempotencyKey: fmt.Sprintf("trip:%d:tip", tripID),
+	})
+	if errors.Is(err, internal.ErrPaymentMethodUnsupported) {
+		return models.Receipt{}, fmt.Errorf("%w: %s", ErrTipNotChargeable, record.PaymentMethod)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to charge tip", "trip_id", tripID, "error", err)
+		span.RecordError(err)
+		return models.Receipt{}, err
+	}
+
+	driverID := int(record.DriverID.Int32)
+	var tip models.Tip
+	err = trip.DB.WithTx(ctx, func(tx repository.DatabaseRepo) error {
+		var err error
+		tip, err = tx.CreateTip(ctx, models.Tip{
+			TripID:        tripID,
+			PassengerID:   passengerID,
+			DriverID:      driverID,
+			Amount:        amount,
+			PaymentMethod: record.PaymentMethod,
+			ChargeRef:     chargeRef,
+		})
+		if err != nil {
+			return err
+		}
+		return enqueueEvent(ctx, tx, events.UserActor(passengerID), events.TripSubject(tripID), events.TripTipped{
+			TripID:        tripID,
+			PassengerID:   passengerID,
+			DriverID:      driverID,
+			Amount:        amount,
+			PaymentMethod: record.PaymentMethod,
+		})
+	})
+	if err != nil {
+		if _, getErr := trip.DB.GetTip(ctx, tripID); getErr == nil {
+			return models.Receipt{}, ErrTipExists
+		}
+		logger.Error(ctx, "Failed to save tip", "trip_id", tripID, "charge_ref", chargeRef, "error", err)
+		span.RecordError(err)
+		return models.Receipt{}, err
+	}
+
+	// A failed credit is retried by creditPendingTips.
+	trip.creditTip(ctx, tip)
+	return trip.receipt(record, &tip), nil
+}
+
+// creditTip adds the tip to the driver's revenue and marks it credited.
+// user-service credits each trip's tip once, so repeating this is safe.
+func (trip *TripService) creditTip(ctx context.Context, tip models.Tip) bool {
+	if _, err := trip.grpcClients.CreditDriverEarningViaGRPC(ctx, tip.DriverID, tip.TripID, tipEarningKind, tip.Amount); err != nil {
+		logger.Warn(ctx, "Failed to credit tip to driver, will retry", "trip_id", tip.TripID, "driver_id", tip.DriverID, "error", err)
+		return false
+	}
+	if err := trip.DB.MarkTipCredited(ctx, tip.TripID, time.Now()); err != nil {
+		logger.Error(ctx, "Failed to mark tip credited", "trip_id", tip.TripID, "error", err)
+		return false
+	}
+	return true
+}
+
+// creditPendingTips retries tip credits that didn't go through, every
+// interval until ctx is cancelled.
+func (trip *TripService) creditPendingTips(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			trip.retryTipCredits(ctx)
+		}
+	}
+}
+
+func (trip *TripService) retryTipCredits(ctx context.Context) {
+	tips, err := trip.DB.GetUncreditedTips(ctx, time.Now().Add(-tipCreditGrace), tipCreditBatchSize)
+	if err != nil {
+		logger.Error("Failed to get uncredited tips", "error", err)
+		return
+	}
+	credited := 0
+	for _, tip := range tips {
+		if trip.creditTip(ctx, tip) {
+			credited++
+		}
+	}
+	if credited > 0 {
+		logger.Info("Credited pending tips", "credited", credited, "pending", len(tips)-credited)
+	}
+}
+
+// GetTripReceipt returns the receipt of a completed trip to its passenger or
+// driver.
+func (trip *TripService) GetTripReceipt(ctx context.Context, tripID int, userID int) (models.Receipt, error) {
+	record, err := trip.DB.GetTrip(ctx, tripID)
+	if err != nil {
+		return models.Receipt{}, err
+	}
+	if record.PassengerID != userID && (!record.DriverID.Valid || int(record.DriverID.Int32) != userID) {
+		return models.Receipt{}, ErrNotTripParticipant
+	}
+	if record.Status != models.StatusCompleted {
+		return models.Receipt{}, ErrReceiptUnavailable
+	}
+	tip, err := trip.DB.GetTip(ctx, tripID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return trip.receipt(record, nil), nil
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to get tip", "trip_id", tripID, "error", err)
+		return models.Receipt{}, err
+	}
+	return trip.receipt(record, &tip), nil
+}
+
+func (trip *TripService) receipt(record models.Trip, tip *models.Tip) models.Receipt {
+	receipt := models.Receipt{
+		TripID:        record.ID,
+		PassengerID:   record.PassengerID,
+		DriverID:      int(record.DriverID.Int32),
+		PaymentMethod: record.PaymentMethod,
+		Distance:      record.Distance,
+		Fare:          record.Fare,
+		Total:         record.Fare,
+		CompletedAt:   record.CompletedAt.Time,
+	}
+	if tip != nil {
+		receipt.Tip = tip.Amount
+		receipt.Total = math.Round((record.Fare+tip.Amount)*100) / 100
+		receipt.TippedAt = sql.NullTime{Time: tip.CreatedAt, Valid: true}
+		return receipt
+	}
+	if deadline, ok := trip.Tips.tipDeadline(record); ok && trip.Payments != nil && time.Now().Before(deadline) {
+		receipt.TipDeadline = sql.NullTime{Time: deadline, Valid: true}
+	}
+	return receipt
+}
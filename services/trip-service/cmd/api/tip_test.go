@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/events"
+)
+
+const tipDriverID = 9
+
+// completedTrip returns a trip paid with method that driver tipDriverID has
+// just completed. The default route makes its fare 21.
+func (e *testEnv) completedTrip(t *testing.T, method string) models.Trip {
+	t.Helper()
+	trip, _, err := e.service.CreateTrip(context.Background(), repository.NewTripDTO{
+		PassengerID:   passengerID,
+		OriginLat:     10.762622,
+		OriginLng:     106.660172,
+		DestLat:       10.776889,
+		DestLng:       106.700806,
+		PaymentMethod: method,
+	})
+	if err != nil {
+		t.Fatalf("CreateTrip: %v", err)
+	}
+	e.setTrip(t, trip.ID, models.StatusCompleted, tipDriverID)
+	return e.getTrip(t, trip.ID)
+}
+
+func TestAddTipRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		status  models.TripStatus
+		user    int
+		amount  float64
+		window  time.Duration
+		wantErr error
+	}{
+		{name: "driver", method: "card", user: tipDriverID, amount: 2, wantErr: ErrTipNotAllowed},
+		{name: "stranger", method: "card", user: strangerID, amount: 2, wantErr: ErrTipNotAllowed},
+		{name: "trip in progress", method: "card", status: models.StatusStarted, user: passengerID, amount: 2, wantErr: ErrTipWindowClosed},
+		{name: "window passed", method: "card", user: passengerID, amount: 2, window: time.Nanosecond, wantErr: ErrTipWindowClosed},
+		{name: "zero", method: "card", user: passengerID, amount: 0.001, wantErr: ErrInvalidTip},
+		{name: "negative", method: "card", user: passengerID, amount: -5, wantErr: ErrInvalidTip},
+		{name: "over half the fare", method: "card", user: passengerID, amount: 10.51, wantErr: ErrInvalidTip},
+		{name: "cash trip", method: "cash", user: passengerID, amount: 2, wantErr: ErrTipNotChargeable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			if tt.window != 0 {
+				env.service.Tips.Window = tt.window
+			}
+			trip := env.completedTrip(t, tt.method)
+			if tt.status != "" {
+				env.setTrip(t, trip.ID, tt.status, 0)
+			}
+
+			if _, err := env.service.AddTip(context.Background(), trip.ID, tt.user, tt.amount); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddTip error = %v, want %v", err, tt.wantErr)
+			}
+			if len(env.payments.charges) != 0 || len(env.users.credits) != 0 {
+				t.Errorf("rejected tip charged %v and credited %v", env.payments.charges, env.users.credits)
+			}
+		})
+	}
+}
+
+func TestAddTip(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.completedTrip(t, "card")
+
+	before, err := env.service.GetTripReceipt(ctx, trip.ID, passengerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.Tip != 0 || before.Total != trip.Fare || !before.TipDeadline.Valid || before.TippedAt.Valid {
+		t.Fatalf("receipt before tip = %+v", before)
+	}
+
+	receipt, err := env.service.AddTip(ctx, trip.ID, passengerID, 3.456)
+	if err != nil {
+		t.Fatalf("AddTip: %v", err)
+	}
+	if receipt.Fare != 21 || receipt.Tip != 3.46 || receipt.Total != 24.46 || !receipt.TippedAt.Valid || receipt.TipDeadline.Valid {
+		t.Errorf("receipt = %+v", receipt)
+	}
+	if len(env.payments.charges) != 1 || env.payments.charges[0].Amount != 3.46 || env.payments.charges[0].Method != "card" || env.payments.charges[0].UserID != passengerID {
+		t.Errorf("charges = %+v", env.payments.charges)
+	}
+	// The whole tip goes to the driver.
+	if len(env.users.credits) != 1 || env.users.credits[0].DriverId != tipDriverID || env.users.credits[0].Amount != 3.46 {
+		t.Errorf("credits = %+v", env.users.credits)
+	}
+	if tip, err := env.repo.GetTip(ctx, trip.ID); err != nil || !tip.CreditedAt.Valid || tip.ChargeRef == "" {
+		t.Errorf("stored tip = %+v, %v", tip, err)
+	}
+	names := env.eventNames(t)
+	if len(names) == 0 || names[len(names)-1] != events.TypeTripTipped {
+		t.Errorf("events = %v, want %s last", names, events.TypeTripTipped)
+	}
+
+	if _, err := env.service.AddTip(ctx, trip.ID, passengerID, 1); !errors.Is(err, ErrTipExists) {
+		t.Errorf("second AddTip error = %v, want %v", err, ErrTipExists)
+	}
+	// The driver sees the same receipt.
+	if got, err := env.service.GetTripReceipt(ctx, trip.ID, tipDriverID); err != nil || got.Tip != 3.46 || got.Total != 24.46 {
+		t.Errorf("driver receipt = %+v, %v", got, err)
+	}
+	if _, err := env.service.GetTripReceipt(ctx, trip.ID, strangerID); !errors.Is(err, ErrNotTripParticipant) {
+		t.Errorf("stranger receipt error = %v, want %v", err, ErrNotTripParticipant)
+	}
+}
+
+func TestAddTipWithoutPaymentProvider(t *testing.T) {
+	env := newTestEnv(t)
+	env.service.Payments = nil
+	ctx := context.Background()
+	trip := env.completedTrip(t, "card")
+
+	receipt, err := env.service.GetTripReceipt(ctx, trip.ID, passengerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.TipDeadline.Valid {
+		t.Errorf("receipt offers a tip deadline without a payment provider: %+v", receipt)
+	}
+	if _, err := env.service.AddTip(ctx, trip.ID, passengerID, 2); !errors.Is(err, ErrTipsUnavailable) {
+		t.Fatalf("AddTip error = %v, want %v", err, ErrTipsUnavailable)
+	}
+	if _, err := env.repo.GetTip(ctx, trip.ID); err == nil {
+		t.Error("tip stored without being charged")
+	}
+	if len(env.users.credits) != 0 {
+		t.Errorf("credited %+v for a tip never charged", env.users.credits)
+	}
+}
+
+func TestTipCreditRetry(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	trip := env.completedTrip(t, "card")
+
+	// user-service is down: the tip is kept and charged, the credit waits.
+	env.users.err = errors.New("user-service unavailable")
+	if _, err := env.service.AddTip(ctx, trip.ID, passengerID, 2); err != nil {
+		t.Fatalf("AddTip: %v", err)
+	}
+	if tip, err := env.repo.GetTip(ctx, trip.ID); err != nil || tip.CreditedAt.Valid {
+		t.Fatalf("stored tip = %+v, %v, want uncredited", tip, err)
+	}
+
+	// Tips still inside the grace period are left to their request.
+	env.users.err = nil
+	env.service.retryTipCredits(ctx)
+	if len(env.users.credits) != 0 {
+		t.Fatalf("credited a fresh tip: %+v", env.users.credits)
+	}
+	pending, err := env.repo.GetUncreditedTips(ctx, time.Now().Add(time.Hour), 10)
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("uncredited tips = %+v, %v", pending, err)
+	}
+	if !env.service.creditTip(ctx, pending[0]) {
+		t.Fatal("creditTip failed")
+	}
+	// Crediting again is a no-op on the user-service side.
+	env.service.creditTip(ctx, pending[0])
+	if len(env.users.credits) != 1 {
+		t.Errorf("credits = %+v, want one", env.users.credits)
+	}
+	if pending, _ := env.repo.GetUncreditedTips(ctx, time.Now().Add(time.Hour), 10); len(pending) != 0 {
+		t.Errorf("uncredited tips after credit = %+v", pending)
+	}
+}
+
+func TestGetTripReceiptUnavailable(t *testing.T) {
+	env := newTestEnv(t)
+	trip := env.createTrip(t)
+	env.setTrip(t, trip.ID, models.StatusStarted, tipDriverID)
+
+	if _, err := env.service.GetTripReceipt(context.Background(), trip.ID, passengerID); !errors.Is(err, ErrReceiptUnavailable) {
+		t.Errorf("GetTripReceipt error = %v, want %v", err, ErrReceiptUnavailable)
+	}
+}
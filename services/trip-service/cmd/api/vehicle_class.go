@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+)
+
+var ErrInvalidVehicleClass = errors.New("invalid vehicle class")
+
+// vehicleKind groups user-service's free-form vehicle types.
+type vehicleKind int
+
+const (
+	kindCar vehicleKind = iota
+	kindMotorbike
+	kindPremiumCar
+)
+
+// vehicleClass is what a class needs from the driver's vehicle, and its fare
+// relative to the route's standard 4-seat fare.
+type vehicleClass struct {
+	kinds     []vehicleKind
+	minSeats  int
+	fareRatio float64
+}
+
+var vehicleClasses = map[string]vehicleClass{
+	models.ClassMotorbike: {kinds: []vehicleKind{kindMotorbike}, minSeats: 1, fareRatio: 0.6},
+	models.ClassCar4:      {kinds: []vehicleKind{kindCar, kindPremiumCar}, minSeats: 4, fareRatio: 1},
+	models.ClassCar7:      {kinds: []vehicleKind{kindCar, kindPremiumCar}, minSeats: 7, fareRatio: 1.3},
+	models.ClassPremium:   {kinds: []vehicleKind{kindPremiumCar}, minSeats: 4, fareRatio: 1.8},
+}
+
+// lookupVehicleClass normalizes name, defaulting to a standard 4-seat car.
+func lookupVehicleClass(name string) (string, vehicleClass, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = models.DefaultVehicleClass
+	}
+	class, ok := vehicleClasses[name]
+	if !ok {
+		return "", vehicleClass{}, fmt.Errorf("%w: %q, must be motorbike, car_4, car_7 or premium", ErrInvalidVehicleClass, name)
+	}
+	return name, class, nil
+}
+
+func vehicleKindOf(vehicleType string) vehicleKind {
+	switch strings.ToLower(strings.TrimSpace(vehicleType)) {
+	case "motorbike", "motorcycle", "scooter", "bike":
+		return kindMotorbike
+	case "premium", "luxury":
+		return kindPremiumCar
+	}
+	return kindCar
+}
+
+// vehicleVerified reports whether the vehicle has been verified. user-service
+// sends the zero time for vehicles that haven't been.
+func vehicleVerified(vehicle *userpb.Vehicle) bool {
+	verifiedAt, err := time.Parse(time.RFC3339, vehicle.VerifiedAt)
+	return err == nil && !verifiedAt.IsZero()
+}
+
+// servedBy reports whether the vehicle can take a trip of the class: it must
+// be active, verified, of an accepted kind and have enough seats.
+func (c vehicleClass) servedBy(vehicle *userpb.Vehicle) bool {
+	if !strings.EqualFold(vehicle.Status, "active") || !vehicleVerified(vehicle) {
+		return false
+	}
+	if int(vehicle.Seats) < c.minSeats {
+		return false
+	}
+	kind := vehicleKindOf(vehicle.VehicleType)
+	for _, k := range c.kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// fare prices the class from the route's standard fare, rounded to the cent.
+func (c vehicleClass) fare(standardFare float64) float64 {
+	return math.Round(standardFare*c.fareRatio*100) / 100
+}
+
+// filterDriversByVehicle keeps the candidates who have a vehicle for the
+// class, in order. The candidates' vehicles are looked up in parallel; a
+// driver whose vehicles can't be looked up is left out.
+func (trip *TripService) filterDriversByVehicle(ctx context.Context, className string, candidates []int) []int {
+	_, class, err := lookupVehicleClass(className)
+	if err != nil {
+		logger.Error(ctx, "Trip has an unknown vehicle class", "vehicle_class", className)
+		return nil
+	}
+
+	served := make([]bool, len(candidates))
+	var wg sync.WaitGroup
+	for i, driverID := range candidates {
+		wg.Add(1)
+		go func(i int, driverID int) {
+			defer wg.Done()
+			resp, err := trip.grpcClients.GetVehiclesByUserIdViaGRPC(ctx, driverID)
+			if err != nil {
+				logger.Warn(ctx, "Failed to get driver vehicles, skipping driver", "driver_id", driverID, "error", err)
+				return
+			}
+			for _, vehicle := range resp.Vehicles {
+				if class.servedBy(vehicle) {
+					served[i] = true
+					return
+				}
+			}
+		}(i, driverID)
+	}
+	wg.Wait()
+
+	allowed := make([]int, 0, len(candidates))
+	for i, driverID := range candidates {
+		if served[i] {
+			allowed = append(allowed, driverID)
+		}
+	}
+	return allowed
+}
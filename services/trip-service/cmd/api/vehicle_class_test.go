@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"trip-service/internal/models"
+	"trip-service/internal/repository"
+
+	userpb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+)
+
+func TestVehicleClassServedBy(t *testing.T) {
+	vehicle := func(vehicleType string, seats int32, mutate ...func(v *userpb.Vehicle)) *userpb.Vehicle {
+		v := &userpb.Vehicle{VehicleType: vehicleType, Seats: seats, Status: "active", VerifiedAt: "2024-12-01T00:00:00Z"}
+		for _, m := range mutate {
+			m(v)
+		}
+		return v
+	}
+	unverified := func(v *userpb.Vehicle) { v.VerifiedAt = "0001-01-01T00:00:00Z" }
+	inactive := func(v *userpb.Vehicle) { v.Status = "inactive" }
+
+	tests := []struct {
+		class   string
+		vehicle *userpb.Vehicle
+		want    bool
+	}{
+		{models.ClassMotorbike, vehicle("Motorbike", 2), true},
+		{models.ClassMotorbike, vehicle("sedan", 4), false},
+		{models.ClassCar4, vehicle("sedan", 4), true},
+		{models.ClassCar4, vehicle("premium", 4), true},
+		{models.ClassCar4, vehicle("motorbike", 2), false},
+		{models.ClassCar4, vehicle("sedan", 4, unverified), false},
+		{models.ClassCar4, vehicle("sedan", 4, inactive), false},
+		{models.ClassCar4, vehicle("sedan", 4, func(v *userpb.Vehicle) { v.VerifiedAt = "" }), false},
+		{models.ClassCar7, vehicle("suv", 7), true},
+		{models.ClassCar7, vehicle("sedan", 4), false},
+		{models.ClassPremium, vehicle("luxury", 4), true},
+		{models.ClassPremium, vehicle("suv", 7), false},
+	}
+	for _, tt := range tests {
+		_, class, err := lookupVehicleClass(tt.class)
+		if err != nil {
+			t.Fatalf("lookupVehicleClass(%q): %v", tt.class, err)
+		}
+		if got := class.servedBy(tt.vehicle); got != tt.want {
+			t.Errorf("%s served by %+v = %v, want %v", tt.class, tt.vehicle, got, tt.want)
+		}
+	}
+}
+
+func TestLookupVehicleClass(t *testing.T) {
+	if name, _, err := lookupVehicleClass(""); err != nil || name != models.ClassCar4 {
+		t.Errorf("lookupVehicleClass(\"\") = %q, %v, want %q", name, err, models.ClassCar4)
+	}
+	if name, _, err := lookupVehicleClass(" Premium "); err != nil || name != models.ClassPremium {
+		t.Errorf("lookupVehicleClass(\" Premium \") = %q, %v, want %q", name, err, models.ClassPremium)
+	}
+	if _, _, err := lookupVehicleClass("helicopter"); !errors.Is(err, ErrInvalidVehicleClass) {
+		t.Errorf("lookupVehicleClass(\"helicopter\") error = %v, want ErrInvalidVehicleClass", err)
+	}
+}
+
+func TestCreateTripVehicleClass(t *testing.T) {
+	const bikeDriver, sedanDriver, suvDriver, unverifiedSUVDriver = 1, 2, 3, 4
+
+	tests := []struct {
+		class     string
+		wantClass string
+		wantFare  float64
+		wantQueue []int
+		wantErr   error
+	}{
+		{class: "", wantClass: models.ClassCar4, wantFare: 21, wantQueue: []int{sedanDriver, suvDriver}},
+		{class: models.ClassMotorbike, wantClass: models.ClassMotorbike, wantFare: 12.6, wantQueue: []int{bikeDriver}},
+		{class: models.ClassCar7, wantClass: models.ClassCar7, wantFare: 27.3, wantQueue: []int{suvDriver}},
+		{class: models.ClassPremium, wantClass: models.ClassPremium, wantFare: 37.8},
+		{class: "bus", wantErr: ErrInvalidVehicleClass},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantClass+tt.class, func(t *testing.T) {
+			env := newTestEnv(t, bikeDriver, sedanDriver, suvDriver, unverifiedSUVDriver)
+			env.users.vehicles[bikeDriver][0].VehicleType = "motorbike"
+			env.users.vehicles[bikeDriver][0].Seats = 2
+			env.users.vehicles[suvDriver][0].VehicleType = "suv"
+			env.users.vehicles[suvDriver][0].Seats = 7
+			env.users.vehicles[unverifiedSUVDriver] = []*userpb.Vehicle{
+				{VehicleType: "suv", Seats: 7, Status: "active", VerifiedAt: "0001-01-01T00:00:00Z"},
+			}
+
+			trip, _, err := env.service.CreateTrip(context.Background(), repository.NewTripDTO{
+				PassengerID:   passengerID,
+				PaymentMethod: "cash",
+				VehicleClass:  tt.class,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CreateTrip error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTrip: %v", err)
+			}
+			if trip.VehicleClass != tt.wantClass || trip.Fare != tt.wantFare {
+				t.Errorf("class, fare = %q, %v, want %q, %v", trip.VehicleClass, trip.Fare, tt.wantClass, tt.wantFare)
+			}
+			if !equalInts(tripMap[trip.ID], tt.wantQueue) {
+				t.Errorf("driver queue = %v, want %v", tripMap[trip.ID], tt.wantQueue)
+			}
+		})
+	}
+}
@@ -27,6 +27,18 @@ type RouteSummary struct {
 	Fare     float64
 }
 
+// RouteProvider computes the driving route between two "lat,lng" points.
+type RouteProvider interface {
+	GetRouteSummary(ctx context.Context, origin, destination string) (*RouteSummary, error)
+}
+
+// HereRouteProvider is the RouteProvider backed by the HERE routing API.
+type HereRouteProvider struct{}
+
+func (HereRouteProvider) GetRouteSummary(ctx context.Context, origin, destination string) (*RouteSummary, error) {
+	return GetRouteSummary(ctx, origin, destination)
+}
+
 func GetRouteSummary(ctx context.Context, origin, destination string) (*RouteSummary, error) {
 	token, err := utils.FetchHereToken()
 	if err != nil {
@@ -1,19 +1,17 @@
--- Create a new database
-CREATE DATABASE trips WITH OWNER = postgres ENCODING = 'UTF8';
+DO $$
+BEGIN
+  CREATE TYPE trip_status AS ENUM (
+    'REQUESTED',
+    'ACCEPTED',
+    'STARTED',
+    'COMPLETED',
+    'CANCELLED'
+  );
+EXCEPTION
+  WHEN duplicate_object THEN NULL;
+END
+$$;
 
--- Connect to the newly created database
-\connect trips;
-
--- Enum
-CREATE TYPE trip_status AS ENUM (
-  'REQUESTED',
-  'ACCEPTED',
-  'STARTED',
-  'COMPLETED',
-  'CANCELLED'
-);
-
--- Create table
 CREATE TABLE IF NOT EXISTS trips (
   id SERIAL PRIMARY KEY,
   passenger_id INT NOT NULL,
@@ -36,7 +34,6 @@ CREATE TABLE IF NOT EXISTS trips (
   cancel_by_user_id INT
 );
 
--- Indexes 
-CREATE INDEX idx_trips_passenger_id ON trips (passenger_id);
-CREATE INDEX idx_trips_driver_id ON trips (driver_id);
-CREATE INDEX idx_trips_status ON trips (status);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_trips_passenger_id ON trips (passenger_id);
+CREATE INDEX IF NOT EXISTS idx_trips_driver_id ON trips (driver_id);
+CREATE INDEX IF NOT EXISTS idx_trips_status ON trips (status);
@@ -0,0 +1,13 @@
+-- Stored responses for client idempotency keys (Idempotency-Key header)
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  user_id INT NOT NULL,
+  idempotency_key VARCHAR(255) NOT NULL,
+  operation VARCHAR(64) NOT NULL,
+  request_hash CHAR(64) NOT NULL,
+  response BYTEA,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  completed_at TIMESTAMP NULL,
+  PRIMARY KEY (user_id, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at);
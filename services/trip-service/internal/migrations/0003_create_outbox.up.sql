@@ -0,0 +1,14 @@
+-- Transactional outbox: events are written with the trip change and relayed to RabbitMQ
+CREATE TABLE IF NOT EXISTS outbox (
+  id BIGSERIAL PRIMARY KEY,
+  event_name VARCHAR(100) NOT NULL,
+  destination VARCHAR(255) NOT NULL,
+  payload BYTEA NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  sent_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (next_attempt_at, id) WHERE sent_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_trips_origin;
+DROP INDEX IF EXISTS idx_trips_created_at;
+ALTER TABLE trips DROP COLUMN IF EXISTS accepted_at;
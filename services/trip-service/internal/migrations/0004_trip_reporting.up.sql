@@ -0,0 +1,6 @@
+-- Reporting: record when a trip was accepted (wait-to-accept) and index the
+-- columns the aggregate queries filter on.
+ALTER TABLE trips ADD COLUMN IF NOT EXISTS accepted_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_trips_created_at ON trips (created_at);
+CREATE INDEX IF NOT EXISTS idx_trips_origin ON trips (origin_lat, origin_lng);
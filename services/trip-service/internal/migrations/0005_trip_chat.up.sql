@@ -0,0 +1,15 @@
+-- In-trip chat between passenger and driver. Receipts are per message so a
+-- client can show delivered/read ticks.
+CREATE TABLE IF NOT EXISTS trip_messages (
+  id BIGSERIAL PRIMARY KEY,
+  trip_id INT NOT NULL REFERENCES trips (id) ON DELETE CASCADE,
+  sender_id INT NOT NULL,
+  recipient_id INT NOT NULL,
+  body TEXT NOT NULL,
+  quick_reply VARCHAR(50),
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  delivered_at TIMESTAMP NULL,
+  read_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_messages_trip ON trip_messages (trip_id, id);
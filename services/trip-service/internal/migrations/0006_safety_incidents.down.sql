@@ -0,0 +1,3 @@
+ALTER TABLE outbox DROP COLUMN IF EXISTS priority;
+DROP TABLE IF EXISTS incident_track;
+DROP TABLE IF EXISTS incidents;
@@ -0,0 +1,39 @@
+-- SOS incidents. The snapshot holds the trip, both parties' last known
+-- locations and the vehicle as they were when SOS was pressed.
+CREATE TABLE IF NOT EXISTS incidents (
+  id BIGSERIAL PRIMARY KEY,
+  trip_id INT NOT NULL REFERENCES trips (id),
+  raised_by INT NOT NULL,
+  raised_by_role VARCHAR(20) NOT NULL,
+  note TEXT NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+  snapshot JSONB NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  closed_by INT NULL,
+  resolution TEXT NULL,
+  closed_at TIMESTAMP NULL
+);
+
+-- At most one open incident per trip; a repeated SOS joins it.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_incidents_open_trip ON incidents (trip_id) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_incidents_status ON incidents (status, id);
+
+-- Positions sampled while an incident is open. Every replica samples, so a
+-- device report is stored once per user and reported_at.
+CREATE TABLE IF NOT EXISTS incident_track (
+  id BIGSERIAL PRIMARY KEY,
+  incident_id BIGINT NOT NULL REFERENCES incidents (id) ON DELETE CASCADE,
+  user_id INT NOT NULL,
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  speed DOUBLE PRECISION NOT NULL DEFAULT 0,
+  heading VARCHAR(10) NOT NULL DEFAULT '',
+  reported_at VARCHAR(40) NOT NULL,
+  recorded_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  UNIQUE (incident_id, user_id, reported_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_incident_track_incident ON incident_track (incident_id, id);
+
+-- Safety events jump the outbox queue.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
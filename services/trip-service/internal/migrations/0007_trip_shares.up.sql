@@ -0,0 +1,13 @@
+-- Share links for the public live trip view. Only a SHA-256 of the token is
+-- stored, so a database dump can't be used to follow anyone's ride.
+CREATE TABLE IF NOT EXISTS trip_shares (
+  id BIGSERIAL PRIMARY KEY,
+  trip_id INT NOT NULL REFERENCES trips (id) ON DELETE CASCADE,
+  created_by INT NOT NULL,
+  token_hash CHAR(64) NOT NULL UNIQUE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMP NOT NULL,
+  revoked_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_shares_trip ON trip_shares (trip_id, id);
@@ -0,0 +1,15 @@
+-- One tip per trip, charged to the trip's payment method and credited in full
+-- to the driver. credited_at stays NULL until user-service has added the tip
+-- to the driver's revenue, so failed credits can be retried.
+CREATE TABLE IF NOT EXISTS trip_tips (
+  trip_id INT PRIMARY KEY REFERENCES trips (id) ON DELETE CASCADE,
+  passenger_id INT NOT NULL,
+  driver_id INT NOT NULL,
+  amount DOUBLE PRECISION NOT NULL CHECK (amount > 0),
+  payment_method VARCHAR(50) NOT NULL,
+  charge_ref VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  credited_at TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_tips_uncredited ON trip_tips (created_at) WHERE credited_at IS NULL;
@@ -0,0 +1,3 @@
+-- Vehicle class the passenger asked for. Trips created before classes existed
+-- were matched and priced as a standard 4-seat car.
+ALTER TABLE trips ADD COLUMN IF NOT EXISTS vehicle_class VARCHAR(20) NOT NULL DEFAULT 'car_4';
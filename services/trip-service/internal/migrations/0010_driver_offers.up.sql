@@ -0,0 +1,15 @@
+-- One row per decided trip offer. A driver who accepts and later cancels has
+-- both an accepted and a cancelled row for the trip. offered_at is when the
+-- offer reached the head of the trip's driver queue, or when it was accepted
+-- for a cancellation.
+CREATE TABLE IF NOT EXISTS driver_offers (
+  id BIGSERIAL PRIMARY KEY,
+  trip_id INT NOT NULL REFERENCES trips (id) ON DELETE CASCADE,
+  driver_id INT NOT NULL,
+  outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('accepted', 'rejected', 'timed_out', 'cancelled')),
+  offered_at TIMESTAMP NOT NULL,
+  decided_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_driver_offers_driver ON driver_offers (driver_id, decided_at);
+CREATE INDEX IF NOT EXISTS idx_driver_offers_decided_at ON driver_offers (decided_at);
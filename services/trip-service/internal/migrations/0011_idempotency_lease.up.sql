@@ -0,0 +1,5 @@
+-- An in-progress reservation only holds its key until locked_until; after that
+-- a retry takes it over, so a request that crashed mid-way doesn't block its
+-- key for the whole retention window. Reservations made before this column
+-- existed have no lease and can be taken over at once.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP NULL;
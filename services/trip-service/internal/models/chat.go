@@ -0,0 +1,41 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type ChatMessage struct {
+	ID          int64          `json:"id"`
+	TripID      int            `json:"trip_id"`
+	SenderID    int            `json:"sender_id"`
+	RecipientID int            `json:"recipient_id"`
+	Body        string         `json:"body"`
+	QuickReply  sql.NullString `json:"quick_reply,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	DeliveredAt sql.NullTime   `json:"delivered_at"`
+	ReadAt      sql.NullTime   `json:"read_at"`
+}
+
+type ChatReceiptKind string
+
+const (
+	ReceiptDelivered ChatReceiptKind = "DELIVERED"
+	ReceiptRead      ChatReceiptKind = "READ"
+)
+
+// ChatReceipt acknowledges every message addressed to RecipientID in a trip
+// up to and including UpToID.
+type ChatReceipt struct {
+	TripID      int             `json:"trip_id"`
+	RecipientID int             `json:"recipient_id"`
+	UpToID      int64           `json:"up_to_id"`
+	Kind        ChatReceiptKind `json:"kind"`
+	At          time.Time       `json:"at"`
+}
+
+// QuickReply is a canned chat message a participant can send with one tap.
+type QuickReply struct {
+	Key  string `json:"key"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "database/sql"
+
+// DriverStats is what a driver's trip history says about them, for matching.
+// AvgRating is over RatedTrips; LastTripEndedAt is when their most recent
+// trip was completed or cancelled.
+type DriverStats struct {
+	DriverID        int          `json:"driver_id"`
+	RatedTrips      int          `json:"rated_trips"`
+	AvgRating       float64      `json:"avg_rating"`
+	LastTripEndedAt sql.NullTime `json:"last_trip_ended_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of a mutating request, keyed by the
+// caller's user ID and the client-supplied idempotency key.
+// Response is nil while the original request is still being executed, which
+// holds the key until LockedUntil.
+type IdempotencyRecord struct {
+	UserID      int        `json:"user_id"`
+	Key         string     `json:"idempotency_key"`
+	Operation   string     `json:"operation"`
+	RequestHash string     `json:"request_hash"`
+	Response    []byte     `json:"response,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
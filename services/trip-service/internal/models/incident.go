@@ -0,0 +1,68 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+type IncidentStatus string
+
+const (
+	IncidentOpen   IncidentStatus = "OPEN"
+	IncidentClosed IncidentStatus = "CLOSED"
+)
+
+// PartyLocation is the last position location-service had for a trip
+// participant. Known is false when it had none.
+type PartyLocation struct {
+	UserID     int     `json:"user_id"`
+	Role       string  `json:"role"`
+	Known      bool    `json:"known"`
+	Latitude   float64 `json:"latitude,omitempty"`
+	Longitude  float64 `json:"longitude,omitempty"`
+	Speed      float64 `json:"speed,omitempty"`
+	Heading    string  `json:"heading,omitempty"`
+	ReportedAt string  `json:"reported_at,omitempty"`
+}
+
+type IncidentVehicle struct {
+	VehicleID    int    `json:"vehicle_id"`
+	LicensePlate string `json:"license_plate"`
+	VehicleType  string `json:"vehicle_type"`
+	Seats        int    `json:"seats"`
+}
+
+// IncidentSnapshot is what was known about the trip when SOS was raised. It
+// is stored as it was and never updated.
+type IncidentSnapshot struct {
+	Trip      Trip             `json:"trip"`
+	Locations []PartyLocation  `json:"locations"`
+	Vehicle   *IncidentVehicle `json:"vehicle,omitempty"`
+}
+
+type Incident struct {
+	ID           int64            `json:"id"`
+	TripID       int              `json:"trip_id"`
+	RaisedBy     int              `json:"raised_by"`
+	RaisedByRole string           `json:"raised_by_role"`
+	Note         string           `json:"note"`
+	Status       IncidentStatus   `json:"status"`
+	Snapshot     IncidentSnapshot `json:"snapshot"`
+	CreatedAt    time.Time        `json:"created_at"`
+	ClosedBy     sql.NullInt32    `json:"closed_by"`
+	Resolution   sql.NullString   `json:"resolution"`
+	ClosedAt     sql.NullTime     `json:"closed_at"`
+}
+
+// TrackPoint is a participant position sampled while an incident is open.
+type TrackPoint struct {
+	ID         int64     `json:"id"`
+	IncidentID int64     `json:"incident_id"`
+	UserID     int       `json:"user_id"`
+	Latitude   float64   `json:"latitude"`
+	Longitude  float64   `json:"longitude"`
+	Speed      float64   `json:"speed"`
+	Heading    string    `json:"heading"`
+	ReportedAt string    `json:"reported_at"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
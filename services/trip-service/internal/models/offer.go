@@ -0,0 +1,76 @@
+package models
+
+import "time"
+
+type OfferOutcome string
+
+const (
+	OfferAccepted  OfferOutcome = "accepted"
+	OfferRejected  OfferOutcome = "rejected"
+	OfferTimedOut  OfferOutcome = "timed_out"
+	OfferCancelled OfferOutcome = "cancelled"
+)
+
+// DriverOffer is how a driver answered a trip offered to them. Cancelled
+// records a driver cancelling a trip they had accepted; OfferedAt is then
+// when they accepted it.
+type DriverOffer struct {
+	ID        int64        `json:"id"`
+	TripID    int          `json:"trip_id"`
+	DriverID  int          `json:"driver_id"`
+	Outcome   OfferOutcome `json:"outcome"`
+	OfferedAt time.Time    `json:"offered_at"`
+	DecidedAt time.Time    `json:"decided_at"`
+}
+
+// DriverOfferStats counts a driver's offer outcomes, or everyone's in a
+// report summary. Offers is the number of offers answered or left to time
+// out; cancellations come on top of the acceptances they undo.
+type DriverOfferStats struct {
+	DriverID         int     `json:"driver_id,omitempty"`
+	Offers           int64   `json:"offers"`
+	Accepted         int64   `json:"accepted"`
+	Rejected         int64   `json:"rejected"`
+	TimedOut         int64   `json:"timed_out"`
+	Cancelled        int64   `json:"cancelled"`
+	AcceptanceRate   float64 `json:"acceptance_rate"`
+	CancellationRate float64 `json:"cancellation_rate"`
+}
+
+// Add counts one more outcome.
+func (s *DriverOfferStats) Add(outcome OfferOutcome) {
+	switch outcome {
+	case OfferAccepted:
+		s.Accepted++
+	case OfferRejected:
+		s.Rejected++
+	case OfferTimedOut:
+		s.TimedOut++
+	case OfferCancelled:
+		s.Cancelled++
+	}
+}
+
+// SetRates fills Offers, AcceptanceRate and CancellationRate from the counts.
+// The acceptance rate is over all offers, the cancellation rate over the
+// accepted ones.
+func (s *DriverOfferStats) SetRates() {
+	s.Offers = s.Accepted + s.Rejected + s.TimedOut
+	s.AcceptanceRate, s.CancellationRate = 0, 0
+	if s.Offers > 0 {
+		s.AcceptanceRate = float64(s.Accepted) / float64(s.Offers)
+	}
+	if s.Accepted > 0 {
+		// An acceptance from before the window can be cancelled inside it.
+		s.CancellationRate = min(float64(s.Cancelled)/float64(s.Accepted), 1)
+	}
+}
+
+// DriverOfferReport is the offer outcomes of the offers decided in [From, To),
+// with the drivers least likely to accept first.
+type DriverOfferReport struct {
+	From    time.Time          `json:"from"`
+	To      time.Time          `json:"to"`
+	Summary DriverOfferStats   `json:"summary"`
+	Drivers []DriverOfferStats `json:"drivers"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Outbox priorities. Higher priority events are relayed first and carry their
+// priority to the broker.
+const (
+	PriorityNormal uint8 = 0
+	PriorityHigh   uint8 = 9
+)
+
+// OutboxEvent is a domain event recorded in the same transaction as the trip
+// change that produced it, and later published to RabbitMQ by the outbox relay.
+type OutboxEvent struct {
+	ID            int64          `json:"id"`
+	EventName     string         `json:"event_name"`
+	Destination   string         `json:"destination"`
+	Payload       []byte         `json:"payload"`
+	Priority      uint8          `json:"priority"`
+	Attempts      int            `json:"attempts"`
+	LastError     sql.NullString `json:"last_error,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	NextAttemptAt time.Time      `json:"next_attempt_at"`
+	SentAt        sql.NullTime   `json:"sent_at"`
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+type ReportInterval string
+
+const (
+	IntervalHour ReportInterval = "hour"
+	IntervalDay  ReportInterval = "day"
+)
+
+// BoundingBox is an area in degrees. Reports place a trip by its origin.
+type BoundingBox struct {
+	MinLat float64 `json:"min_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLat float64 `json:"max_lat"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+func (b BoundingBox) Contains(lat, lng float64) bool {
+	return lat >= b.MinLat && lat <= b.MaxLat && lng >= b.MinLng && lng <= b.MaxLng
+}
+
+// TripStats aggregates a set of trips. Fare, distance and revenue only count
+// completed trips; the wait to accept only counts accepted ones.
+type TripStats struct {
+	Trips                  int64   `json:"trips"`
+	Completed              int64   `json:"completed"`
+	Cancelled              int64   `json:"cancelled"`
+	CompletionRate         float64 `json:"completion_rate"`
+	CancellationRate       float64 `json:"cancellation_rate"`
+	AvgWaitToAcceptSeconds float64 `json:"avg_wait_to_accept_seconds"`
+	AvgFare                float64 `json:"avg_fare"`
+	AvgDistance            float64 `json:"avg_distance"`
+	Revenue                float64 `json:"revenue"`
+}
+
+// SetRates fills CompletionRate and CancellationRate from the counts.
+func (s *TripStats) SetRates() {
+	if s.Trips == 0 {
+		s.CompletionRate, s.CancellationRate = 0, 0
+		return
+	}
+	s.CompletionRate = float64(s.Completed) / float64(s.Trips)
+	s.CancellationRate = float64(s.Cancelled) / float64(s.Trips)
+}
+
+type TripReportBucket struct {
+	Start time.Time `json:"start"`
+	TripStats
+}
+
+type ZoneStats struct {
+	Cell BoundingBox `json:"cell"`
+	TripStats
+}
+
+type PaymentMethodRevenue struct {
+	PaymentMethod string  `json:"payment_method"`
+	Trips         int64   `json:"trips"`
+	Revenue       float64 `json:"revenue"`
+}
+
+// TripReport is the aggregated view of the trips created in [From, To).
+// Buckets and zones without trips are omitted.
+type TripReport struct {
+	From                   time.Time              `json:"from"`
+	To                     time.Time              `json:"to"`
+	Interval               ReportInterval         `json:"interval"`
+	Summary                TripStats              `json:"summary"`
+	Buckets                []TripReportBucket     `json:"buckets"`
+	Zones                  []ZoneStats            `json:"zones"`
+	RevenueByPaymentMethod []PaymentMethodRevenue `json:"revenue_by_payment_method"`
+}
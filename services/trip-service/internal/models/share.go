@@ -0,0 +1,43 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// ShareLink grants read-only access to a trip's live view to whoever holds
+// its token. Only the token's hash is stored.
+type ShareLink struct {
+	ID        int64        `json:"id"`
+	TripID    int          `json:"trip_id"`
+	CreatedBy int          `json:"created_by"`
+	TokenHash string       `json:"-"`
+	CreatedAt time.Time    `json:"created_at"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	RevokedAt sql.NullTime `json:"revoked_at"`
+}
+
+// Active reports whether the link can still be used at now.
+func (l ShareLink) Active(now time.Time) bool {
+	return !l.RevokedAt.Valid && now.Before(l.ExpiresAt)
+}
+
+type SharedPosition struct {
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Heading    string  `json:"heading,omitempty"`
+	ReportedAt string  `json:"reported_at,omitempty"`
+}
+
+// SharedTripView is what a share link shows: no ids, contact details or fare.
+type SharedTripView struct {
+	Status          TripStatus      `json:"status"`
+	DriverFirstName string          `json:"driver_first_name,omitempty"`
+	LicensePlate    string          `json:"license_plate,omitempty"`
+	VehicleType     string          `json:"vehicle_type,omitempty"`
+	DriverPosition  *SharedPosition `json:"driver_position,omitempty"`
+	// ETASeconds is how long until the driver reaches ETATarget, 0 if unknown.
+	ETASeconds int       `json:"eta_seconds,omitempty"`
+	ETATarget  string    `json:"eta_target,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
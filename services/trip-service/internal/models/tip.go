@@ -0,0 +1,37 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Tip is what a passenger added on top of the fare after a completed trip.
+// ChargeRef identifies the payment taken; CreditedAt is set once the tip has
+// reached the driver's revenue.
+type Tip struct {
+	TripID        int          `json:"trip_id"`
+	PassengerID   int          `json:"passenger_id"`
+	DriverID      int          `json:"driver_id"`
+	Amount        float64      `json:"amount"`
+	PaymentMethod string       `json:"payment_method"`
+	ChargeRef     string       `json:"charge_ref"`
+	CreatedAt     time.Time    `json:"created_at"`
+	CreditedAt    sql.NullTime `json:"credited_at"`
+}
+
+// Receipt is the passenger's and driver's record of what a trip cost.
+type Receipt struct {
+	TripID        int       `json:"trip_id"`
+	PassengerID   int       `json:"passenger_id"`
+	DriverID      int       `json:"driver_id"`
+	PaymentMethod string    `json:"payment_method"`
+	Distance      float64   `json:"distance"`
+	Fare          float64   `json:"fare"`
+	Tip           float64   `json:"tip"`
+	Total         float64   `json:"total"`
+	CompletedAt   time.Time `json:"completed_at"`
+	// TippedAt is set once a tip was added.
+	TippedAt sql.NullTime `json:"tipped_at"`
+	// TipDeadline is set while the passenger can still add a tip.
+	TipDeadline sql.NullTime `json:"tip_deadline"`
+}
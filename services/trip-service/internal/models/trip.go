@@ -17,10 +17,12 @@ type Trip struct {
 	Distance       float64        `json:"distance"`
 	Fare           float64        `json:"fare"`
 	PaymentMethod  string         `json:"payment_method"`
+	VehicleClass   string         `json:"vehicle_class"`
 	Rating         sql.NullInt32  `json:"rating,omitempty"`
 	Review         sql.NullString `json:"review,omitempty"`
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
+	AcceptedAt     sql.NullTime   `json:"accepted_at"`
 	StartedAt      sql.NullTime   `json:"started_at"`
 	CompletedAt    sql.NullTime   `json:"completed_at"`
 	CancelledAt    sql.NullTime   `json:"cancelled_at"`
@@ -36,3 +38,14 @@ const (
 	StatusCompleted TripStatus = "COMPLETED"
 	StatusCancelled TripStatus = "CANCELLED"
 )
+
+// Vehicle classes a passenger can request.
+const (
+	ClassMotorbike = "motorbike"
+	ClassCar4      = "car_4"
+	ClassCar7      = "car_7"
+	ClassPremium   = "premium"
+)
+
+// DefaultVehicleClass is used when a trip is requested without a class.
+const DefaultVehicleClass = ClassCar4
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPaymentMethodUnsupported means the payment method can't be charged after
+// the trip, e.g. cash.
+var ErrPaymentMethodUnsupported = errors.New("payment method cannot be charged in-app")
+
+// Charge asks for Amount to be taken from the user's payment method on file.
+// Charges with the same IdempotencyKey are taken at most once.
+type Charge struct {
+	UserID         int
+	Method         string
+	Amount         float64
+	Description    string
+	IdempotencyKey string
+}
+
+// PaymentProvider charges a user's payment method on file and returns the
+// payment gateway's reference for the money taken. It returns
+// ErrPaymentMethodUnsupported for methods it can't charge.
+//
+// No payment gateway is integrated yet, so trip-service runs without a
+// provider and everything that needs one, such as tipping, is disabled.
+type PaymentProvider interface {
+	Charge(ctx context.Context, charge Charge) (string, error)
+}
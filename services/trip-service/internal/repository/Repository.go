@@ -3,20 +3,56 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 	"trip-service/internal/models"
 )
 
+// DatabaseRepo is the trip-service persistence layer. Every method takes the
+// caller's context so deadlines, cancellation and trace spans reach Postgres.
 type DatabaseRepo interface {
 	Connection() *sql.DB
 	PingContext(ctx context.Context) error
-	CreateTrip(tripDTO NewTripDTO, distance float64, fare float64) (models.Trip, error)
-	AcceptTrip(tripID int, driverID int) error
-	GetTrip(tripID int) (models.Trip, error)
-	GetTripsByPassenger(passengerID int) ([]models.Trip, error)
-	GetTripsByDriver(driverID int) ([]models.Trip, error)
-	UpdateTripStatus(status models.TripStatus, tripID int) error
-	GetTrips(page int, limit int) ([]models.Trip, error)
-	CancelTrip(userID int, tripID int) error
-	ReviewTrip(tripID int, review ReviewDTO) error
-	GetReview(tripID int) (ReviewDTO, error)
+	CreateTrip(ctx context.Context, tripDTO NewTripDTO, distance float64, fare float64) (models.Trip, error)
+	AcceptTrip(ctx context.Context, tripID int, driverID int) error
+	GetTrip(ctx context.Context, tripID int) (models.Trip, error)
+	GetTripsByPassenger(ctx context.Context, passengerID int) ([]models.Trip, error)
+	GetTripsByDriver(ctx context.Context, driverID int) ([]models.Trip, error)
+	GetDriverStats(ctx context.Context, driverIDs []int) (map[int]models.DriverStats, error)
+	RecordDriverOffer(ctx context.Context, offer models.DriverOffer) (models.DriverOffer, error)
+	GetDriverOfferStats(ctx context.Context, filter DriverOfferFilter) (map[int]models.DriverOfferStats, error)
+	UpdateTripStatus(ctx context.Context, status models.TripStatus, tripID int) error
+	GetTrips(ctx context.Context, page int, limit int) ([]models.Trip, error)
+	CancelTrip(ctx context.Context, userID int, tripID int) error
+	ReviewTrip(ctx context.Context, tripID int, review ReviewDTO) error
+	GetReview(ctx context.Context, tripID int) (ReviewDTO, error)
+	GetTripReport(ctx context.Context, filter TripReportFilter) (models.TripReport, error)
+	ExportTrips(ctx context.Context, filter TripExportFilter, afterID int, limit int) ([]models.Trip, error)
+	CreateChatMessage(ctx context.Context, message models.ChatMessage) (models.ChatMessage, error)
+	GetChatMessages(ctx context.Context, tripID int, afterID int64, limit int) ([]models.ChatMessage, error)
+	MarkChatMessages(ctx context.Context, tripID int, recipientID int, upToID int64, kind models.ChatReceiptKind, at time.Time) (int64, error)
+	CreateIncident(ctx context.Context, incident models.Incident) (models.Incident, error)
+	GetIncident(ctx context.Context, incidentID int64) (models.Incident, error)
+	GetOpenIncident(ctx context.Context, tripID int) (models.Incident, error)
+	ListIncidents(ctx context.Context, status models.IncidentStatus, tripID int, limit int) ([]models.Incident, error)
+	CloseIncident(ctx context.Context, incidentID int64, closedBy int, resolution string, at time.Time) (bool, error)
+	AddTrackPoints(ctx context.Context, points []models.TrackPoint) (int64, error)
+	GetTrackPoints(ctx context.Context, incidentID int64, afterID int64, limit int) ([]models.TrackPoint, error)
+	CreateShareLink(ctx context.Context, link models.ShareLink) (models.ShareLink, error)
+	GetShareLinkByTokenHash(ctx context.Context, tokenHash string) (models.ShareLink, error)
+	GetShareLinks(ctx context.Context, tripID int) ([]models.ShareLink, error)
+	RevokeShareLink(ctx context.Context, tripID int, shareID int64, at time.Time) (bool, error)
+	CreateTip(ctx context.Context, tip models.Tip) (models.Tip, error)
+	GetTip(ctx context.Context, tripID int) (models.Tip, error)
+	GetUncreditedTips(ctx context.Context, createdBefore time.Time, limit int) ([]models.Tip, error)
+	MarkTipCredited(ctx context.Context, tripID int, at time.Time) error
+	ReserveIdempotencyKey(ctx context.Context, record models.IdempotencyRecord, expiredBefore time.Time) (models.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, userID int, key string, response []byte) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error)
+	WithTx(ctx context.Context, fn func(repo DatabaseRepo) error) error
+	EnqueueEvent(ctx context.Context, event models.OutboxEvent) error
+	FetchPendingEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkEventSent(ctx context.Context, eventID int64) error
+	MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt time.Time) error
+	DeleteSentEvents(ctx context.Context, sentBefore time.Time) (int64, error)
 }
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"trip-service/internal/models"
+)
+
+func (m *PostgresDBRepo) CreateChatMessage(ctx context.Context, message models.ChatMessage) (models.ChatMessage, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into trip_messages (trip_id, sender_id, recipient_id, body, quick_reply, created_at)
+		values ($1, $2, $3, $4, $5, $6) returning id`
+
+	message.CreatedAt = time.Now()
+	err := m.conn().QueryRowContext(ctx, query,
+		message.TripID,
+		message.SenderID,
+		message.RecipientID,
+		message.Body,
+		message.QuickReply,
+		message.CreatedAt,
+	).Scan(&message.ID)
+	if err != nil {
+		return models.ChatMessage{}, err
+	}
+
+	return message, nil
+}
+
+// GetChatMessages returns up to limit messages of a trip with an id greater
+// than afterID, oldest first.
+func (m *PostgresDBRepo) GetChatMessages(ctx context.Context, tripID int, afterID int64, limit int) ([]models.ChatMessage, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select id, trip_id, sender_id, recipient_id, body, quick_reply, created_at, delivered_at, read_at
+		from trip_messages where trip_id = $1 and id > $2 order by id limit $3`
+
+	rows, err := m.conn().QueryContext(ctx, query, tripID, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	messages := []models.ChatMessage{}
+	for rows.Next() {
+		var message models.ChatMessage
+		if err = rows.Scan(
+			&message.ID,
+			&message.TripID,
+			&message.SenderID,
+			&message.RecipientID,
+			&message.Body,
+			&message.QuickReply,
+			&message.CreatedAt,
+			&message.DeliveredAt,
+			&message.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, rows.Err()
+}
+
+// MarkChatMessages records a receipt for the messages addressed to
+// recipientID up to upToID that don't have one yet, and returns how many
+// changed. Reading a message also marks it delivered.
+func (m *PostgresDBRepo) MarkChatMessages(ctx context.Context, tripID int, recipientID int, upToID int64, kind models.ChatReceiptKind, at time.Time) (int64, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update trip_messages set delivered_at = $4
+		where trip_id = $1 and recipient_id = $2 and id <= $3 and delivered_at is null`
+	if kind == models.ReceiptRead {
+		query = `update trip_messages set read_at = $4, delivered_at = coalesce(delivered_at, $4)
+			where trip_id = $1 and recipient_id = $2 and id <= $3 and read_at is null`
+	}
+
+	result, err := m.conn().ExecContext(ctx, query, tripID, recipientID, upToID, at)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
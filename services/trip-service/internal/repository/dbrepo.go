@@ -3,34 +3,85 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 	"trip-service/internal/models"
 )
 
-const dbTimeout = time.Second * 3
+// DefaultTimeout bounds each query when PostgresDBRepo.Timeout is unset.
+const DefaultTimeout = time.Second * 3
 
 type PostgresDBRepo struct {
 	DB *sql.DB
+	// Timeout bounds each query; the caller's deadline still applies when
+	// it is sooner.
+	Timeout time.Duration
+	tx      *sql.Tx
+}
+
+// dbtx is the subset of *sql.DB and *sql.Tx used by the repository queries.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// conn returns the transaction when the repo is bound to one, else the pool.
+func (m *PostgresDBRepo) conn() dbtx {
+	if m.tx != nil {
+		return m.tx
+	}
+	return m.DB
+}
+
+// withTimeout derives the per-query context from the caller's context, so
+// cancellation and trace spans propagate down to the driver.
+func (m *PostgresDBRepo) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := m.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
 }
 
 func (m *PostgresDBRepo) Connection() *sql.DB {
 	return m.DB
 }
 
+// WithTx runs fn against a repository bound to a single transaction, committing
+// when fn returns nil and rolling back otherwise. Calls on a repo that is already
+// inside a transaction reuse it.
+func (m *PostgresDBRepo) WithTx(ctx context.Context, fn func(repo DatabaseRepo) error) error {
+	if m.tx != nil {
+		return fn(m)
+	}
+	tx, err := m.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(&PostgresDBRepo{DB: m.DB, Timeout: m.Timeout, tx: tx}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, rbErr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
 func (m *PostgresDBRepo) PingContext(ctx context.Context) error {
 	return m.DB.PingContext(ctx)
 }
 
-func (m *PostgresDBRepo) CreateTrip(tripDTO NewTripDTO, distance float64, fare float64) (models.Trip, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) CreateTrip(ctx context.Context, tripDTO NewTripDTO, distance float64, fare float64) (models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `insert into trips (passenger_id, origin_lat, origin_lng, dest_lat, dest_lng, status, distance, fare, 
-				payment_method, created_at, updated_at) values
-				($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id, passenger_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
-				distance, fare, payment_method`
+				payment_method, vehicle_class, created_at, updated_at) values
+				($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) returning id, passenger_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
+				distance, fare, payment_method, vehicle_class`
 	var trip models.Trip
-	err := m.DB.QueryRowContext(ctx, query,
+	err := m.conn().QueryRowContext(ctx, query,
 		tripDTO.PassengerID,
 		tripDTO.OriginLat,
 		tripDTO.OriginLng,
@@ -40,6 +91,7 @@ func (m *PostgresDBRepo) CreateTrip(tripDTO NewTripDTO, distance float64, fare f
 		distance,
 		fare,
 		tripDTO.PaymentMethod,
+		tripDTO.VehicleClass,
 		time.Now(),
 		time.Now(),
 	).Scan(
@@ -53,6 +105,7 @@ func (m *PostgresDBRepo) CreateTrip(tripDTO NewTripDTO, distance float64, fare f
 		&trip.Distance,
 		&trip.Fare,
 		&trip.PaymentMethod,
+		&trip.VehicleClass,
 	)
 	if err != nil {
 		return trip, err
@@ -60,13 +113,13 @@ func (m *PostgresDBRepo) CreateTrip(tripDTO NewTripDTO, distance float64, fare f
 	return trip, nil
 }
 
-func (m *PostgresDBRepo) AcceptTrip(tripID int, driverID int) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) AcceptTrip(ctx context.Context, tripID int, driverID int) error {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
-	query := `update trips set driver_id = $1, status = $2, updated_at = $3 where id = $4`
+	query := `update trips set driver_id = $1, status = $2, updated_at = $3, accepted_at = $3 where id = $4`
 
-	_, err := m.DB.ExecContext(ctx, query,
+	_, err := m.conn().ExecContext(ctx, query,
 		driverID,
 		models.StatusAccepted,
 		time.Now(),
@@ -79,16 +132,16 @@ func (m *PostgresDBRepo) AcceptTrip(tripID int, driverID int) error {
 	return nil
 }
 
-func (m *PostgresDBRepo) GetTrip(tripID int) (models.Trip, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) GetTrip(ctx context.Context, tripID int) (models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, passenger_id, driver_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
-		distance, fare, payment_method, rating, review, created_at, updated_at, started_at, completed_at, cancelled_at, cancel_by_user_id
+		distance, fare, payment_method, vehicle_class, rating, review, created_at, updated_at, accepted_at, started_at, completed_at, cancelled_at, cancel_by_user_id
 		from trips where id = $1`
 
 	var trip models.Trip
-	err := m.DB.QueryRowContext(ctx, query, tripID).Scan(
+	err := m.conn().QueryRowContext(ctx, query, tripID).Scan(
 		&trip.ID,
 		&trip.PassengerID,
 		&trip.DriverID,
@@ -100,10 +153,12 @@ func (m *PostgresDBRepo) GetTrip(tripID int) (models.Trip, error) {
 		&trip.Distance,
 		&trip.Fare,
 		&trip.PaymentMethod,
+		&trip.VehicleClass,
 		&trip.Rating,
 		&trip.Review,
 		&trip.CreatedAt,
 		&trip.UpdatedAt,
+		&trip.AcceptedAt,
 		&trip.StartedAt,
 		&trip.CompletedAt,
 		&trip.CancelledAt,
@@ -116,15 +171,15 @@ func (m *PostgresDBRepo) GetTrip(tripID int) (models.Trip, error) {
 	return trip, nil
 }
 
-func (m *PostgresDBRepo) GetTrips(page int, limit int) ([]models.Trip, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) GetTrips(ctx context.Context, page int, limit int) ([]models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	offset := (page - 1) * limit
-	rows, err := m.DB.QueryContext(ctx, `
+	rows, err := m.conn().QueryContext(ctx, `
 	SELECT id, passenger_id, driver_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
-	       distance, fare, payment_method, rating, review, created_at, updated_at, started_at, 
-	       completed_at, cancelled_at, cancel_by_user_id
+	       distance, fare, payment_method, vehicle_class, rating, review, created_at, updated_at, accepted_at,
+	       started_at, completed_at, cancelled_at, cancel_by_user_id
 	FROM trips
 	LIMIT $1 OFFSET $2`, limit, offset)
 	if err != nil {
@@ -147,10 +202,12 @@ func (m *PostgresDBRepo) GetTrips(page int, limit int) ([]models.Trip, error) {
 			&trip.Distance,
 			&trip.Fare,
 			&trip.PaymentMethod,
+			&trip.VehicleClass,
 			&trip.Rating,
 			&trip.Review,
 			&trip.CreatedAt,
 			&trip.UpdatedAt,
+			&trip.AcceptedAt,
 			&trip.StartedAt,
 			&trip.CompletedAt,
 			&trip.CancelledAt,
@@ -163,12 +220,16 @@ func (m *PostgresDBRepo) GetTrips(page int, limit int) ([]models.Trip, error) {
 	return trips, nil
 }
 
-func (m *PostgresDBRepo) UpdateTripStatus(status models.TripStatus, tripID int) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) UpdateTripStatus(ctx context.Context, status models.TripStatus, tripID int) error {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
-	query := `update trips set status = $1, updated_at = $2 where id = $3`
-	_, err := m.DB.ExecContext(ctx, query,
+	// started_at/completed_at keep the first time the trip reached the status.
+	query := `update trips set status = $1, updated_at = $2,
+		started_at = case when $1 = 'STARTED' then coalesce(started_at, $2) else started_at end,
+		completed_at = case when $1 = 'COMPLETED' then coalesce(completed_at, $2) else completed_at end
+		where id = $3`
+	_, err := m.conn().ExecContext(ctx, query,
 		status,
 		time.Now(),
 		tripID,
@@ -179,18 +240,19 @@ func (m *PostgresDBRepo) UpdateTripStatus(status models.TripStatus, tripID int)
 	return nil
 }
 
-func (m *PostgresDBRepo) GetTripsByPassenger(passengerID int) ([]models.Trip, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) GetTripsByPassenger(ctx context.Context, passengerID int) ([]models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, passenger_id, driver_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
-		distance, fare, payment_method, rating, review, created_at, updated_at, started_at, completed_at, cancelled_at, cancel_by_user_id
+		distance, fare, payment_method, vehicle_class, rating, review, created_at, updated_at, accepted_at, started_at, completed_at, cancelled_at, cancel_by_user_id
 		from trips where passenger_id = $1`
 
-	rows, err := m.DB.QueryContext(ctx, query, passengerID)
+	rows, err := m.conn().QueryContext(ctx, query, passengerID)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 	trips := []models.Trip{}
 	for rows.Next() {
 		var trip models.Trip
@@ -206,10 +268,12 @@ func (m *PostgresDBRepo) GetTripsByPassenger(passengerID int) ([]models.Trip, er
 			&trip.Distance,
 			&trip.Fare,
 			&trip.PaymentMethod,
+			&trip.VehicleClass,
 			&trip.Rating,
 			&trip.Review,
 			&trip.CreatedAt,
 			&trip.UpdatedAt,
+			&trip.AcceptedAt,
 			&trip.StartedAt,
 			&trip.CompletedAt,
 			&trip.CancelledAt,
@@ -219,17 +283,16 @@ func (m *PostgresDBRepo) GetTripsByPassenger(passengerID int) ([]models.Trip, er
 		}
 		trips = append(trips, trip)
 	}
-	defer rows.Close()
-	return trips, nil
+	return trips, rows.Err()
 }
-func (m *PostgresDBRepo) GetTripsByDriver(driverID int) ([]models.Trip, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) GetTripsByDriver(ctx context.Context, driverID int) ([]models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `select id, passenger_id, driver_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
-		distance, fare, payment_method, rating, review, created_at, updated_at, started_at, completed_at, cancelled_at, cancel_by_user_id
+		distance, fare, payment_method, vehicle_class, rating, review, created_at, updated_at, accepted_at, started_at, completed_at, cancelled_at, cancel_by_user_id
 		from trips where driver_id = $1`
-	rows, err := m.DB.QueryContext(ctx, query, driverID)
+	rows, err := m.conn().QueryContext(ctx, query, driverID)
 	if err != nil {
 		return nil, err
 	}
@@ -249,10 +312,12 @@ func (m *PostgresDBRepo) GetTripsByDriver(driverID int) ([]models.Trip, error) {
 			&trip.Distance,
 			&trip.Fare,
 			&trip.PaymentMethod,
+			&trip.VehicleClass,
 			&trip.Rating,
 			&trip.Review,
 			&trip.CreatedAt,
 			&trip.UpdatedAt,
+			&trip.AcceptedAt,
 			&trip.StartedAt,
 			&trip.CompletedAt,
 			&trip.CancelledAt,
@@ -262,15 +327,14 @@ func (m *PostgresDBRepo) GetTripsByDriver(driverID int) ([]models.Trip, error) {
 		}
 		trips = append(trips, trip)
 	}
-	defer rows.Close()
-	return trips, nil
+	return trips, rows.Err()
 }
-func (m *PostgresDBRepo) CancelTrip(userID int, tripID int) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) CancelTrip(ctx context.Context, userID int, tripID int) error {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `update trips set status = $1, cancel_by_user_id = $2, updated_at = $3, cancelled_at = $4 where id = $5`
-	_, err := m.DB.ExecContext(ctx, query,
+	_, err := m.conn().ExecContext(ctx, query,
 		models.StatusCancelled,
 		userID,
 		time.Now(),
@@ -282,12 +346,12 @@ func (m *PostgresDBRepo) CancelTrip(userID int, tripID int) error {
 	}
 	return nil
 }
-func (m *PostgresDBRepo) ReviewTrip(tripID int, review ReviewDTO) error {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) ReviewTrip(ctx context.Context, tripID int, review ReviewDTO) error {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `update trips set rating = $1, review = $2, updated_at = $3 where id = $4`
-	_, err := m.DB.ExecContext(ctx, query,
+	_, err := m.conn().ExecContext(ctx, query,
 		review.Rating,
 		review.Comment,
 		time.Now(),
@@ -299,13 +363,13 @@ func (m *PostgresDBRepo) ReviewTrip(tripID int, review ReviewDTO) error {
 	return nil
 }
 
-func (m *PostgresDBRepo) GetReview(tripID int) (ReviewDTO, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), dbTimeout)
+func (m *PostgresDBRepo) GetReview(ctx context.Context, tripID int) (ReviewDTO, error) {
+	ctx, cancel := m.withTimeout(ctx)
 	defer cancel()
 
 	query := `select passenger_id, rating, review from trips where id = $1`
 	var review ReviewDTO
-	err := m.DB.QueryRowContext(ctx, query, tripID).Scan(
+	err := m.conn().QueryRowContext(ctx, query, tripID).Scan(
 		&review.PassengerID,
 		&review.Rating,
 		&review.Comment,
@@ -315,3 +379,197 @@ func (m *PostgresDBRepo) GetReview(tripID int) (ReviewDTO, error) {
 	}
 	return review, nil
 }
+
+// ReserveIdempotencyKey claims the (user, key) pair for a new request until
+// record.LockedUntil. It returns reserved=true when the caller should execute the
+// request, or the existing record when the key was already used within the
+// retention window and isn't an unfinished reservation whose lease ran out.
+func (m *PostgresDBRepo) ReserveIdempotencyKey(ctx context.Context, record models.IdempotencyRecord, expiredBefore time.Time) (models.IdempotencyRecord, bool, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	// An expired row, or an unfinished one whose lease ran out, is taken over
+	// as if it did not exist.
+	now := time.Now()
+	query := `insert into idempotency_keys (user_id, idempotency_key, operation, request_hash, created_at, locked_until)
+		values ($1, $2, $3, $4, $5, $6)
+		on conflict (user_id, idempotency_key) do update set
+			operation = excluded.operation,
+			request_hash = excluded.request_hash,
+			response = null,
+			created_at = excluded.created_at,
+			completed_at = null,
+			locked_until = excluded.locked_until
+		where idempotency_keys.created_at < $7
+			or (idempotency_keys.response is null
+				and (idempotency_keys.locked_until is null or idempotency_keys.locked_until < $5))
+		returning user_id`
+	var userID int
+	err := m.conn().QueryRowContext(ctx, query,
+		record.UserID,
+		record.Key,
+		record.Operation,
+		record.RequestHash,
+		now,
+		record.LockedUntil,
+		expiredBefore,
+	).Scan(&userID)
+	if err == nil {
+		return record, true, nil
+	}
+	if err != sql.ErrNoRows {
+		return models.IdempotencyRecord{}, false, err
+	}
+
+	query = `select user_id, idempotency_key, operation, request_hash, response, created_at, completed_at, locked_until
+		from idempotency_keys where user_id = $1 and idempotency_key = $2`
+	var existing models.IdempotencyRecord
+	err = m.conn().QueryRowContext(ctx, query, record.UserID, record.Key).Scan(
+		&existing.UserID,
+		&existing.Key,
+		&existing.Operation,
+		&existing.RequestHash,
+		&existing.Response,
+		&existing.CreatedAt,
+		&existing.CompletedAt,
+		&existing.LockedUntil,
+	)
+	if err != nil {
+		return models.IdempotencyRecord{}, false, err
+	}
+	return existing, false, nil
+}
+
+func (m *PostgresDBRepo) CompleteIdempotencyKey(ctx context.Context, userID int, key string, response []byte) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update idempotency_keys set response = $1, completed_at = $2 where user_id = $3 and idempotency_key = $4`
+	_, err := m.conn().ExecContext(ctx, query, response, time.Now(), userID, key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey drops an unfinished reservation so the client can retry
+// a request that failed.
+func (m *PostgresDBRepo) ReleaseIdempotencyKey(ctx context.Context, userID int, key string) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `delete from idempotency_keys where user_id = $1 and idempotency_key = $2 and completed_at is null`
+	_, err := m.conn().ExecContext(ctx, query, userID, key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	result, err := m.conn().ExecContext(ctx, `delete from idempotency_keys where created_at < $1`, expiredBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// EnqueueEvent writes an event to the outbox. Call it through WithTx so the
+// event commits or rolls back together with the trip change it describes.
+func (m *PostgresDBRepo) EnqueueEvent(ctx context.Context, event models.OutboxEvent) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into outbox (event_name, destination, payload, priority, created_at, next_attempt_at)
+		values ($1, $2, $3, $4, $5, $5)`
+	_, err := m.conn().ExecContext(ctx, query,
+		event.EventName,
+		event.Destination,
+		event.Payload,
+		event.Priority,
+		time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// FetchPendingEvents returns unsent events that are due for a delivery attempt,
+// highest priority first and oldest first within a priority. Inside WithTx the rows stay locked until the transaction ends,
+// and rows locked by another relay replica are skipped.
+func (m *PostgresDBRepo) FetchPendingEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select id, event_name, destination, payload, priority, attempts, last_error, created_at, next_attempt_at, sent_at
+		from outbox
+		where sent_at is null and next_attempt_at <= $1
+		order by priority desc, id
+		limit $2
+		for update skip locked`
+	rows, err := m.conn().QueryContext(ctx, query, time.Now(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []models.OutboxEvent{}
+	for rows.Next() {
+		var event models.OutboxEvent
+		if err = rows.Scan(
+			&event.ID,
+			&event.EventName,
+			&event.Destination,
+			&event.Payload,
+			&event.Priority,
+			&event.Attempts,
+			&event.LastError,
+			&event.CreatedAt,
+			&event.NextAttemptAt,
+			&event.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (m *PostgresDBRepo) MarkEventSent(ctx context.Context, eventID int64) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update outbox set sent_at = $1, attempts = attempts + 1, last_error = null where id = $2`
+	_, err := m.conn().ExecContext(ctx, query, time.Now(), eventID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostgresDBRepo) MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt time.Time) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update outbox set attempts = attempts + 1, last_error = $1, next_attempt_at = $2 where id = $3`
+	_, err := m.conn().ExecContext(ctx, query, lastError, nextAttemptAt, eventID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostgresDBRepo) DeleteSentEvents(ctx context.Context, sentBefore time.Time) (int64, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	result, err := m.conn().ExecContext(ctx, `delete from outbox where sent_at < $1`, sentBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
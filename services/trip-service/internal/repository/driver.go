@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"trip-service/internal/models"
+)
+
+// GetDriverStats returns the stats of the drivers among driverIDs who have
+// had a trip; drivers without one are missing from the map.
+func (m *PostgresDBRepo) GetDriverStats(ctx context.Context, driverIDs []int) (map[int]models.DriverStats, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	ids := make([]int32, len(driverIDs))
+	for i, id := range driverIDs {
+		ids[i] = int32(id)
+	}
+	query := `select driver_id, count(rating), coalesce(avg(rating), 0), max(coalesce(completed_at, cancelled_at))
+		from trips where driver_id = any($1)
+		group by driver_id`
+	rows, err := m.conn().QueryContext(ctx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[int]models.DriverStats, len(driverIDs))
+	for rows.Next() {
+		var s models.DriverStats
+		if err := rows.Scan(&s.DriverID, &s.RatedTrips, &s.AvgRating, &s.LastTripEndedAt); err != nil {
+			return nil, err
+		}
+		stats[s.DriverID] = s
+	}
+	return stats, rows.Err()
+}
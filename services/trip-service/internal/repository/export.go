@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"trip-service/internal/models"
+)
+
+// ExportTrips returns up to limit trips matching filter with an id greater
+// than afterID, ordered by id. Callers page through large exports by passing
+// the last id they received, so no query or connection is held for the whole
+// export.
+func (m *PostgresDBRepo) ExportTrips(ctx context.Context, filter TripExportFilter, afterID int, limit int) ([]models.Trip, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	args := []any{afterID}
+	conditions := []string{"id > $1"}
+	add := func(condition string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, v := range values {
+			args = append(args, v)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+	if !filter.From.IsZero() {
+		add("created_at >= %s", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("created_at < %s", filter.To)
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		add("status::text = any(%s)", statuses)
+	}
+	if filter.PassengerID != 0 {
+		add("passenger_id = %s", filter.PassengerID)
+	}
+	if filter.DriverID != 0 {
+		add("driver_id = %s", filter.DriverID)
+	}
+	if filter.PaymentMethod != "" {
+		add("payment_method = %s", filter.PaymentMethod)
+	}
+	if zone := filter.Zone; zone != nil {
+		add("origin_lat between %s and %s and origin_lng between %s and %s", zone.MinLat, zone.MaxLat, zone.MinLng, zone.MaxLng)
+	}
+	args = append(args, limit)
+
+	query := `select id, passenger_id, driver_id, origin_lat, origin_lng, dest_lat, dest_lng, status,
+		distance, fare, payment_method, vehicle_class, rating, review, created_at, updated_at, accepted_at, started_at, completed_at, cancelled_at, cancel_by_user_id
+		from trips where ` + strings.Join(conditions, " and ") + fmt.Sprintf(` order by id limit $%d`, len(args))
+
+	rows, err := m.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	trips := []models.Trip{}
+	for rows.Next() {
+		var trip models.Trip
+		if err = rows.Scan(
+			&trip.ID,
+			&trip.PassengerID,
+			&trip.DriverID,
+			&trip.OriginLat,
+			&trip.OriginLng,
+			&trip.DestLat,
+			&trip.DestLng,
+			&trip.Status,
+			&trip.Distance,
+			&trip.Fare,
+			&trip.PaymentMethod,
+			&trip.VehicleClass,
+			&trip.Rating,
+			&trip.Review,
+			&trip.CreatedAt,
+			&trip.UpdatedAt,
+			&trip.AcceptedAt,
+			&trip.StartedAt,
+			&trip.CompletedAt,
+			&trip.CancelledAt,
+			&trip.CancelByUserID,
+		); err != nil {
+			return nil, err
+		}
+		trips = append(trips, trip)
+	}
+	return trips, rows.Err()
+}
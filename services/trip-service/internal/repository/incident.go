@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"trip-service/internal/models"
+)
+
+const incidentColumns = `id, trip_id, raised_by, raised_by_role, note, status, snapshot, created_at, closed_by, resolution, closed_at`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanIncident(row rowScanner) (models.Incident, error) {
+	var incident models.Incident
+	var snapshot []byte
+	if err := row.Scan(
+		&incident.ID,
+		&incident.TripID,
+		&incident.RaisedBy,
+		&incident.RaisedByRole,
+		&incident.Note,
+		&incident.Status,
+		&snapshot,
+		&incident.CreatedAt,
+		&incident.ClosedBy,
+		&incident.Resolution,
+		&incident.ClosedAt,
+	); err != nil {
+		return models.Incident{}, err
+	}
+	if err := json.Unmarshal(snapshot, &incident.Snapshot); err != nil {
+		return models.Incident{}, err
+	}
+	return incident, nil
+}
+
+// CreateIncident stores a new open incident. It fails if the trip already has
+// an open one.
+func (m *PostgresDBRepo) CreateIncident(ctx context.Context, incident models.Incident) (models.Incident, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	snapshot, err := json.Marshal(incident.Snapshot)
+	if err != nil {
+		return models.Incident{}, err
+	}
+	query := `insert into incidents (trip_id, raised_by, raised_by_role, note, status, snapshot, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7) returning id`
+
+	incident.Status = models.IncidentOpen
+	incident.CreatedAt = time.Now()
+	err = m.conn().QueryRowContext(ctx, query,
+		incident.TripID,
+		incident.RaisedBy,
+		incident.RaisedByRole,
+		incident.Note,
+		incident.Status,
+		snapshot,
+		incident.CreatedAt,
+	).Scan(&incident.ID)
+	if err != nil {
+		return models.Incident{}, err
+	}
+	return incident, nil
+}
+
+func (m *PostgresDBRepo) GetIncident(ctx context.Context, incidentID int64) (models.Incident, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select ` + incidentColumns + ` from incidents where id = $1`
+	return scanIncident(m.conn().QueryRowContext(ctx, query, incidentID))
+}
+
+// GetOpenIncident returns the trip's open incident, or sql.ErrNoRows.
+func (m *PostgresDBRepo) GetOpenIncident(ctx context.Context, tripID int) (models.Incident, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select ` + incidentColumns + ` from incidents where trip_id = $1 and status = $2`
+	return scanIncident(m.conn().QueryRowContext(ctx, query, tripID, models.IncidentOpen))
+}
+
+// ListIncidents returns up to limit incidents, newest first. An empty status
+// or a zero tripID doesn't filter.
+func (m *PostgresDBRepo) ListIncidents(ctx context.Context, status models.IncidentStatus, tripID int, limit int) ([]models.Incident, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select ` + incidentColumns + ` from incidents
+		where ($1 = '' or status = $1) and ($2 = 0 or trip_id = $2)
+		order by id desc limit $3`
+	rows, err := m.conn().QueryContext(ctx, query, string(status), tripID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	incidents := []models.Incident{}
+	for rows.Next() {
+		incident, err := scanIncident(rows)
+		if err != nil {
+			return nil, err
+		}
+		incidents = append(incidents, incident)
+	}
+	return incidents, rows.Err()
+}
+
+// CloseIncident closes an open incident and reports whether it was open.
+func (m *PostgresDBRepo) CloseIncident(ctx context.Context, incidentID int64, closedBy int, resolution string, at time.Time) (bool, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update incidents set status = $1, closed_by = $2, resolution = $3, closed_at = $4
+		where id = $5 and status = $6`
+	result, err := m.conn().ExecContext(ctx, query, models.IncidentClosed, closedBy, resolution, at, incidentID, models.IncidentOpen)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// AddTrackPoints stores sampled positions, skipping device reports that are
+// already recorded, and returns how many were new.
+func (m *PostgresDBRepo) AddTrackPoints(ctx context.Context, points []models.TrackPoint) (int64, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into incident_track (incident_id, user_id, latitude, longitude, speed, heading, reported_at, recorded_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8)
+		on conflict (incident_id, user_id, reported_at) do nothing`
+	var added int64
+	for _, point := range points {
+		result, err := m.conn().ExecContext(ctx, query,
+			point.IncidentID,
+			point.UserID,
+			point.Latitude,
+			point.Longitude,
+			point.Speed,
+			point.Heading,
+			point.ReportedAt,
+			point.RecordedAt,
+		)
+		if err != nil {
+			return added, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return added, err
+		}
+		added += n
+	}
+	return added, nil
+}
+
+// GetTrackPoints returns up to limit positions of an incident with an id
+// greater than afterID, oldest first.
+func (m *PostgresDBRepo) GetTrackPoints(ctx context.Context, incidentID int64, afterID int64, limit int) ([]models.TrackPoint, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select id, incident_id, user_id, latitude, longitude, speed, heading, reported_at, recorded_at
+		from incident_track where incident_id = $1 and id > $2 order by id limit $3`
+	rows, err := m.conn().QueryContext(ctx, query, incidentID, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := []models.TrackPoint{}
+	for rows.Next() {
+		var point models.TrackPoint
+		if err = rows.Scan(
+			&point.ID,
+			&point.IncidentID,
+			&point.UserID,
+			&point.Latitude,
+			&point.Longitude,
+			&point.Speed,
+			&point.Heading,
+			&point.ReportedAt,
+			&point.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, rows.Err()
+}
@@ -0,0 +1,969 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+	"trip-service/internal/models"
+)
+
+// MemoryDBRepo is an in-memory DatabaseRepo for tests and local runs without
+// Postgres. It mirrors the observable behaviour of PostgresDBRepo, including
+// sql.ErrNoRows for missing trips. Transactions work on a copy of the data that
+// replaces the original on commit; they are serialised with every other call,
+// so fn must only use the repo it is given.
+type MemoryDBRepo struct {
+	mu   *sync.Mutex
+	data *memoryData
+	inTx bool
+}
+
+type idempotencyKey struct {
+	userID int
+	key    string
+}
+
+type memoryData struct {
+	trips       map[int]models.Trip
+	nextTripID  int
+	idempotency map[idempotencyKey]models.IdempotencyRecord
+	outbox      []models.OutboxEvent
+	nextEventID int64
+	chat        []models.ChatMessage
+	nextChatID  int64
+	incidents   []models.Incident
+	track       []models.TrackPoint
+	nextTrackID int64
+	shares      []models.ShareLink
+	tips        map[int]models.Tip
+	offers      []models.DriverOffer
+	nextOfferID int64
+}
+
+func NewMemoryDBRepo() *MemoryDBRepo {
+	return &MemoryDBRepo{
+		mu: &sync.Mutex{},
+		data: &memoryData{
+			trips:       make(map[int]models.Trip),
+			idempotency: make(map[idempotencyKey]models.IdempotencyRecord),
+			tips:        make(map[int]models.Tip),
+		},
+	}
+}
+
+func (d *memoryData) clone() *memoryData {
+	c := &memoryData{
+		trips:       make(map[int]models.Trip, len(d.trips)),
+		nextTripID:  d.nextTripID,
+		idempotency: make(map[idempotencyKey]models.IdempotencyRecord, len(d.idempotency)),
+		outbox:      append([]models.OutboxEvent(nil), d.outbox...),
+		nextEventID: d.nextEventID,
+		chat:        append([]models.ChatMessage(nil), d.chat...),
+		nextChatID:  d.nextChatID,
+		incidents:   append([]models.Incident(nil), d.incidents...),
+		track:       append([]models.TrackPoint(nil), d.track...),
+		nextTrackID: d.nextTrackID,
+		shares:      append([]models.ShareLink(nil), d.shares...),
+		tips:        make(map[int]models.Tip, len(d.tips)),
+		offers:      append([]models.DriverOffer(nil), d.offers...),
+		nextOfferID: d.nextOfferID,
+	}
+	for id, trip := range d.trips {
+		c.trips[id] = trip
+	}
+	for key, record := range d.idempotency {
+		c.idempotency[key] = record
+	}
+	for tripID, tip := range d.tips {
+		c.tips[tripID] = tip
+	}
+	return c
+}
+
+// lock serialises access to the data. Inside WithTx the transaction already
+// holds the lock, so the bound repo doesn't take it again.
+func (m *MemoryDBRepo) lock() func() {
+	if m.inTx {
+		return func() {}
+	}
+	m.mu.Lock()
+	return m.mu.Unlock
+}
+
+func (m *MemoryDBRepo) Connection() *sql.DB {
+	return nil
+}
+
+func (m *MemoryDBRepo) PingContext(ctx context.Context) error {
+	return ctx.Err()
+}
+
+func (m *MemoryDBRepo) WithTx(ctx context.Context, fn func(repo DatabaseRepo) error) error {
+	if m.inTx {
+		return fn(m)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	tx := &MemoryDBRepo{mu: m.mu, data: m.data.clone(), inTx: true}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	m.data = tx.data
+	return nil
+}
+
+func (m *MemoryDBRepo) CreateTrip(ctx context.Context, tripDTO NewTripDTO, distance float64, fare float64) (models.Trip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Trip{}, err
+	}
+
+	m.data.nextTripID++
+	now := time.Now()
+	trip := models.Trip{
+		ID:            m.data.nextTripID,
+		PassengerID:   tripDTO.PassengerID,
+		OriginLat:     tripDTO.OriginLat,
+		OriginLng:     tripDTO.OriginLng,
+		DestLat:       tripDTO.DestLat,
+		DestLng:       tripDTO.DestLng,
+		Status:        models.StatusRequested,
+		Distance:      distance,
+		Fare:          fare,
+		PaymentMethod: tripDTO.PaymentMethod,
+		VehicleClass:  tripDTO.VehicleClass,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	m.data.trips[trip.ID] = trip
+	return trip, nil
+}
+
+// updateTrip applies change to a trip if it exists. Like an UPDATE matching no
+// rows, a missing trip is not an error.
+func (m *MemoryDBRepo) updateTrip(ctx context.Context, tripID int, change func(trip *models.Trip)) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	trip, ok := m.data.trips[tripID]
+	if !ok {
+		return nil
+	}
+	change(&trip)
+	trip.UpdatedAt = time.Now()
+	m.data.trips[tripID] = trip
+	return nil
+}
+
+func (m *MemoryDBRepo) AcceptTrip(ctx context.Context, tripID int, driverID int) error {
+	return m.updateTrip(ctx, tripID, func(trip *models.Trip) {
+		trip.DriverID = sql.NullInt32{Int32: int32(driverID), Valid: true}
+		trip.Status = models.StatusAccepted
+		trip.AcceptedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	})
+}
+
+func (m *MemoryDBRepo) UpdateTripStatus(ctx context.Context, status models.TripStatus, tripID int) error {
+	return m.updateTrip(ctx, tripID, func(trip *models.Trip) {
+		trip.Status = status
+		if status == models.StatusStarted && !trip.StartedAt.Valid {
+			trip.StartedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+		if status == models.StatusCompleted && !trip.CompletedAt.Valid {
+			trip.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+	})
+}
+
+func (m *MemoryDBRepo) CancelTrip(ctx context.Context, userID int, tripID int) error {
+	return m.updateTrip(ctx, tripID, func(trip *models.Trip) {
+		trip.Status = models.StatusCancelled
+		trip.CancelByUserID = sql.NullInt64{Int64: int64(userID), Valid: true}
+		trip.CancelledAt = sql.NullTime{Time: time.Now(), Valid: true}
+	})
+}
+
+func (m *MemoryDBRepo) ReviewTrip(ctx context.Context, tripID int, review ReviewDTO) error {
+	return m.updateTrip(ctx, tripID, func(trip *models.Trip) {
+		trip.Rating = sql.NullInt32{Int32: int32(review.Rating), Valid: true}
+		trip.Review = sql.NullString{String: review.Comment, Valid: true}
+	})
+}
+
+func (m *MemoryDBRepo) GetTrip(ctx context.Context, tripID int) (models.Trip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Trip{}, err
+	}
+	trip, ok := m.data.trips[tripID]
+	if !ok {
+		return models.Trip{}, sql.ErrNoRows
+	}
+	return trip, nil
+}
+
+// filterTrips returns the trips matching keep, ordered by ID.
+func (m *MemoryDBRepo) filterTrips(ctx context.Context, keep func(trip models.Trip) bool) ([]models.Trip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	trips := []models.Trip{}
+	for _, trip := range m.data.trips {
+		if keep(trip) {
+			trips = append(trips, trip)
+		}
+	}
+	sort.Slice(trips, func(i, j int) bool { return trips[i].ID < trips[j].ID })
+	return trips, nil
+}
+
+func (m *MemoryDBRepo) GetTrips(ctx context.Context, page int, limit int) ([]models.Trip, error) {
+	trips, err := m.filterTrips(ctx, func(models.Trip) bool { return true })
+	if err != nil {
+		return []models.Trip{}, err
+	}
+	offset := (page - 1) * limit
+	if offset < 0 || offset >= len(trips) {
+		return []models.Trip{}, nil
+	}
+	end := offset + limit
+	if end > len(trips) {
+		end = len(trips)
+	}
+	return trips[offset:end], nil
+}
+
+func (m *MemoryDBRepo) GetTripsByPassenger(ctx context.Context, passengerID int) ([]models.Trip, error) {
+	return m.filterTrips(ctx, func(trip models.Trip) bool { return trip.PassengerID == passengerID })
+}
+
+func (m *MemoryDBRepo) GetTripsByDriver(ctx context.Context, driverID int) ([]models.Trip, error) {
+	return m.filterTrips(ctx, func(trip models.Trip) bool {
+		return trip.DriverID.Valid && int(trip.DriverID.Int32) == driverID
+	})
+}
+
+func (m *MemoryDBRepo) GetDriverStats(ctx context.Context, driverIDs []int) (map[int]models.DriverStats, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	stats := make(map[int]models.DriverStats, len(driverIDs))
+	ratingSums := make(map[int]float64)
+	for _, trip := range m.data.trips {
+		if !trip.DriverID.Valid || !slices.Contains(driverIDs, int(trip.DriverID.Int32)) {
+			continue
+		}
+		driverID := int(trip.DriverID.Int32)
+		s := stats[driverID]
+		s.DriverID = driverID
+		if trip.Rating.Valid {
+			s.RatedTrips++
+			ratingSums[driverID] += float64(trip.Rating.Int32)
+		}
+		ended := trip.CompletedAt
+		if !ended.Valid {
+			ended = trip.CancelledAt
+		}
+		if ended.Valid && (!s.LastTripEndedAt.Valid || ended.Time.After(s.LastTripEndedAt.Time)) {
+			s.LastTripEndedAt = ended
+		}
+		stats[driverID] = s
+	}
+	for driverID, s := range stats {
+		if s.RatedTrips > 0 {
+			s.AvgRating = ratingSums[driverID] / float64(s.RatedTrips)
+			stats[driverID] = s
+		}
+	}
+	return stats, nil
+}
+
+func (m *MemoryDBRepo) GetReview(ctx context.Context, tripID int) (ReviewDTO, error) {
+	trip, err := m.GetTrip(ctx, tripID)
+	if err != nil {
+		return ReviewDTO{}, err
+	}
+	// Postgres fails to scan the NULL rating of an unreviewed trip.
+	if !trip.Rating.Valid {
+		return ReviewDTO{}, errors.New("trip has not been reviewed")
+	}
+	return ReviewDTO{
+		PassengerID: trip.PassengerID,
+		Rating:      int(trip.Rating.Int32),
+		Comment:     trip.Review.String,
+	}, nil
+}
+
+func (m *MemoryDBRepo) ReserveIdempotencyKey(ctx context.Context, record models.IdempotencyRecord, expiredBefore time.Time) (models.IdempotencyRecord, bool, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.IdempotencyRecord{}, false, err
+	}
+	key := idempotencyKey{userID: record.UserID, key: record.Key}
+	now := time.Now()
+	if existing, ok := m.data.idempotency[key]; ok && !existing.CreatedAt.Before(expiredBefore) {
+		leaseOver := existing.Response == nil && (existing.LockedUntil == nil || existing.LockedUntil.Before(now))
+		if !leaseOver {
+			return existing, false, nil
+		}
+	}
+	record.Response = nil
+	record.CreatedAt = now
+	record.CompletedAt = nil
+	m.data.idempotency[key] = record
+	return record, true, nil
+}
+
+func (m *MemoryDBRepo) CompleteIdempotencyKey(ctx context.Context, userID int, key string, response []byte) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	k := idempotencyKey{userID: userID, key: key}
+	record, ok := m.data.idempotency[k]
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	record.Response = response
+	record.CompletedAt = &now
+	m.data.idempotency[k] = record
+	return nil
+}
+
+func (m *MemoryDBRepo) ReleaseIdempotencyKey(ctx context.Context, userID int, key string) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	k := idempotencyKey{userID: userID, key: key}
+	if record, ok := m.data.idempotency[k]; ok && record.CompletedAt == nil {
+		delete(m.data.idempotency, k)
+	}
+	return nil
+}
+
+func (m *MemoryDBRepo) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for k, record := range m.data.idempotency {
+		if record.CreatedAt.Before(expiredBefore) {
+			delete(m.data.idempotency, k)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (m *MemoryDBRepo) EnqueueEvent(ctx context.Context, event models.OutboxEvent) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.data.nextEventID++
+	now := time.Now()
+	event.ID = m.data.nextEventID
+	event.Attempts = 0
+	event.CreatedAt = now
+	event.NextAttemptAt = now
+	event.SentAt = sql.NullTime{}
+	m.data.outbox = append(m.data.outbox, event)
+	return nil
+}
+
+func (m *MemoryDBRepo) FetchPendingEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	events := []models.OutboxEvent{}
+	for _, event := range m.data.outbox {
+		if !event.SentAt.Valid && !event.NextAttemptAt.After(now) {
+			events = append(events, event)
+		}
+	}
+	// The outbox is in id order already.
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Priority > events[j].Priority })
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// updateEvent applies change to an outbox event if it exists.
+func (m *MemoryDBRepo) updateEvent(ctx context.Context, eventID int64, change func(event *models.OutboxEvent)) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for i := range m.data.outbox {
+		if m.data.outbox[i].ID == eventID {
+			change(&m.data.outbox[i])
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *MemoryDBRepo) MarkEventSent(ctx context.Context, eventID int64) error {
+	return m.updateEvent(ctx, eventID, func(event *models.OutboxEvent) {
+		event.SentAt = sql.NullTime{Time: time.Now(), Valid: true}
+		event.Attempts++
+		event.LastError = sql.NullString{}
+	})
+}
+
+func (m *MemoryDBRepo) MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt time.Time) error {
+	return m.updateEvent(ctx, eventID, func(event *models.OutboxEvent) {
+		event.Attempts++
+		event.LastError = sql.NullString{String: lastError, Valid: true}
+		event.NextAttemptAt = nextAttemptAt
+	})
+}
+
+func (m *MemoryDBRepo) DeleteSentEvents(ctx context.Context, sentBefore time.Time) (int64, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	kept := m.data.outbox[:0]
+	var deleted int64
+	for _, event := range m.data.outbox {
+		if event.SentAt.Valid && event.SentAt.Time.Before(sentBefore) {
+			deleted++
+			continue
+		}
+		kept = append(kept, event)
+	}
+	m.data.outbox = kept
+	return deleted, nil
+}
+
+// statsAccumulator computes the same aggregates as tripStatsColumns.
+type statsAccumulator struct {
+	stats    models.TripStats
+	waitSum  float64
+	accepted int64
+	distSum  float64
+}
+
+func (a *statsAccumulator) add(trip models.Trip) {
+	a.stats.Trips++
+	if trip.AcceptedAt.Valid {
+		a.accepted++
+		a.waitSum += trip.AcceptedAt.Time.Sub(trip.CreatedAt).Seconds()
+	}
+	switch trip.Status {
+	case models.StatusCompleted:
+		a.stats.Completed++
+		a.stats.Revenue += trip.Fare
+		a.distSum += trip.Distance
+	case models.StatusCancelled:
+		a.stats.Cancelled++
+	}
+}
+
+func (a *statsAccumulator) result() models.TripStats {
+	stats := a.stats
+	if a.accepted > 0 {
+		stats.AvgWaitToAcceptSeconds = a.waitSum / float64(a.accepted)
+	}
+	if stats.Completed > 0 {
+		stats.AvgFare = stats.Revenue / float64(stats.Completed)
+		stats.AvgDistance = a.distSum / float64(stats.Completed)
+	}
+	stats.SetRates()
+	return stats
+}
+
+type zoneIndex struct {
+	lat, lng int64
+}
+
+// GetTripReport aggregates trips like PostgresDBRepo, truncating buckets in UTC.
+func (m *MemoryDBRepo) GetTripReport(ctx context.Context, filter TripReportFilter) (models.TripReport, error) {
+	trips, err := m.filterTrips(ctx, func(trip models.Trip) bool {
+		if trip.CreatedAt.Before(filter.From) || !trip.CreatedAt.Before(filter.To) {
+			return false
+		}
+		return filter.Zone == nil || filter.Zone.Contains(trip.OriginLat, trip.OriginLng)
+	})
+	if err != nil {
+		return models.TripReport{}, err
+	}
+
+	var summary statsAccumulator
+	buckets := make(map[time.Time]*statsAccumulator)
+	zones := make(map[zoneIndex]*statsAccumulator)
+	payments := make(map[string]*models.PaymentMethodRevenue)
+	for _, trip := range trips {
+		summary.add(trip)
+
+		start := trip.CreatedAt.UTC().Truncate(time.Hour)
+		if filter.Interval == models.IntervalDay {
+			start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		}
+		if buckets[start] == nil {
+			buckets[start] = &statsAccumulator{}
+		}
+		buckets[start].add(trip)
+
+		if filter.ZoneCellSize > 0 {
+			index := zoneIndex{
+				lat: int64(math.Floor(trip.OriginLat / filter.ZoneCellSize)),
+				lng: int64(math.Floor(trip.OriginLng / filter.ZoneCellSize)),
+			}
+			if zones[index] == nil {
+				zones[index] = &statsAccumulator{}
+			}
+			zones[index].add(trip)
+		}
+
+		if trip.Status == models.StatusCompleted {
+			if payments[trip.PaymentMethod] == nil {
+				payments[trip.PaymentMethod] = &models.PaymentMethodRevenue{PaymentMethod: trip.PaymentMethod}
+			}
+			payments[trip.PaymentMethod].Trips++
+			payments[trip.PaymentMethod].Revenue += trip.Fare
+		}
+	}
+
+	report := models.TripReport{
+		From:                   filter.From,
+		To:                     filter.To,
+		Interval:               filter.Interval,
+		Summary:                summary.result(),
+		Buckets:                []models.TripReportBucket{},
+		Zones:                  []models.ZoneStats{},
+		RevenueByPaymentMethod: []models.PaymentMethodRevenue{},
+	}
+	for start, acc := range buckets {
+		report.Buckets = append(report.Buckets, models.TripReportBucket{Start: start, TripStats: acc.result()})
+	}
+	sort.Slice(report.Buckets, func(i, j int) bool { return report.Buckets[i].Start.Before(report.Buckets[j].Start) })
+
+	indexes := make([]zoneIndex, 0, len(zones))
+	for index := range zones {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		if indexes[i].lat != indexes[j].lat {
+			return indexes[i].lat < indexes[j].lat
+		}
+		return indexes[i].lng < indexes[j].lng
+	})
+	for _, index := range indexes {
+		report.Zones = append(report.Zones, models.ZoneStats{
+			Cell:      zoneCell(index.lat, index.lng, filter.ZoneCellSize),
+			TripStats: zones[index].result(),
+		})
+	}
+
+	for _, revenue := range payments {
+		report.RevenueByPaymentMethod = append(report.RevenueByPaymentMethod, *revenue)
+	}
+	sort.Slice(report.RevenueByPaymentMethod, func(i, j int) bool {
+		a, b := report.RevenueByPaymentMethod[i], report.RevenueByPaymentMethod[j]
+		if a.Revenue != b.Revenue {
+			return a.Revenue > b.Revenue
+		}
+		return a.PaymentMethod < b.PaymentMethod
+	})
+	return report, nil
+}
+
+func (m *MemoryDBRepo) ExportTrips(ctx context.Context, filter TripExportFilter, afterID int, limit int) ([]models.Trip, error) {
+	trips, err := m.filterTrips(ctx, func(trip models.Trip) bool {
+		return trip.ID > afterID && exportMatches(filter, trip)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(trips) > limit {
+		trips = trips[:limit]
+	}
+	return trips, nil
+}
+
+func exportMatches(filter TripExportFilter, trip models.Trip) bool {
+	if !filter.From.IsZero() && trip.CreatedAt.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && !trip.CreatedAt.Before(filter.To) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, trip.Status) {
+		return false
+	}
+	if filter.PassengerID != 0 && trip.PassengerID != filter.PassengerID {
+		return false
+	}
+	if filter.DriverID != 0 && (!trip.DriverID.Valid || int(trip.DriverID.Int32) != filter.DriverID) {
+		return false
+	}
+	if filter.PaymentMethod != "" && trip.PaymentMethod != filter.PaymentMethod {
+		return false
+	}
+	return filter.Zone == nil || filter.Zone.Contains(trip.OriginLat, trip.OriginLng)
+}
+
+func (m *MemoryDBRepo) CreateChatMessage(ctx context.Context, message models.ChatMessage) (models.ChatMessage, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.ChatMessage{}, err
+	}
+	if _, ok := m.data.trips[message.TripID]; !ok {
+		return models.ChatMessage{}, errors.New("trip does not exist")
+	}
+
+	m.data.nextChatID++
+	message.ID = m.data.nextChatID
+	message.CreatedAt = time.Now()
+	m.data.chat = append(m.data.chat, message)
+	return message, nil
+}
+
+func (m *MemoryDBRepo) GetChatMessages(ctx context.Context, tripID int, afterID int64, limit int) ([]models.ChatMessage, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Messages are appended in id order.
+	messages := []models.ChatMessage{}
+	for _, message := range m.data.chat {
+		if message.TripID == tripID && message.ID > afterID && len(messages) < limit {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (m *MemoryDBRepo) MarkChatMessages(ctx context.Context, tripID int, recipientID int, upToID int64, kind models.ChatReceiptKind, at time.Time) (int64, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var updated int64
+	for i := range m.data.chat {
+		message := &m.data.chat[i]
+		if message.TripID != tripID || message.RecipientID != recipientID || message.ID > upToID {
+			continue
+		}
+		switch {
+		case kind == models.ReceiptRead && !message.ReadAt.Valid:
+			message.ReadAt = sql.NullTime{Time: at, Valid: true}
+			if !message.DeliveredAt.Valid {
+				message.DeliveredAt = message.ReadAt
+			}
+			updated++
+		case kind == models.ReceiptDelivered && !message.DeliveredAt.Valid:
+			message.DeliveredAt = sql.NullTime{Time: at, Valid: true}
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (m *MemoryDBRepo) CreateIncident(ctx context.Context, incident models.Incident) (models.Incident, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Incident{}, err
+	}
+	if _, ok := m.data.trips[incident.TripID]; !ok {
+		return models.Incident{}, errors.New("trip does not exist")
+	}
+	for _, existing := range m.data.incidents {
+		if existing.TripID == incident.TripID && existing.Status == models.IncidentOpen {
+			return models.Incident{}, errors.New("trip already has an open incident")
+		}
+	}
+
+	incident.ID = int64(len(m.data.incidents) + 1)
+	incident.Status = models.IncidentOpen
+	incident.CreatedAt = time.Now()
+	m.data.incidents = append(m.data.incidents, incident)
+	return incident, nil
+}
+
+func (m *MemoryDBRepo) GetIncident(ctx context.Context, incidentID int64) (models.Incident, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Incident{}, err
+	}
+	// Incident ids are their position in the slice plus one.
+	if incidentID < 1 || incidentID > int64(len(m.data.incidents)) {
+		return models.Incident{}, sql.ErrNoRows
+	}
+	return m.data.incidents[incidentID-1], nil
+}
+
+func (m *MemoryDBRepo) GetOpenIncident(ctx context.Context, tripID int) (models.Incident, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Incident{}, err
+	}
+	for _, incident := range m.data.incidents {
+		if incident.TripID == tripID && incident.Status == models.IncidentOpen {
+			return incident, nil
+		}
+	}
+	return models.Incident{}, sql.ErrNoRows
+}
+
+func (m *MemoryDBRepo) ListIncidents(ctx context.Context, status models.IncidentStatus, tripID int, limit int) ([]models.Incident, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	incidents := []models.Incident{}
+	for i := len(m.data.incidents) - 1; i >= 0 && len(incidents) < limit; i-- {
+		incident := m.data.incidents[i]
+		if (status == "" || incident.Status == status) && (tripID == 0 || incident.TripID == tripID) {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+func (m *MemoryDBRepo) CloseIncident(ctx context.Context, incidentID int64, closedBy int, resolution string, at time.Time) (bool, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	if incidentID < 1 || incidentID > int64(len(m.data.incidents)) {
+		return false, nil
+	}
+	incident := &m.data.incidents[incidentID-1]
+	if incident.Status != models.IncidentOpen {
+		return false, nil
+	}
+	incident.Status = models.IncidentClosed
+	incident.ClosedBy = sql.NullInt32{Int32: int32(closedBy), Valid: true}
+	incident.Resolution = sql.NullString{String: resolution, Valid: true}
+	incident.ClosedAt = sql.NullTime{Time: at, Valid: true}
+	return true, nil
+}
+
+func (m *MemoryDBRepo) AddTrackPoints(ctx context.Context, points []models.TrackPoint) (int64, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	var added int64
+	for _, point := range points {
+		duplicate := slices.ContainsFunc(m.data.track, func(p models.TrackPoint) bool {
+			return p.IncidentID == point.IncidentID && p.UserID == point.UserID && p.ReportedAt == point.ReportedAt
+		})
+		if duplicate {
+			continue
+		}
+		m.data.nextTrackID++
+		point.ID = m.data.nextTrackID
+		m.data.track = append(m.data.track, point)
+		added++
+	}
+	return added, nil
+}
+
+func (m *MemoryDBRepo) GetTrackPoints(ctx context.Context, incidentID int64, afterID int64, limit int) ([]models.TrackPoint, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	points := []models.TrackPoint{}
+	for _, point := range m.data.track {
+		if point.IncidentID == incidentID && point.ID > afterID && len(points) < limit {
+			points = append(points, point)
+		}
+	}
+	return points, nil
+}
+
+func (m *MemoryDBRepo) CreateShareLink(ctx context.Context, link models.ShareLink) (models.ShareLink, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.ShareLink{}, err
+	}
+	if _, ok := m.data.trips[link.TripID]; !ok {
+		return models.ShareLink{}, errors.New("trip does not exist")
+	}
+	for _, existing := range m.data.shares {
+		if existing.TokenHash == link.TokenHash {
+			return models.ShareLink{}, errors.New("duplicate share token")
+		}
+	}
+
+	link.ID = int64(len(m.data.shares) + 1)
+	link.CreatedAt = time.Now()
+	m.data.shares = append(m.data.shares, link)
+	return link, nil
+}
+
+func (m *MemoryDBRepo) GetShareLinkByTokenHash(ctx context.Context, tokenHash string) (models.ShareLink, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.ShareLink{}, err
+	}
+	for _, link := range m.data.shares {
+		if link.TokenHash == tokenHash {
+			return link, nil
+		}
+	}
+	return models.ShareLink{}, sql.ErrNoRows
+}
+
+func (m *MemoryDBRepo) GetShareLinks(ctx context.Context, tripID int) ([]models.ShareLink, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	links := []models.ShareLink{}
+	for _, link := range m.data.shares {
+		if link.TripID == tripID {
+			links = append(links, link)
+		}
+	}
+	return links, nil
+}
+
+func (m *MemoryDBRepo) RevokeShareLink(ctx context.Context, tripID int, shareID int64, at time.Time) (bool, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	for i := range m.data.shares {
+		link := &m.data.shares[i]
+		if link.ID == shareID && link.TripID == tripID && !link.RevokedAt.Valid {
+			link.RevokedAt = sql.NullTime{Time: at, Valid: true}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryDBRepo) CreateTip(ctx context.Context, tip models.Tip) (models.Tip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Tip{}, err
+	}
+	if _, ok := m.data.trips[tip.TripID]; !ok {
+		return models.Tip{}, errors.New("trip does not exist")
+	}
+	if _, ok := m.data.tips[tip.TripID]; ok {
+		return models.Tip{}, errors.New("trip already has a tip")
+	}
+	if tip.Amount <= 0 {
+		return models.Tip{}, errors.New("tip amount must be positive")
+	}
+
+	tip.CreatedAt = time.Now()
+	m.data.tips[tip.TripID] = tip
+	return tip, nil
+}
+
+func (m *MemoryDBRepo) GetTip(ctx context.Context, tripID int) (models.Tip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.Tip{}, err
+	}
+	tip, ok := m.data.tips[tripID]
+	if !ok {
+		return models.Tip{}, sql.ErrNoRows
+	}
+	return tip, nil
+}
+
+func (m *MemoryDBRepo) GetUncreditedTips(ctx context.Context, createdBefore time.Time, limit int) ([]models.Tip, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	tips := []models.Tip{}
+	for _, tip := range m.data.tips {
+		if !tip.CreditedAt.Valid && tip.CreatedAt.Before(createdBefore) {
+			tips = append(tips, tip)
+		}
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].CreatedAt.Before(tips[j].CreatedAt) })
+	if len(tips) > limit {
+		tips = tips[:limit]
+	}
+	return tips, nil
+}
+
+func (m *MemoryDBRepo) MarkTipCredited(ctx context.Context, tripID int, at time.Time) error {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if tip, ok := m.data.tips[tripID]; ok && !tip.CreditedAt.Valid {
+		tip.CreditedAt = sql.NullTime{Time: at, Valid: true}
+		m.data.tips[tripID] = tip
+	}
+	return nil
+}
+
+func (m *MemoryDBRepo) RecordDriverOffer(ctx context.Context, offer models.DriverOffer) (models.DriverOffer, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return models.DriverOffer{}, err
+	}
+	if _, ok := m.data.trips[offer.TripID]; !ok {
+		return models.DriverOffer{}, errors.New("trip does not exist")
+	}
+	switch offer.Outcome {
+	case models.OfferAccepted, models.OfferRejected, models.OfferTimedOut, models.OfferCancelled:
+	default:
+		return models.DriverOffer{}, errors.New("unknown offer outcome")
+	}
+
+	m.data.nextOfferID++
+	offer.ID = m.data.nextOfferID
+	if offer.DecidedAt.IsZero() {
+		offer.DecidedAt = time.Now()
+	}
+	m.data.offers = append(m.data.offers, offer)
+	return offer, nil
+}
+
+func (m *MemoryDBRepo) GetDriverOfferStats(ctx context.Context, filter DriverOfferFilter) (map[int]models.DriverOfferStats, error) {
+	defer m.lock()()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	stats := make(map[int]models.DriverOfferStats)
+	for _, offer := range m.data.offers {
+		if offer.DecidedAt.Before(filter.From) || !offer.DecidedAt.Before(filter.To) {
+			continue
+		}
+		if len(filter.DriverIDs) > 0 && !slices.Contains(filter.DriverIDs, offer.DriverID) {
+			continue
+		}
+		s := stats[offer.DriverID]
+		s.DriverID = offer.DriverID
+		s.Add(offer.Outcome)
+		stats[offer.DriverID] = s
+	}
+	for driverID, s := range stats {
+		s.SetRates()
+		stats[driverID] = s
+	}
+	return stats, nil
+}
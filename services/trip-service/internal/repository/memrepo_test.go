@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+	"trip-service/internal/models"
+)
+
+func TestMemoryDBRepoWithTx(t *testing.T) {
+	errFail := errors.New("fail")
+	tests := []struct {
+		name      string
+		fnErr     error
+		wantTrips int
+		wantEvent bool
+	}{
+		{name: "commit", wantTrips: 1, wantEvent: true},
+		{name: "rollback", fnErr: errFail, wantTrips: 0, wantEvent: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := NewMemoryDBRepo()
+
+			err := repo.WithTx(ctx, func(tx DatabaseRepo) error {
+				trip, err := tx.CreateTrip(ctx, NewTripDTO{PassengerID: 1}, 1000, 5)
+				if err != nil {
+					return err
+				}
+				if err := tx.EnqueueEvent(ctx, models.OutboxEvent{EventName: "trip.requested"}); err != nil {
+					return err
+				}
+				// A nested WithTx joins the outer transaction.
+				if err := tx.WithTx(ctx, func(inner DatabaseRepo) error {
+					return inner.UpdateTripStatus(ctx, models.StatusCancelled, trip.ID)
+				}); err != nil {
+					return err
+				}
+				return tt.fnErr
+			})
+			if !errors.Is(err, tt.fnErr) {
+				t.Fatalf("WithTx error = %v, want %v", err, tt.fnErr)
+			}
+
+			trips, err := repo.GetTripsByPassenger(ctx, 1)
+			if err != nil {
+				t.Fatalf("GetTripsByPassenger: %v", err)
+			}
+			if len(trips) != tt.wantTrips {
+				t.Fatalf("got %d trips, want %d", len(trips), tt.wantTrips)
+			}
+			if tt.wantTrips > 0 && trips[0].Status != models.StatusCancelled {
+				t.Errorf("status = %s, want CANCELLED from the nested transaction", trips[0].Status)
+			}
+			events, err := repo.FetchPendingEvents(ctx, 10)
+			if err != nil {
+				t.Fatalf("FetchPendingEvents: %v", err)
+			}
+			if got := len(events) == 1; got != tt.wantEvent {
+				t.Errorf("pending events = %v, want event: %v", events, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestMemoryDBRepoGetTripNotFound(t *testing.T) {
+	_, err := NewMemoryDBRepo().GetTrip(context.Background(), 42)
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetTrip error = %v, want sql.ErrNoRows", err)
+	}
+}
+
+func TestMemoryDBRepoIdempotencyKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryDBRepo()
+	lease := time.Now().Add(time.Minute)
+	record := models.IdempotencyRecord{UserID: 1, Key: "k", Operation: "CreateTrip", RequestHash: "h", LockedUntil: &lease}
+	past := record.CreatedAt // zero time: nothing has expired
+
+	if _, reserved, err := repo.ReserveIdempotencyKey(ctx, record, past); err != nil || !reserved {
+		t.Fatalf("first reserve = %v, %v, want reserved", reserved, err)
+	}
+	if existing, reserved, err := repo.ReserveIdempotencyKey(ctx, record, past); err != nil || reserved || existing.Response != nil {
+		t.Fatalf("second reserve = %+v, %v, %v, want in-flight record", existing, reserved, err)
+	}
+	if err := repo.CompleteIdempotencyKey(ctx, 1, "k", []byte("ok")); err != nil {
+		t.Fatalf("CompleteIdempotencyKey: %v", err)
+	}
+	// Completed keys survive a release and replay the stored response.
+	if err := repo.ReleaseIdempotencyKey(ctx, 1, "k"); err != nil {
+		t.Fatalf("ReleaseIdempotencyKey: %v", err)
+	}
+	existing, reserved, err := repo.ReserveIdempotencyKey(ctx, record, past)
+	if err != nil || reserved || string(existing.Response) != "ok" {
+		t.Fatalf("reserve after completion = %+v, %v, %v, want stored response", existing, reserved, err)
+	}
+
+	// An unfinished reservation is taken over once its lease runs out.
+	stale := time.Now().Add(-time.Second)
+	crashed := models.IdempotencyRecord{UserID: 1, Key: "crashed", Operation: "CreateTrip", RequestHash: "h", LockedUntil: &stale}
+	if _, reserved, err := repo.ReserveIdempotencyKey(ctx, crashed, past); err != nil || !reserved {
+		t.Fatalf("reserve crashed = %v, %v, want reserved", reserved, err)
+	}
+	crashed.LockedUntil = &lease
+	if _, reserved, err := repo.ReserveIdempotencyKey(ctx, crashed, past); err != nil || !reserved {
+		t.Fatalf("reserve after lease = %v, %v, want taken over", reserved, err)
+	}
+}
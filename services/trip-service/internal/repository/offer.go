@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"trip-service/internal/models"
+)
+
+func (m *PostgresDBRepo) RecordDriverOffer(ctx context.Context, offer models.DriverOffer) (models.DriverOffer, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into driver_offers (trip_id, driver_id, outcome, offered_at, decided_at)
+		values ($1, $2, $3, $4, $5) returning id`
+
+	if offer.DecidedAt.IsZero() {
+		offer.DecidedAt = time.Now()
+	}
+	err := m.conn().QueryRowContext(ctx, query,
+		offer.TripID,
+		offer.DriverID,
+		offer.Outcome,
+		offer.OfferedAt,
+		offer.DecidedAt,
+	).Scan(&offer.ID)
+	if err != nil {
+		return models.DriverOffer{}, err
+	}
+	return offer, nil
+}
+
+// GetDriverOfferStats counts the outcomes of the offers matching filter per
+// driver. Drivers without one are missing from the map.
+func (m *PostgresDBRepo) GetDriverOfferStats(ctx context.Context, filter DriverOfferFilter) (map[int]models.DriverOfferStats, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	ids := make([]int32, len(filter.DriverIDs))
+	for i, id := range filter.DriverIDs {
+		ids[i] = int32(id)
+	}
+	query := `select driver_id,
+			count(*) filter (where outcome = 'accepted'),
+			count(*) filter (where outcome = 'rejected'),
+			count(*) filter (where outcome = 'timed_out'),
+			count(*) filter (where outcome = 'cancelled')
+		from driver_offers
+		where decided_at >= $1 and decided_at < $2
+			and (cardinality($3::int[]) = 0 or driver_id = any($3))
+		group by driver_id`
+	rows, err := m.conn().QueryContext(ctx, query, filter.From, filter.To, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[int]models.DriverOfferStats)
+	for rows.Next() {
+		var s models.DriverOfferStats
+		if err := rows.Scan(&s.DriverID, &s.Accepted, &s.Rejected, &s.TimedOut, &s.Cancelled); err != nil {
+			return nil, err
+		}
+		s.SetRates()
+		stats[s.DriverID] = s
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"trip-service/internal/models"
+)
+
+// tripStatsColumns aggregates the trips of a group into the columns scanned by
+// scanTripStats.
+const tripStatsColumns = `count(*),
+	count(*) filter (where status = 'COMPLETED'),
+	count(*) filter (where status = 'CANCELLED'),
+	coalesce(avg(extract(epoch from accepted_at - created_at)) filter (where accepted_at is not null), 0)::float8,
+	coalesce(avg(fare) filter (where status = 'COMPLETED'), 0)::float8,
+	coalesce(avg(distance) filter (where status = 'COMPLETED'), 0)::float8,
+	coalesce(sum(fare) filter (where status = 'COMPLETED'), 0)::float8`
+
+// tripReportWhere selects the trips of a report; its parameters are built by
+// reportArgs.
+const tripReportWhere = `where created_at >= $1 and created_at < $2
+	and ($3 = false or (origin_lat between $4 and $5 and origin_lng between $6 and $7))`
+
+func reportArgs(filter TripReportFilter, extra ...any) []any {
+	var zone models.BoundingBox
+	if filter.Zone != nil {
+		zone = *filter.Zone
+	}
+	args := []any{filter.From, filter.To, filter.Zone != nil, zone.MinLat, zone.MaxLat, zone.MinLng, zone.MaxLng}
+	return append(args, extra...)
+}
+
+// scanTripStats scans tripStatsColumns, preceded by dest.
+func scanTripStats(row interface{ Scan(dest ...any) error }, stats *models.TripStats, dest ...any) error {
+	dest = append(dest,
+		&stats.Trips,
+		&stats.Completed,
+		&stats.Cancelled,
+		&stats.AvgWaitToAcceptSeconds,
+		&stats.AvgFare,
+		&stats.AvgDistance,
+		&stats.Revenue,
+	)
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+	stats.SetRates()
+	return nil
+}
+
+// zoneCell returns the grid cell with the given indexes, as computed by
+// floor(coordinate / size).
+func zoneCell(latIndex, lngIndex int64, size float64) models.BoundingBox {
+	return models.BoundingBox{
+		MinLat: float64(latIndex) * size,
+		MinLng: float64(lngIndex) * size,
+		MaxLat: float64(latIndex+1) * size,
+		MaxLng: float64(lngIndex+1) * size,
+	}
+}
+
+// GetTripReport aggregates trips in SQL. Buckets are truncated in the
+// database session's time zone.
+func (m *PostgresDBRepo) GetTripReport(ctx context.Context, filter TripReportFilter) (models.TripReport, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	report := models.TripReport{
+		From:                   filter.From,
+		To:                     filter.To,
+		Interval:               filter.Interval,
+		Buckets:                []models.TripReportBucket{},
+		Zones:                  []models.ZoneStats{},
+		RevenueByPaymentMethod: []models.PaymentMethodRevenue{},
+	}
+
+	query := `select ` + tripStatsColumns + ` from trips ` + tripReportWhere
+	if err := scanTripStats(m.conn().QueryRowContext(ctx, query, reportArgs(filter)...), &report.Summary); err != nil {
+		return models.TripReport{}, err
+	}
+
+	query = `select date_trunc($8, created_at) as bucket, ` + tripStatsColumns + ` from trips ` + tripReportWhere + `
+		group by bucket order by bucket`
+	rows, err := m.conn().QueryContext(ctx, query, reportArgs(filter, string(filter.Interval))...)
+	if err != nil {
+		return models.TripReport{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bucket models.TripReportBucket
+		if err := scanTripStats(rows, &bucket.TripStats, &bucket.Start); err != nil {
+			return models.TripReport{}, err
+		}
+		report.Buckets = append(report.Buckets, bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return models.TripReport{}, err
+	}
+
+	if filter.ZoneCellSize > 0 {
+		query = `select floor(origin_lat / $8)::bigint as lat_cell, floor(origin_lng / $8)::bigint as lng_cell, ` +
+			tripStatsColumns + ` from trips ` + tripReportWhere + `
+			group by lat_cell, lng_cell order by lat_cell, lng_cell`
+		zoneRows, err := m.conn().QueryContext(ctx, query, reportArgs(filter, filter.ZoneCellSize)...)
+		if err != nil {
+			return models.TripReport{}, err
+		}
+		defer zoneRows.Close()
+		for zoneRows.Next() {
+			var zone models.ZoneStats
+			var latIndex, lngIndex int64
+			if err := scanTripStats(zoneRows, &zone.TripStats, &latIndex, &lngIndex); err != nil {
+				return models.TripReport{}, err
+			}
+			zone.Cell = zoneCell(latIndex, lngIndex, filter.ZoneCellSize)
+			report.Zones = append(report.Zones, zone)
+		}
+		if err := zoneRows.Err(); err != nil {
+			return models.TripReport{}, err
+		}
+	}
+
+	query = `select payment_method, count(*), coalesce(sum(fare), 0)::float8 from trips ` + tripReportWhere + `
+		and status = 'COMPLETED'
+		group by payment_method order by 3 desc, payment_method`
+	paymentRows, err := m.conn().QueryContext(ctx, query, reportArgs(filter)...)
+	if err != nil {
+		return models.TripReport{}, err
+	}
+	defer paymentRows.Close()
+	for paymentRows.Next() {
+		var revenue models.PaymentMethodRevenue
+		if err := paymentRows.Scan(&revenue.PaymentMethod, &revenue.Trips, &revenue.Revenue); err != nil {
+			return models.TripReport{}, err
+		}
+		report.RevenueByPaymentMethod = append(report.RevenueByPaymentMethod, revenue)
+	}
+	if err := paymentRows.Err(); err != nil {
+		return models.TripReport{}, err
+	}
+	return report, nil
+}
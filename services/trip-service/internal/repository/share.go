@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"trip-service/internal/models"
+)
+
+func (m *PostgresDBRepo) CreateShareLink(ctx context.Context, link models.ShareLink) (models.ShareLink, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into trip_shares (trip_id, created_by, token_hash, created_at, expires_at)
+		values ($1, $2, $3, $4, $5) returning id`
+
+	link.CreatedAt = time.Now()
+	err := m.conn().QueryRowContext(ctx, query,
+		link.TripID,
+		link.CreatedBy,
+		link.TokenHash,
+		link.CreatedAt,
+		link.ExpiresAt,
+	).Scan(&link.ID)
+	if err != nil {
+		return models.ShareLink{}, err
+	}
+	return link, nil
+}
+
+// GetShareLinkByTokenHash returns the link with the token hash, or
+// sql.ErrNoRows.
+func (m *PostgresDBRepo) GetShareLinkByTokenHash(ctx context.Context, tokenHash string) (models.ShareLink, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select id, trip_id, created_by, token_hash, created_at, expires_at, revoked_at
+		from trip_shares where token_hash = $1`
+
+	var link models.ShareLink
+	err := m.conn().QueryRowContext(ctx, query, tokenHash).Scan(
+		&link.ID,
+		&link.TripID,
+		&link.CreatedBy,
+		&link.TokenHash,
+		&link.CreatedAt,
+		&link.ExpiresAt,
+		&link.RevokedAt,
+	)
+	if err != nil {
+		return models.ShareLink{}, err
+	}
+	return link, nil
+}
+
+func (m *PostgresDBRepo) GetShareLinks(ctx context.Context, tripID int) ([]models.ShareLink, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select id, trip_id, created_by, token_hash, created_at, expires_at, revoked_at
+		from trip_shares where trip_id = $1 order by id`
+	rows, err := m.conn().QueryContext(ctx, query, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := []models.ShareLink{}
+	for rows.Next() {
+		var link models.ShareLink
+		if err = rows.Scan(
+			&link.ID,
+			&link.TripID,
+			&link.CreatedBy,
+			&link.TokenHash,
+			&link.CreatedAt,
+			&link.ExpiresAt,
+			&link.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
+
+// RevokeShareLink revokes one of the trip's links and reports whether it was
+// still unrevoked.
+func (m *PostgresDBRepo) RevokeShareLink(ctx context.Context, tripID int, shareID int64, at time.Time) (bool, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update trip_shares set revoked_at = $1 where id = $2 and trip_id = $3 and revoked_at is null`
+	result, err := m.conn().ExecContext(ctx, query, at, shareID, tripID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"trip-service/internal/models"
+)
+
+func (m *PostgresDBRepo) CreateTip(ctx context.Context, tip models.Tip) (models.Tip, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `insert into trip_tips (trip_id, passenger_id, driver_id, amount, payment_method, charge_ref, created_at)
+		values ($1, $2, $3, $4, $5, $6, $7)`
+
+	tip.CreatedAt = time.Now()
+	_, err := m.conn().ExecContext(ctx, query,
+		tip.TripID,
+		tip.PassengerID,
+		tip.DriverID,
+		tip.Amount,
+		tip.PaymentMethod,
+		tip.ChargeRef,
+		tip.CreatedAt,
+	)
+	if err != nil {
+		return models.Tip{}, err
+	}
+	return tip, nil
+}
+
+// GetTip returns the trip's tip, or sql.ErrNoRows if it has none.
+func (m *PostgresDBRepo) GetTip(ctx context.Context, tripID int) (models.Tip, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select trip_id, passenger_id, driver_id, amount, payment_method, charge_ref, created_at, credited_at
+		from trip_tips where trip_id = $1`
+
+	var tip models.Tip
+	err := m.conn().QueryRowContext(ctx, query, tripID).Scan(
+		&tip.TripID,
+		&tip.PassengerID,
+		&tip.DriverID,
+		&tip.Amount,
+		&tip.PaymentMethod,
+		&tip.ChargeRef,
+		&tip.CreatedAt,
+		&tip.CreditedAt,
+	)
+	if err != nil {
+		return models.Tip{}, err
+	}
+	return tip, nil
+}
+
+// GetUncreditedTips returns up to limit tips created before the cutoff that
+// haven't reached the driver's revenue yet, oldest first.
+func (m *PostgresDBRepo) GetUncreditedTips(ctx context.Context, createdBefore time.Time, limit int) ([]models.Tip, error) {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `select trip_id, passenger_id, driver_id, amount, payment_method, charge_ref, created_at, credited_at
+		from trip_tips where credited_at is null and created_at < $1 order by created_at limit $2`
+	rows, err := m.conn().QueryContext(ctx, query, createdBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tips := []models.Tip{}
+	for rows.Next() {
+		var tip models.Tip
+		if err = rows.Scan(
+			&tip.TripID,
+			&tip.PassengerID,
+			&tip.DriverID,
+			&tip.Amount,
+			&tip.PaymentMethod,
+			&tip.ChargeRef,
+			&tip.CreatedAt,
+			&tip.CreditedAt,
+		); err != nil {
+			return nil, err
+		}
+		tips = append(tips, tip)
+	}
+	return tips, rows.Err()
+}
+
+func (m *PostgresDBRepo) MarkTipCredited(ctx context.Context, tripID int, at time.Time) error {
+	ctx, cancel := m.withTimeout(ctx)
+	defer cancel()
+
+	query := `update trip_tips set credited_at = $1 where trip_id = $2 and credited_at is null`
+	_, err := m.conn().ExecContext(ctx, query, at, tripID)
+	return err
+}
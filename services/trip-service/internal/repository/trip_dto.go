@@ -1,5 +1,10 @@
 package repository
 
+import (
+	"time"
+	"trip-service/internal/models"
+)
+
 type NewTripDTO struct {
 	PassengerID   int     `json:"passenger_id"`
 	OriginLat     float64 `json:"origin_lat"`
@@ -7,6 +12,11 @@ type NewTripDTO struct {
 	DestLat       float64 `json:"dest_lat"`
 	DestLng       float64 `json:"dest_lng"`
 	PaymentMethod string  `json:"payment_method"`
+	VehicleClass  string  `json:"vehicle_class"`
+	// OriginPlaceID and DestPlaceID name saved places of the passenger whose
+	// coordinates replace the ones above.
+	OriginPlaceID int `json:"origin_place_id,omitempty"`
+	DestPlaceID   int `json:"dest_place_id,omitempty"`
 }
 
 type ReviewDTO struct {
@@ -14,3 +24,34 @@ type ReviewDTO struct {
 	Comment     string `json:"comment,omitempty"`
 	Rating      int    `json:"rating"`
 }
+
+// TripReportFilter selects the trips created in [From, To), optionally only
+// those starting inside Zone. ZoneCellSize > 0 adds a breakdown by grid cells
+// of that size in degrees.
+type TripReportFilter struct {
+	From         time.Time
+	To           time.Time
+	Interval     models.ReportInterval
+	Zone         *models.BoundingBox
+	ZoneCellSize float64
+}
+
+// TripExportFilter selects trips to export. Zero values don't filter; From and
+// To bound created_at as [From, To).
+type TripExportFilter struct {
+	From          time.Time
+	To            time.Time
+	Statuses      []models.TripStatus
+	PassengerID   int
+	DriverID      int
+	PaymentMethod string
+	Zone          *models.BoundingBox
+}
+
+// DriverOfferFilter selects the offers decided in [From, To), only those made
+// to DriverIDs when it isn't empty.
+type DriverOfferFilter struct {
+	From      time.Time
+	To        time.Time
+	DriverIDs []int
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	user_service "user-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Block List gRPC Methods
+// ============================================
+
+func toPBBlock(block user_service.UserBlock) *pb.UserBlock {
+	return &pb.UserBlock{
+		UserId:        int32(block.UserId),
+		BlockedUserId: int32(block.BlockedUserId),
+		Reason:        block.Reason,
+		CreatedAt:     formatTime(block.CreatedAt),
+	}
+}
+
+// blockError maps service errors to gRPC status codes.
+func blockError(ctx context.Context, msg string, err error) error {
+	switch {
+	case errors.Is(err, user_service.ErrInvalidBlock):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, user_service.ErrBlockNotFound), errors.Is(err, user_service.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, user_service.ErrBlockLimit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger.WithContext(ctx).ErrorContext(ctx, msg, "error", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *UserServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC BlockUser called", "user_id", req.UserId, "blocked_user_id", req.BlockedUserId)
+
+	block, err := s.service.BlockUser(ctx, int(req.UserId), int(req.BlockedUserId), req.Reason)
+	if err != nil {
+		return nil, blockError(ctx, "Failed to block user", err)
+	}
+
+	return &pb.BlockUserResponse{
+		Success: true,
+		Message: "User blocked successfully",
+		Block:   toPBBlock(block),
+	}, nil
+}
+
+func (s *UserServer) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC UnblockUser called", "user_id", req.UserId, "blocked_user_id", req.BlockedUserId)
+
+	if err := s.service.UnblockUser(ctx, int(req.UserId), int(req.BlockedUserId)); err != nil {
+		return nil, blockError(ctx, "Failed to unblock user", err)
+	}
+
+	return &pb.UnblockUserResponse{
+		Success: true,
+		Message: "User unblocked successfully",
+	}, nil
+}
+
+func (s *UserServer) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	blocks, err := s.service.ListBlockedUsers(ctx, int(req.UserId))
+	if err != nil {
+		return nil, blockError(ctx, "Failed to list blocked users", err)
+	}
+
+	pbBlocks := make([]*pb.UserBlock, 0, len(blocks))
+	for _, block := range blocks {
+		pbBlocks = append(pbBlocks, toPBBlock(block))
+	}
+
+	return &pb.ListBlockedUsersResponse{
+		Success:    true,
+		Message:    "Blocked users retrieved successfully",
+		Blocks:     pbBlocks,
+		TotalCount: int32(len(pbBlocks)),
+	}, nil
+}
+
+func (s *UserServer) FilterBlockedUsers(ctx context.Context, req *pb.FilterBlockedUsersRequest) (*pb.FilterBlockedUsersResponse, error) {
+	candidates := make([]int, 0, len(req.CandidateIds))
+	for _, id := range req.CandidateIds {
+		candidates = append(candidates, int(id))
+	}
+
+	allowed, err := s.service.FilterBlockedUsers(ctx, int(req.UserId), candidates)
+	if err != nil {
+		return nil, blockError(ctx, "Failed to filter blocked users", err)
+	}
+
+	allowedIds := make([]int32, 0, len(allowed))
+	for _, id := range allowed {
+		allowedIds = append(allowedIds, int32(id))
+	}
+	return &pb.FilterBlockedUsersResponse{
+		Success:    true,
+		Message:    "Candidates filtered successfully",
+		AllowedIds: allowedIds,
+	}, nil
+}
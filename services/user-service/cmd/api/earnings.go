@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	user_service "user-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Driver Earnings gRPC Methods
+// ============================================
+
+func (s *UserServer) CreditDriverEarning(ctx context.Context, req *pb.CreditDriverEarningRequest) (*pb.CreditDriverEarningResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC CreditDriverEarning called", "driver_id", req.DriverId, "trip_id", req.TripId, "kind", req.Kind)
+
+	credited, err := s.service.CreditDriverEarning(ctx, int(req.DriverId), int(req.TripId), req.Kind, req.Amount)
+	if err != nil {
+		switch {
+		case errors.Is(err, user_service.ErrInvalidEarning):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, user_service.ErrDriverNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		logger.WithContext(ctx).ErrorContext(ctx, "Failed to credit driver earning", "error", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	message := "Earning credited"
+	if !credited {
+		message = "Earning was already credited"
+	}
+	return &pb.CreditDriverEarningResponse{
+		Success:  true,
+		Message:  message,
+		Credited: credited,
+	}, nil
+}
@@ -303,6 +303,7 @@ func (s *UserServer) UpdateVehicle(ctx context.Context, req *pb.UpdateVehicleReq
 		VehicleType:  req.VehicleType,
 		Seats:        int(req.Seats),
 		Status:       req.Status,
+		Verify:       req.Verify,
 	}
 
 	vehicle, err := s.service.UpdateVehicle(ctx, int(req.VehicleId), vehicleRequest)
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	user_service "user-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Driver Profile gRPC Methods
+// ============================================
+
+func toPBOfferStats(stats user_service.DriverOfferStats) *pb.DriverOfferStats {
+	return &pb.DriverOfferStats{
+		DriverId:         int32(stats.DriverId),
+		Offers:           stats.Offers,
+		Accepted:         stats.Accepted,
+		Rejected:         stats.Rejected,
+		TimedOut:         stats.TimedOut,
+		Cancelled:        stats.Cancelled,
+		AcceptanceRate:   stats.AcceptanceRate,
+		CancellationRate: stats.CancellationRate,
+		WindowDays:       int32(stats.WindowDays),
+		AsOf:             formatTime(stats.AsOf),
+	}
+}
+
+// offerStatsError maps service errors to gRPC status codes.
+func offerStatsError(ctx context.Context, msg string, err error) error {
+	switch {
+	case errors.Is(err, user_service.ErrInvalidOfferStats):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, user_service.ErrDriverNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	logger.WithContext(ctx).ErrorContext(ctx, msg, "error", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func (s *UserServer) RecordDriverOfferStats(ctx context.Context, req *pb.RecordDriverOfferStatsRequest) (*pb.RecordDriverOfferStatsResponse, error) {
+	in := req.GetStats()
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC RecordDriverOfferStats called", "driver_id", in.GetDriverId(), "as_of", in.GetAsOf())
+
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "stats are required")
+	}
+	asOf, err := time.Parse(time.RFC3339, in.AsOf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "as_of must be an RFC 3339 time")
+	}
+	applied, err := s.service.RecordDriverOfferStats(ctx, user_service.DriverOfferStats{
+		DriverId:         int(in.DriverId),
+		Offers:           in.Offers,
+		Accepted:         in.Accepted,
+		Rejected:         in.Rejected,
+		TimedOut:         in.TimedOut,
+		Cancelled:        in.Cancelled,
+		AcceptanceRate:   in.AcceptanceRate,
+		CancellationRate: in.CancellationRate,
+		WindowDays:       int(in.WindowDays),
+		AsOf:             asOf,
+	})
+	if err != nil {
+		return nil, offerStatsError(ctx, "Failed to record driver offer stats", err)
+	}
+
+	message := "Offer stats recorded"
+	if !applied {
+		message = "Newer offer stats were already recorded"
+	}
+	return &pb.RecordDriverOfferStatsResponse{
+		Success: true,
+		Message: message,
+		Applied: applied,
+	}, nil
+}
+
+func (s *UserServer) GetDriverProfile(ctx context.Context, req *pb.GetDriverProfileRequest) (*pb.GetDriverProfileResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC GetDriverProfile called", "driver_id", req.DriverId)
+
+	profile, err := s.service.GetDriverProfile(ctx, int(req.DriverId))
+	if err != nil {
+		return nil, offerStatsError(ctx, "Failed to get driver profile", err)
+	}
+
+	driver := profile.Driver
+	vehicles := make([]*pb.Vehicle, 0, len(profile.Vehicles))
+	for _, vehicle := range profile.Vehicles {
+		vehicles = append(vehicles, &pb.Vehicle{
+			VehicleId:    int32(vehicle.VehicleId),
+			DriverId:     int32(vehicle.DriverId),
+			LicensePlate: vehicle.LicensePlate,
+			VehicleType:  vehicle.VehicleType,
+			Seats:        int32(vehicle.Seats),
+			Status:       vehicle.Status,
+			VerifiedAt:   vehicle.VerifiedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CreatedAt:    formatTime(vehicle.CreatedAt),
+			UpdatedAt:    formatTime(vehicle.UpdatedAt),
+		})
+	}
+	resp := &pb.GetDriverProfileResponse{
+		Success: true,
+		Message: "Driver profile retrieved successfully",
+		Driver: &pb.User{
+			UserId:           int32(driver.UserId),
+			Email:            driver.Email,
+			FirstName:        driver.FirstName,
+			LastName:         driver.LastName,
+			Role:             driver.Role,
+			DriverStatus:     driver.DriverStatus,
+			DriverTotalTrip:  int32(driver.DriverTotalTrip),
+			DriverRevenue:    driver.DriverRevenue,
+			DriverAvgRating:  driver.DriverAvgRating,
+			DriverVerifiedAt: driver.DriverVerifiedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CreatedAt:        formatTime(driver.CreatedAt),
+			UpdatedAt:        formatTime(driver.UpdatedAt),
+		},
+		Vehicles: vehicles,
+	}
+	if profile.OfferStats != nil {
+		resp.OfferStats = toPBOfferStats(*profile.OfferStats)
+	}
+	return resp, nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	user_service "user-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Saved Place gRPC Methods
+// ============================================
+
+func toPBPlace(place user_service.SavedPlace) *pb.SavedPlace {
+	return &pb.SavedPlace{
+		PlaceId:   int32(place.PlaceId),
+		UserId:    int32(place.UserId),
+		Kind:      place.Kind,
+		Label:     place.Label,
+		Address:   place.Address,
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		CreatedAt: formatTime(place.CreatedAt),
+		UpdatedAt: formatTime(place.UpdatedAt),
+	}
+}
+
+// placeError maps service errors to gRPC status codes.
+func placeError(ctx context.Context, msg string, err error) error {
+	switch {
+	case errors.Is(err, user_service.ErrInvalidPlace):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, user_service.ErrPlaceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, user_service.ErrPlaceExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, user_service.ErrPlaceLimit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger.WithContext(ctx).ErrorContext(ctx, msg, "error", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func placeResponse(place user_service.SavedPlace, message string) *pb.SavedPlaceResponse {
+	return &pb.SavedPlaceResponse{
+		Success: true,
+		Message: message,
+		Place:   toPBPlace(place),
+	}
+}
+
+func (s *UserServer) CreateSavedPlace(ctx context.Context, req *pb.CreateSavedPlaceRequest) (*pb.SavedPlaceResponse, error) {
+	place, err := s.service.CreateSavedPlace(ctx, user_service.PlaceRequest{
+		UserId:    int(req.UserId),
+		Kind:      req.Kind,
+		Label:     req.Label,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		return nil, placeError(ctx, "Failed to create saved place", err)
+	}
+
+	return placeResponse(place, "Place saved successfully"), nil
+}
+
+func (s *UserServer) GetSavedPlace(ctx context.Context, req *pb.GetSavedPlaceRequest) (*pb.SavedPlaceResponse, error) {
+	place, err := s.service.GetSavedPlace(ctx, int(req.PlaceId), int(req.UserId))
+	if err != nil {
+		return nil, placeError(ctx, "Failed to get saved place", err)
+	}
+
+	return placeResponse(place, "Place retrieved successfully"), nil
+}
+
+func (s *UserServer) ListSavedPlaces(ctx context.Context, req *pb.ListSavedPlacesRequest) (*pb.ListSavedPlacesResponse, error) {
+	places, err := s.service.ListSavedPlaces(ctx, int(req.UserId))
+	if err != nil {
+		return nil, placeError(ctx, "Failed to list saved places", err)
+	}
+
+	pbPlaces := make([]*pb.SavedPlace, 0, len(places))
+	for _, place := range places {
+		pbPlaces = append(pbPlaces, toPBPlace(place))
+	}
+
+	return &pb.ListSavedPlacesResponse{
+		Success:    true,
+		Message:    "Places retrieved successfully",
+		Places:     pbPlaces,
+		TotalCount: int32(len(pbPlaces)),
+	}, nil
+}
+
+func (s *UserServer) UpdateSavedPlace(ctx context.Context, req *pb.UpdateSavedPlaceRequest) (*pb.SavedPlaceResponse, error) {
+	place, err := s.service.UpdateSavedPlace(ctx, int(req.PlaceId), user_service.PlaceRequest{
+		UserId:    int(req.UserId),
+		Kind:      req.Kind,
+		Label:     req.Label,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+	})
+	if err != nil {
+		return nil, placeError(ctx, "Failed to update saved place", err)
+	}
+
+	return placeResponse(place, "Place updated successfully"), nil
+}
+
+func (s *UserServer) DeleteSavedPlace(ctx context.Context, req *pb.DeleteSavedPlaceRequest) (*pb.DeleteSavedPlaceResponse, error) {
+	if err := s.service.DeleteSavedPlace(ctx, int(req.PlaceId), int(req.UserId)); err != nil {
+		return nil, placeError(ctx, "Failed to delete saved place", err)
+	}
+
+	return &pb.DeleteSavedPlaceResponse{
+		Success: true,
+		Message: "Place deleted successfully",
+	}, nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	user_service "user-service/internal"
+
+	"github.com/OneKeyCoder/UIT-Go-Backend/common/logger"
+	pb "github.com/OneKeyCoder/UIT-Go-Backend/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// Support Ticket gRPC Methods
+// ============================================
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02T15:04:05Z07:00")
+}
+
+func toPBTicket(ticket user_service.SupportTicket) *pb.SupportTicket {
+	messages := make([]*pb.TicketMessage, 0, len(ticket.Messages))
+	for _, m := range ticket.Messages {
+		messages = append(messages, &pb.TicketMessage{
+			AuthorId:   int32(m.AuthorId),
+			AuthorRole: m.AuthorRole,
+			Body:       m.Body,
+			CreatedAt:  formatTime(m.CreatedAt),
+		})
+	}
+	return &pb.SupportTicket{
+		TicketId:        int32(ticket.TicketId),
+		TripId:          int32(ticket.TripId),
+		OpenedBy:        int32(ticket.OpenedBy),
+		OpenerRole:      ticket.OpenerRole,
+		Category:        ticket.Category,
+		Subject:         ticket.Subject,
+		ItemDescription: ticket.ItemDescription,
+		Status:          ticket.Status,
+		AssigneeId:      int32(ticket.AssigneeId),
+		Messages:        messages,
+		CreatedAt:       formatTime(ticket.CreatedAt),
+		UpdatedAt:       formatTime(ticket.UpdatedAt),
+		ResolvedAt:      formatTime(ticket.ResolvedAt),
+	}
+}
+
+// ticketError maps service errors to gRPC status codes so the gateway can
+// tell a bad request from a missing ticket.
+func ticketError(ctx context.Context, msg string, err error) error {
+	switch {
+	case errors.Is(err, user_service.ErrInvalidTicket):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, user_service.ErrTicketNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, user_service.ErrTicketForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	logger.WithContext(ctx).ErrorContext(ctx, msg, "error", err)
+	return status.Error(codes.Internal, err.Error())
+}
+
+func ticketResponse(ticket user_service.SupportTicket, message string) *pb.TicketResponse {
+	return &pb.TicketResponse{
+		Success: true,
+		Message: message,
+		Ticket:  toPBTicket(ticket),
+	}
+}
+
+func (s *UserServer) OpenTicket(ctx context.Context, req *pb.OpenTicketRequest) (*pb.TicketResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC OpenTicket called", "trip_id", req.TripId, "user_id", req.UserId, "category", req.Category)
+
+	ticket, err := s.service.OpenTicket(ctx, user_service.TicketRequest{
+		TripId:          int(req.TripId),
+		UserId:          int(req.UserId),
+		Role:            req.Role,
+		Category:        req.Category,
+		Subject:         req.Subject,
+		Message:         req.Message,
+		ItemDescription: req.ItemDescription,
+	})
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to open ticket", err)
+	}
+
+	return ticketResponse(ticket, "Ticket opened successfully"), nil
+}
+
+func (s *UserServer) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.TicketResponse, error) {
+	ticket, err := s.service.GetTicket(ctx, int(req.TicketId), int(req.UserId), req.IsAdmin)
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to get ticket", err)
+	}
+
+	return ticketResponse(ticket, "Ticket retrieved successfully"), nil
+}
+
+func (s *UserServer) ListTickets(ctx context.Context, req *pb.ListTicketsRequest) (*pb.ListTicketsResponse, error) {
+	tickets, err := s.service.ListTickets(ctx, user_service.TicketFilter{
+		OpenedBy:   int(req.OpenedBy),
+		Status:     req.Status,
+		AssigneeId: int(req.AssigneeId),
+		TripId:     int(req.TripId),
+	})
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to list tickets", err)
+	}
+
+	pbTickets := make([]*pb.SupportTicket, 0, len(tickets))
+	for _, ticket := range tickets {
+		pbTickets = append(pbTickets, toPBTicket(ticket))
+	}
+
+	return &pb.ListTicketsResponse{
+		Success:    true,
+		Message:    "Tickets retrieved successfully",
+		Tickets:    pbTickets,
+		TotalCount: int32(len(pbTickets)),
+	}, nil
+}
+
+func (s *UserServer) AddTicketMessage(ctx context.Context, req *pb.AddTicketMessageRequest) (*pb.TicketResponse, error) {
+	ticket, err := s.service.AddTicketMessage(ctx, int(req.TicketId), int(req.UserId), req.IsAdmin, req.Body)
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to add ticket message", err)
+	}
+
+	return ticketResponse(ticket, "Message added successfully"), nil
+}
+
+func (s *UserServer) UpdateTicketStatus(ctx context.Context, req *pb.UpdateTicketStatusRequest) (*pb.TicketResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC UpdateTicketStatus called", "ticket_id", req.TicketId, "status", req.Status)
+
+	ticket, err := s.service.UpdateTicketStatus(ctx, int(req.TicketId), req.Status)
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to update ticket status", err)
+	}
+
+	return ticketResponse(ticket, "Ticket status updated successfully"), nil
+}
+
+func (s *UserServer) AssignTicket(ctx context.Context, req *pb.AssignTicketRequest) (*pb.TicketResponse, error) {
+	logger.WithContext(ctx).InfoContext(ctx, "gRPC AssignTicket called", "ticket_id", req.TicketId, "assignee_id", req.AssigneeId)
+
+	ticket, err := s.service.AssignTicket(ctx, int(req.TicketId), int(req.AssigneeId))
+	if err != nil {
+		return nil, ticketError(ctx, "Failed to assign ticket", err)
+	}
+
+	return ticketResponse(ticket, "Ticket assigned successfully"), nil
+}
@@ -0,0 +1,197 @@
+package user_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const blocksCollection = "user_blocks"
+
+const (
+	// MaxBlockedUsers is how many users one user can block.
+	MaxBlockedUsers   = 200
+	maxBlockReasonLen = 500
+)
+
+var (
+	ErrInvalidBlock  = errors.New("invalid block")
+	ErrBlockNotFound = errors.New("user is not blocked")
+	ErrUserNotFound  = errors.New("user not found")
+	ErrBlockLimit    = fmt.Errorf("at most %d blocked users per user", MaxBlockedUsers)
+)
+
+// UserBlock is UserId's block of BlockedUserId. The document id is derived from
+// the pair, so blocking twice keeps a single block.
+type UserBlock struct {
+	Id            string    `json:"id" bson:"_id"`
+	UserId        int       `json:"user_id" bson:"user_id"`
+	BlockedUserId int       `json:"blocked_user_id" bson:"blocked_user_id"`
+	Reason        string    `json:"reason" bson:"reason"`
+	CreatedAt     time.Time `json:"created_at" bson:"created_at"`
+}
+
+func blockId(userId int, blockedUserId int) string {
+	return fmt.Sprintf("%d:%d", userId, blockedUserId)
+}
+
+func validateBlock(userId int, blockedUserId int, reason string) error {
+	switch {
+	case userId <= 0:
+		return fmt.Errorf("%w: user_id is required", ErrInvalidBlock)
+	case blockedUserId <= 0:
+		return fmt.Errorf("%w: blocked_user_id is required", ErrInvalidBlock)
+	case userId == blockedUserId:
+		return fmt.Errorf("%w: users can't block themselves", ErrInvalidBlock)
+	case len(reason) > maxBlockReasonLen:
+		return fmt.Errorf("%w: reason is longer than %d characters", ErrInvalidBlock, maxBlockReasonLen)
+	}
+	return nil
+}
+
+func (us *UserService) getBlocksCollection() *mongo.Collection {
+	return us.mongoClient.Database("mongo").Collection(blocksCollection)
+}
+
+// BlockUser blocks blockedUserId for userId. Blocking someone already blocked
+// returns the existing block unchanged.
+func (us *UserService) BlockUser(ctx context.Context, userId int, blockedUserId int, reason string) (UserBlock, error) {
+	reason = strings.TrimSpace(reason)
+	if err := validateBlock(userId, blockedUserId, reason); err != nil {
+		return UserBlock{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	blocks := us.getBlocksCollection()
+	var existing UserBlock
+	err := blocks.FindOne(ctx, bson.M{"_id": blockId(userId, blockedUserId)}).Decode(&existing)
+	if err == nil {
+		return existing, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return UserBlock{}, fmt.Errorf("failed to get block: %w", err)
+	}
+
+	count, err := us.getUsersCollection().CountDocuments(ctx, bson.M{"user_id": blockedUserId})
+	if err != nil {
+		return UserBlock{}, fmt.Errorf("failed to check user: %w", err)
+	}
+	if count == 0 {
+		return UserBlock{}, fmt.Errorf("%w: %d", ErrUserNotFound, blockedUserId)
+	}
+	count, err = blocks.CountDocuments(ctx, bson.M{"user_id": userId})
+	if err != nil {
+		return UserBlock{}, fmt.Errorf("failed to count blocks: %w", err)
+	}
+	if count >= MaxBlockedUsers {
+		return UserBlock{}, ErrBlockLimit
+	}
+
+	block := UserBlock{
+		Id:            blockId(userId, blockedUserId),
+		UserId:        userId,
+		BlockedUserId: blockedUserId,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+	if _, err := blocks.InsertOne(ctx, block); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// Lost a race with the same block.
+			err = blocks.FindOne(ctx, bson.M{"_id": block.Id}).Decode(&existing)
+			if err == nil {
+				return existing, nil
+			}
+		}
+		return UserBlock{}, fmt.Errorf("failed to create block: %w", err)
+	}
+	return block, nil
+}
+
+func (us *UserService) UnblockUser(ctx context.Context, userId int, blockedUserId int) error {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	result, err := us.getBlocksCollection().DeleteOne(ctx, bson.M{"_id": blockId(userId, blockedUserId)})
+	if err != nil {
+		return fmt.Errorf("failed to delete block: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrBlockNotFound
+	}
+	return nil
+}
+
+// ListBlockedUsers returns the users userId blocked, newest first. Blocks made
+// by others against userId are not listed.
+func (us *UserService) ListBlockedUsers(ctx context.Context, userId int) ([]UserBlock, error) {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := us.getBlocksCollection().Find(ctx, bson.M{"user_id": userId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocks: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	blocks := []UserBlock{}
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, fmt.Errorf("failed to decode blocks: %w", err)
+	}
+	return blocks, nil
+}
+
+// FilterBlockedUsers returns the candidates that neither blocked userId nor
+// were blocked by them, in their original order.
+func (us *UserService) FilterBlockedUsers(ctx context.Context, userId int, candidateIds []int) ([]int, error) {
+	if len(candidateIds) == 0 {
+		return []int{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	cursor, err := us.getBlocksCollection().Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"user_id": userId, "blocked_user_id": bson.M{"$in": candidateIds}},
+		bson.M{"blocked_user_id": userId, "user_id": bson.M{"$in": candidateIds}},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find blocks: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var blocks []UserBlock
+	if err := cursor.All(ctx, &blocks); err != nil {
+		return nil, fmt.Errorf("failed to decode blocks: %w", err)
+	}
+	return filterBlocked(userId, candidateIds, blocks), nil
+}
+
+// filterBlocked drops the candidates on the other side of any of blocks from
+// userId.
+func filterBlocked(userId int, candidateIds []int, blocks []UserBlock) []int {
+	blocked := make(map[int]bool, len(blocks))
+	for _, block := range blocks {
+		switch userId {
+		case block.UserId:
+			blocked[block.BlockedUserId] = true
+		case block.BlockedUserId:
+			blocked[block.UserId] = true
+		}
+	}
+	allowed := make([]int, 0, len(candidateIds))
+	for _, id := range candidateIds {
+		if !blocked[id] {
+			allowed = append(allowed, id)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,51 @@
+package user_service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateBlock(t *testing.T) {
+	tests := []struct {
+		name          string
+		userId        int
+		blockedUserId int
+		reason        string
+		wantErr       bool
+	}{
+		{name: "valid", userId: 1, blockedUserId: 2, reason: "rude"},
+		{name: "no reason", userId: 1, blockedUserId: 2},
+		{name: "missing user", userId: 0, blockedUserId: 2, wantErr: true},
+		{name: "missing blocked user", userId: 1, blockedUserId: 0, wantErr: true},
+		{name: "self", userId: 3, blockedUserId: 3, wantErr: true},
+		{name: "long reason", userId: 1, blockedUserId: 2, reason: string(make([]byte, maxBlockReasonLen+1)), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlock(tt.userId, tt.blockedUserId, tt.reason)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("validateBlock() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidBlock) {
+				t.Errorf("error %v does not wrap ErrInvalidBlock", err)
+			}
+		})
+	}
+}
+
+func TestFilterBlocked(t *testing.T) {
+	blocks := []UserBlock{
+		{UserId: 10, BlockedUserId: 2}, // passenger blocked driver 2
+		{UserId: 4, BlockedUserId: 10}, // driver 4 blocked the passenger
+		{UserId: 5, BlockedUserId: 11}, // unrelated
+	}
+	got := filterBlocked(10, []int{1, 2, 3, 4, 5}, blocks)
+	want := []int{1, 3, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filterBlocked() = %v, want %v", got, want)
+	}
+	if got := filterBlocked(10, []int{}, blocks); len(got) != 0 {
+		t.Errorf("filterBlocked() with no candidates = %v, want empty", got)
+	}
+}
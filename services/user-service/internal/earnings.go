@@ -0,0 +1,115 @@
+package user_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const earningsCollection = "driver_earnings"
+
+const EarningKindTip = "tip"
+
+var (
+	ErrInvalidEarning = errors.New("invalid earning")
+	ErrDriverNotFound = errors.New("driver not found")
+)
+
+// DriverEarning is one credit to a driver's revenue. The document id is
+// derived from the trip and kind, which is what makes crediting idempotent.
+type DriverEarning struct {
+	Id        string    `json:"id" bson:"_id"`
+	DriverId  int       `json:"driver_id" bson:"driver_id"`
+	TripId    int       `json:"trip_id" bson:"trip_id"`
+	Kind      string    `json:"kind" bson:"kind"`
+	Amount    float64   `json:"amount" bson:"amount"`
+	Applied   bool      `json:"applied" bson:"applied"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	AppliedAt time.Time `json:"applied_at" bson:"applied_at"`
+}
+
+func earningId(tripId int, kind string) string {
+	return fmt.Sprintf("trip:%d:%s", tripId, kind)
+}
+
+func validateEarning(e DriverEarning) error {
+	switch {
+	case e.DriverId <= 0:
+		return fmt.Errorf("%w: driver_id is required", ErrInvalidEarning)
+	case e.TripId <= 0:
+		return fmt.Errorf("%w: trip_id is required", ErrInvalidEarning)
+	case e.Kind != EarningKindTip:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidEarning, e.Kind)
+	case math.IsNaN(e.Amount) || math.IsInf(e.Amount, 0) || e.Amount <= 0:
+		return fmt.Errorf("%w: amount must be positive", ErrInvalidEarning)
+	}
+	return nil
+}
+
+func (us *UserService) getEarningsCollection() *mongo.Collection {
+	return us.mongoClient.Database("mongo").Collection(earningsCollection)
+}
+
+// CreditDriverEarning adds an earning to the driver's revenue and reports
+// whether it did; an earning already credited for the same trip and kind is
+// left alone. The earning is recorded first and then claimed before the
+// revenue is incremented, so concurrent retries can't both apply it.
+func (us *UserService) CreditDriverEarning(ctx context.Context, driverId int, tripId int, kind string, amount float64) (bool, error) {
+	earning := DriverEarning{
+		Id:        earningId(tripId, kind),
+		DriverId:  driverId,
+		TripId:    tripId,
+		Kind:      kind,
+		Amount:    amount,
+		CreatedAt: time.Now(),
+	}
+	if err := validateEarning(earning); err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	earnings := us.getEarningsCollection()
+	if _, err := earnings.InsertOne(ctx, earning); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return false, fmt.Errorf("failed to record earning: %w", err)
+	}
+
+	claim := earnings.FindOneAndUpdate(ctx,
+		bson.M{"_id": earning.Id, "driver_id": driverId, "applied": false},
+		bson.M{"$set": bson.M{"applied": true, "applied_at": time.Now()}},
+	)
+	if err := claim.Err(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim earning: %w", err)
+	}
+
+	result, err := us.getUsersCollection().UpdateOne(ctx,
+		bson.M{"user_id": driverId},
+		bson.M{
+			"$inc": bson.M{"driver_revenue": amount},
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err == nil && result.MatchedCount == 0 {
+		err = fmt.Errorf("%w: %d", ErrDriverNotFound, driverId)
+	}
+	if err != nil {
+		// Hand the earning back so a retry can apply it.
+		if _, undoErr := earnings.UpdateOne(ctx, bson.M{"_id": earning.Id}, bson.M{"$set": bson.M{"applied": false}}); undoErr != nil {
+			return false, fmt.Errorf("failed to credit revenue: %w (and to release earning: %v)", err, undoErr)
+		}
+		if errors.Is(err, ErrDriverNotFound) {
+			return false, err
+		}
+		return false, fmt.Errorf("failed to credit revenue: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,47 @@
+package user_service
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestValidateEarning(t *testing.T) {
+	valid := DriverEarning{DriverId: 5, TripId: 42, Kind: EarningKindTip, Amount: 2.5}
+
+	tests := []struct {
+		name    string
+		mutate  func(e *DriverEarning)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(e *DriverEarning) {}},
+		{name: "missing driver", mutate: func(e *DriverEarning) { e.DriverId = 0 }, wantErr: true},
+		{name: "missing trip", mutate: func(e *DriverEarning) { e.TripId = 0 }, wantErr: true},
+		{name: "unknown kind", mutate: func(e *DriverEarning) { e.Kind = "bonus" }, wantErr: true},
+		{name: "zero amount", mutate: func(e *DriverEarning) { e.Amount = 0 }, wantErr: true},
+		{name: "negative amount", mutate: func(e *DriverEarning) { e.Amount = -1 }, wantErr: true},
+		{name: "NaN amount", mutate: func(e *DriverEarning) { e.Amount = math.NaN() }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := valid
+			tt.mutate(&e)
+			err := validateEarning(e)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("validateEarning() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidEarning) {
+				t.Errorf("error %v does not wrap ErrInvalidEarning", err)
+			}
+		})
+	}
+}
+
+func TestEarningIdIsPerTripAndKind(t *testing.T) {
+	if earningId(42, EarningKindTip) != earningId(42, EarningKindTip) {
+		t.Fatal("earning id is not stable")
+	}
+	if earningId(42, EarningKindTip) == earningId(43, EarningKindTip) {
+		t.Fatal("different trips share an earning id")
+	}
+}
@@ -45,4 +45,5 @@ type VehicleRequest struct {
 	VehicleType  string `json:"vehicle_type"`
 	Seats        int    `json:"seats"`
 	Status       string `json:"status"`
+	Verify       bool   `json:"verify"`
 }
@@ -0,0 +1,144 @@
+package user_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const offerStatsCollection = "driver_offer_stats"
+
+var ErrInvalidOfferStats = errors.New("invalid offer stats")
+
+// DriverOfferStats is the latest snapshot of a driver's offer outcomes, as
+// computed by trip-service over the last WindowDays. There is one document per
+// driver, keyed by the driver id.
+type DriverOfferStats struct {
+	DriverId         int       `json:"driver_id" bson:"_id"`
+	Offers           int64     `json:"offers" bson:"offers"`
+	Accepted         int64     `json:"accepted" bson:"accepted"`
+	Rejected         int64     `json:"rejected" bson:"rejected"`
+	TimedOut         int64     `json:"timed_out" bson:"timed_out"`
+	Cancelled        int64     `json:"cancelled" bson:"cancelled"`
+	AcceptanceRate   float64   `json:"acceptance_rate" bson:"acceptance_rate"`
+	CancellationRate float64   `json:"cancellation_rate" bson:"cancellation_rate"`
+	WindowDays       int       `json:"window_days" bson:"window_days"`
+	AsOf             time.Time `json:"as_of" bson:"as_of"`
+}
+
+// DriverProfile is a driver with their vehicles and, once they have been
+// offered a trip, their offer stats.
+type DriverProfile struct {
+	Driver     User
+	Vehicles   []Vehicle
+	OfferStats *DriverOfferStats
+}
+
+func validRate(rate float64) bool {
+	return !math.IsNaN(rate) && rate >= 0 && rate <= 1
+}
+
+func validateOfferStats(s DriverOfferStats) error {
+	switch {
+	case s.DriverId <= 0:
+		return fmt.Errorf("%w: driver_id is required", ErrInvalidOfferStats)
+	case s.Accepted < 0 || s.Rejected < 0 || s.TimedOut < 0 || s.Cancelled < 0:
+		return fmt.Errorf("%w: counts can't be negative", ErrInvalidOfferStats)
+	case s.Offers != s.Accepted+s.Rejected+s.TimedOut:
+		return fmt.Errorf("%w: offers must be accepted + rejected + timed_out", ErrInvalidOfferStats)
+	case !validRate(s.AcceptanceRate) || !validRate(s.CancellationRate):
+		return fmt.Errorf("%w: rates must be between 0 and 1", ErrInvalidOfferStats)
+	case s.WindowDays <= 0:
+		return fmt.Errorf("%w: window_days must be positive", ErrInvalidOfferStats)
+	case s.AsOf.IsZero():
+		return fmt.Errorf("%w: as_of is required", ErrInvalidOfferStats)
+	}
+	return nil
+}
+
+func (us *UserService) getOfferStatsCollection() *mongo.Collection {
+	return us.mongoClient.Database("mongo").Collection(offerStatsCollection)
+}
+
+// RecordDriverOfferStats stores the snapshot unless one at least as recent is
+// already stored, and reports whether it did. Snapshots pushed out of order
+// therefore can't roll the stats back.
+func (us *UserService) RecordDriverOfferStats(ctx context.Context, stats DriverOfferStats) (bool, error) {
+	if err := validateOfferStats(stats); err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	// When the stored snapshot is newer the filter matches nothing and the
+	// upsert collides with it on _id.
+	_, err := us.getOfferStatsCollection().ReplaceOne(ctx,
+		bson.M{"_id": stats.DriverId, "as_of": bson.M{"$lt": stats.AsOf}},
+		stats,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record offer stats: %w", err)
+	}
+	return true, nil
+}
+
+// GetDriverOfferStats returns the driver's latest snapshot and whether there
+// is one.
+func (us *UserService) GetDriverOfferStats(ctx context.Context, driverId int) (DriverOfferStats, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	var stats DriverOfferStats
+	err := us.getOfferStatsCollection().FindOne(ctx, bson.M{"_id": driverId}).Decode(&stats)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return DriverOfferStats{}, false, nil
+		}
+		return DriverOfferStats{}, false, fmt.Errorf("failed to get offer stats: %w", err)
+	}
+	return stats, true, nil
+}
+
+// GetDriverProfile returns the driver with their vehicles and offer stats.
+// Users who aren't drivers are reported as ErrDriverNotFound.
+func (us *UserService) GetDriverProfile(ctx context.Context, driverId int) (DriverProfile, error) {
+	var driver User
+	findCtx, cancel := context.WithTimeout(ctx, dbTimeout)
+	err := us.getUsersCollection().FindOne(findCtx, bson.M{"user_id": driverId}).Decode(&driver)
+	cancel()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return DriverProfile{}, fmt.Errorf("%w: %d", ErrDriverNotFound, driverId)
+		}
+		return DriverProfile{}, fmt.Errorf("failed to get driver: %w", err)
+	}
+	if driver.Role != RoleDriver {
+		return DriverProfile{}, fmt.Errorf("%w: %d", ErrDriverNotFound, driverId)
+	}
+
+	vehicles, err := us.GetVehiclesByUserId(ctx, driverId)
+	if err != nil {
+		return DriverProfile{}, err
+	}
+	profile := DriverProfile{Driver: driver, Vehicles: vehicles}
+
+	stats, ok, err := us.GetDriverOfferStats(ctx, driverId)
+	if err != nil {
+		return DriverProfile{}, err
+	}
+	if ok {
+		profile.OfferStats = &stats
+	}
+	return profile, nil
+}
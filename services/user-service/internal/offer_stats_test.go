@@ -0,0 +1,54 @@
+package user_service
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestValidateOfferStats(t *testing.T) {
+	valid := DriverOfferStats{
+		DriverId:         5,
+		Offers:           10,
+		Accepted:         7,
+		Rejected:         2,
+		TimedOut:         1,
+		Cancelled:        1,
+		AcceptanceRate:   0.7,
+		CancellationRate: 1.0 / 7,
+		WindowDays:       30,
+		AsOf:             time.Now(),
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(s *DriverOfferStats)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(s *DriverOfferStats) {}},
+		{name: "no offers yet", mutate: func(s *DriverOfferStats) {
+			*s = DriverOfferStats{DriverId: 5, WindowDays: 30, AsOf: time.Now()}
+		}},
+		{name: "missing driver", mutate: func(s *DriverOfferStats) { s.DriverId = 0 }, wantErr: true},
+		{name: "negative count", mutate: func(s *DriverOfferStats) { s.Cancelled = -1 }, wantErr: true},
+		{name: "offers don't add up", mutate: func(s *DriverOfferStats) { s.Offers = 11 }, wantErr: true},
+		{name: "rate above one", mutate: func(s *DriverOfferStats) { s.AcceptanceRate = 1.5 }, wantErr: true},
+		{name: "NaN rate", mutate: func(s *DriverOfferStats) { s.CancellationRate = math.NaN() }, wantErr: true},
+		{name: "missing window", mutate: func(s *DriverOfferStats) { s.WindowDays = 0 }, wantErr: true},
+		{name: "missing as_of", mutate: func(s *DriverOfferStats) { s.AsOf = time.Time{} }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			tt.mutate(&s)
+			err := validateOfferStats(s)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("validateOfferStats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidOfferStats) {
+				t.Errorf("error %v does not wrap ErrInvalidOfferStats", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package user_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const placesCollection = "saved_places"
+
+const (
+	PlaceKindHome   = "home"
+	PlaceKindWork   = "work"
+	PlaceKindCustom = "custom"
+)
+
+const (
+	// MaxSavedPlaces is how many places one user can save.
+	MaxSavedPlaces   = 20
+	maxPlaceLabelLen = 50
+	maxAddressLen    = 255
+)
+
+var (
+	ErrPlaceNotFound = errors.New("saved place not found")
+	ErrInvalidPlace  = errors.New("invalid saved place")
+	ErrPlaceExists   = errors.New("saved place already exists")
+	ErrPlaceLimit    = fmt.Errorf("at most %d saved places per user", MaxSavedPlaces)
+)
+
+type SavedPlace struct {
+	PlaceId   int       `json:"place_id" bson:"place_id"`
+	UserId    int       `json:"user_id" bson:"user_id"`
+	Kind      string    `json:"kind" bson:"kind"`
+	Label     string    `json:"label" bson:"label"`
+	Address   string    `json:"address" bson:"address"`
+	Latitude  float64   `json:"latitude" bson:"latitude"`
+	Longitude float64   `json:"longitude" bson:"longitude"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+type PlaceRequest struct {
+	UserId    int
+	Kind      string
+	Label     string
+	Address   string
+	Latitude  float64
+	Longitude float64
+}
+
+// normalizePlaceRequest trims the text fields, fills in the label of home and
+// work places and checks the rest.
+func normalizePlaceRequest(req PlaceRequest) (PlaceRequest, error) {
+	req.Kind = strings.ToLower(strings.TrimSpace(req.Kind))
+	req.Label = strings.TrimSpace(req.Label)
+	req.Address = strings.TrimSpace(req.Address)
+
+	switch req.Kind {
+	case PlaceKindHome:
+		if req.Label == "" {
+			req.Label = "Home"
+		}
+	case PlaceKindWork:
+		if req.Label == "" {
+			req.Label = "Work"
+		}
+	case PlaceKindCustom:
+		if req.Label == "" {
+			return req, fmt.Errorf("%w: custom places need a label", ErrInvalidPlace)
+		}
+	default:
+		return req, fmt.Errorf("%w: kind must be home, work or custom", ErrInvalidPlace)
+	}
+
+	switch {
+	case req.UserId <= 0:
+		return req, fmt.Errorf("%w: user_id is required", ErrInvalidPlace)
+	case len(req.Label) > maxPlaceLabelLen:
+		return req, fmt.Errorf("%w: label is longer than %d characters", ErrInvalidPlace, maxPlaceLabelLen)
+	case req.Address == "":
+		return req, fmt.Errorf("%w: address is required", ErrInvalidPlace)
+	case len(req.Address) > maxAddressLen:
+		return req, fmt.Errorf("%w: address is longer than %d characters", ErrInvalidPlace, maxAddressLen)
+	case math.IsNaN(req.Latitude) || req.Latitude < -90 || req.Latitude > 90:
+		return req, fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidPlace)
+	case math.IsNaN(req.Longitude) || req.Longitude < -180 || req.Longitude > 180:
+		return req, fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidPlace)
+	case req.Latitude == 0 && req.Longitude == 0:
+		return req, fmt.Errorf("%w: coordinates are required", ErrInvalidPlace)
+	}
+	return req, nil
+}
+
+func (us *UserService) getPlacesCollection() *mongo.Collection {
+	return us.mongoClient.Database("mongo").Collection(placesCollection)
+}
+
+// checkSingletonKind fails if the user already has a home or work place other
+// than exceptPlaceId.
+func (us *UserService) checkSingletonKind(ctx context.Context, userId int, kind string, exceptPlaceId int) error {
+	if kind == PlaceKindCustom {
+		return nil
+	}
+	count, err := us.getPlacesCollection().CountDocuments(ctx, bson.M{
+		"user_id":  userId,
+		"kind":     kind,
+		"place_id": bson.M{"$ne": exceptPlaceId},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check saved places: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: there is already a %s place, update it instead", ErrPlaceExists, kind)
+	}
+	return nil
+}
+
+func (us *UserService) CreateSavedPlace(ctx context.Context, req PlaceRequest) (SavedPlace, error) {
+	req, err := normalizePlaceRequest(req)
+	if err != nil {
+		return SavedPlace{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	collection := us.getPlacesCollection()
+
+	count, err := collection.CountDocuments(ctx, bson.M{"user_id": req.UserId})
+	if err != nil {
+		return SavedPlace{}, fmt.Errorf("failed to count saved places: %w", err)
+	}
+	if count >= MaxSavedPlaces {
+		return SavedPlace{}, ErrPlaceLimit
+	}
+	if err := us.checkSingletonKind(ctx, req.UserId, req.Kind, 0); err != nil {
+		return SavedPlace{}, err
+	}
+
+	// Get the next place_id
+	opts := options.FindOne().SetSort(bson.D{{Key: "place_id", Value: -1}})
+	var lastPlace SavedPlace
+	err = collection.FindOne(ctx, bson.M{}, opts).Decode(&lastPlace)
+	nextPlaceId := 1
+	if err == nil {
+		nextPlaceId = lastPlace.PlaceId + 1
+	} else if err != mongo.ErrNoDocuments {
+		return SavedPlace{}, fmt.Errorf("failed to get last saved place: %w", err)
+	}
+
+	now := time.Now()
+	place := SavedPlace{
+		PlaceId:   nextPlaceId,
+		UserId:    req.UserId,
+		Kind:      req.Kind,
+		Label:     req.Label,
+		Address:   req.Address,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	_, err = collection.InsertOne(ctx, place)
+	if err != nil {
+		return SavedPlace{}, fmt.Errorf("failed to create saved place: %w", err)
+	}
+
+	return place, nil
+}
+
+// GetSavedPlace returns one of the user's places. A place owned by someone
+// else is reported as not found.
+func (us *UserService) GetSavedPlace(ctx context.Context, placeId int, userId int) (SavedPlace, error) {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	var place SavedPlace
+	err := us.getPlacesCollection().FindOne(ctx, bson.M{"place_id": placeId, "user_id": userId}).Decode(&place)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return SavedPlace{}, ErrPlaceNotFound
+		}
+		return SavedPlace{}, fmt.Errorf("failed to get saved place: %w", err)
+	}
+	return place, nil
+}
+
+// ListSavedPlaces returns the user's places: home, then work, then custom
+// places in the order they were saved.
+func (us *UserService) ListSavedPlaces(ctx context.Context, userId int) ([]SavedPlace, error) {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "place_id", Value: 1}})
+	cursor, err := us.getPlacesCollection().Find(ctx, bson.M{"user_id": userId}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find saved places: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	places := []SavedPlace{}
+	if err := cursor.All(ctx, &places); err != nil {
+		return nil, fmt.Errorf("failed to decode saved places: %w", err)
+	}
+	sortPlaces(places)
+	return places, nil
+}
+
+// sortPlaces puts home first and work second; custom places keep their
+// order.
+func sortPlaces(places []SavedPlace) {
+	rank := map[string]int{PlaceKindHome: 0, PlaceKindWork: 1, PlaceKindCustom: 2}
+	sort.SliceStable(places, func(i, j int) bool {
+		return rank[places[i].Kind] < rank[places[j].Kind]
+	})
+}
+
+// UpdateSavedPlace replaces the place's kind, label, address and coordinates.
+func (us *UserService) UpdateSavedPlace(ctx context.Context, placeId int, req PlaceRequest) (SavedPlace, error) {
+	req, err := normalizePlaceRequest(req)
+	if err != nil {
+		return SavedPlace{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	if err := us.checkSingletonKind(ctx, req.UserId, req.Kind, placeId); err != nil {
+		return SavedPlace{}, err
+	}
+
+	var place SavedPlace
+	err = us.getPlacesCollection().FindOneAndUpdate(ctx,
+		bson.M{"place_id": placeId, "user_id": req.UserId},
+		bson.M{"$set": bson.M{
+			"kind":       req.Kind,
+			"label":      req.Label,
+			"address":    req.Address,
+			"latitude":   req.Latitude,
+			"longitude":  req.Longitude,
+			"updated_at": time.Now(),
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&place)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return SavedPlace{}, ErrPlaceNotFound
+		}
+		return SavedPlace{}, fmt.Errorf("failed to update saved place: %w", err)
+	}
+	return place, nil
+}
+
+func (us *UserService) DeleteSavedPlace(ctx context.Context, placeId int, userId int) error {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	result, err := us.getPlacesCollection().DeleteOne(ctx, bson.M{"place_id": placeId, "user_id": userId})
+	if err != nil {
+		return fmt.Errorf("failed to delete saved place: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrPlaceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package user_service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizePlaceRequest(t *testing.T) {
+	valid := PlaceRequest{
+		UserId:    3,
+		Kind:      PlaceKindCustom,
+		Label:     "Gym",
+		Address:   "12 Nguyen Hue, District 1",
+		Latitude:  10.7769,
+		Longitude: 106.7009,
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(r *PlaceRequest)
+		wantErr   bool
+		wantLabel string
+	}{
+		{name: "custom", mutate: func(r *PlaceRequest) {}, wantLabel: "Gym"},
+		{name: "home gets a label", mutate: func(r *PlaceRequest) { r.Kind = " Home "; r.Label = "" }, wantLabel: "Home"},
+		{name: "work keeps its label", mutate: func(r *PlaceRequest) { r.Kind = PlaceKindWork; r.Label = "Office" }, wantLabel: "Office"},
+		{name: "custom without label", mutate: func(r *PlaceRequest) { r.Label = "  " }, wantErr: true},
+		{name: "unknown kind", mutate: func(r *PlaceRequest) { r.Kind = "school" }, wantErr: true},
+		{name: "missing user", mutate: func(r *PlaceRequest) { r.UserId = 0 }, wantErr: true},
+		{name: "missing address", mutate: func(r *PlaceRequest) { r.Address = "" }, wantErr: true},
+		{name: "latitude out of range", mutate: func(r *PlaceRequest) { r.Latitude = 91 }, wantErr: true},
+		{name: "longitude out of range", mutate: func(r *PlaceRequest) { r.Longitude = -181 }, wantErr: true},
+		{name: "no coordinates", mutate: func(r *PlaceRequest) { r.Latitude, r.Longitude = 0, 0 }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.mutate(&req)
+			got, err := normalizePlaceRequest(req)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("normalizePlaceRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidPlace) {
+					t.Errorf("error %v does not wrap ErrInvalidPlace", err)
+				}
+				return
+			}
+			if got.Label != tt.wantLabel {
+				t.Errorf("label = %q, want %q", got.Label, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestSortPlaces(t *testing.T) {
+	places := []SavedPlace{
+		{PlaceId: 1, Kind: PlaceKindCustom},
+		{PlaceId: 2, Kind: PlaceKindWork},
+		{PlaceId: 3, Kind: PlaceKindCustom},
+		{PlaceId: 4, Kind: PlaceKindHome},
+	}
+	sortPlaces(places)
+	want := []int{4, 2, 1, 3}
+	for i, place := range places {
+		if place.PlaceId != want[i] {
+			t.Fatalf("order = %+v, want place ids %v", places, want)
+		}
+	}
+}
@@ -311,6 +311,13 @@ func (us *UserService) UpdateVehicle(ctx context.Context, vehicleId int, vehicle
 	if vehicleRequest.Status != "" {
 		update["$set"].(bson.M)["status"] = vehicleRequest.Status
 	}
+	// A verification only holds for the plate, type and seats that were
+	// checked.
+	if vehicleRequest.Verify {
+		update["$set"].(bson.M)["verified_at"] = time.Now()
+	} else if vehicleRequest.LicensePlate != "" || vehicleRequest.VehicleType != "" || vehicleRequest.Seats > 0 {
+		update["$set"].(bson.M)["verified_at"] = time.Time{}
+	}
 
 	result, err := collection.UpdateOne(ctx, bson.M{"vehicle_id": vehicleId}, update)
 	if err != nil {
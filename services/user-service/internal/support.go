@@ -0,0 +1,325 @@
+package user_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const ticketsCollection = "support_tickets"
+
+const (
+	TicketStatusOpen     = "open"
+	TicketStatusPending  = "pending"
+	TicketStatusResolved = "resolved"
+)
+
+const (
+	RolePassenger = "passenger"
+	RoleDriver    = "driver"
+	RoleAdmin     = "admin"
+)
+
+// TicketCategories lists the categories a ticket can be opened with.
+var TicketCategories = []string{
+	"lost_item",
+	"overcharge",
+	"driver_behavior",
+	"passenger_behavior",
+	"safety",
+	"app_issue",
+	"other",
+}
+
+var (
+	ErrTicketNotFound  = errors.New("ticket not found")
+	ErrTicketForbidden = errors.New("not allowed to access this ticket")
+	ErrInvalidTicket   = errors.New("invalid ticket")
+)
+
+type TicketMessage struct {
+	AuthorId   int       `json:"author_id" bson:"author_id"`
+	AuthorRole string    `json:"author_role" bson:"author_role"`
+	Body       string    `json:"body" bson:"body"`
+	CreatedAt  time.Time `json:"created_at" bson:"created_at"`
+}
+
+type SupportTicket struct {
+	TicketId        int             `json:"ticket_id" bson:"ticket_id"`
+	TripId          int             `json:"trip_id" bson:"trip_id"`
+	OpenedBy        int             `json:"opened_by" bson:"opened_by"`
+	OpenerRole      string          `json:"opener_role" bson:"opener_role"`
+	Category        string          `json:"category" bson:"category"`
+	Subject         string          `json:"subject" bson:"subject"`
+	ItemDescription string          `json:"item_description,omitempty" bson:"item_description,omitempty"`
+	Status          string          `json:"status" bson:"status"`
+	AssigneeId      int             `json:"assignee_id" bson:"assignee_id"`
+	Messages        []TicketMessage `json:"messages" bson:"messages"`
+	CreatedAt       time.Time       `json:"created_at" bson:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at" bson:"updated_at"`
+	ResolvedAt      time.Time       `json:"resolved_at" bson:"resolved_at"`
+}
+
+type TicketRequest struct {
+	TripId          int
+	UserId          int
+	Role            string
+	Category        string
+	Subject         string
+	Message         string
+	ItemDescription string
+}
+
+// TicketFilter narrows ListTickets; zero fields match everything.
+type TicketFilter struct {
+	OpenedBy   int
+	Status     string
+	AssigneeId int
+	TripId     int
+}
+
+func validateTicketRequest(req TicketRequest) error {
+	if req.TripId <= 0 || req.UserId <= 0 {
+		return fmt.Errorf("%w: trip and user are required", ErrInvalidTicket)
+	}
+	if req.Role != RolePassenger && req.Role != RoleDriver {
+		return fmt.Errorf("%w: role must be %s or %s", ErrInvalidTicket, RolePassenger, RoleDriver)
+	}
+	valid := false
+	for _, c := range TicketCategories {
+		valid = valid || c == req.Category
+	}
+	if !valid {
+		return fmt.Errorf("%w: category must be one of %s", ErrInvalidTicket, strings.Join(TicketCategories, ", "))
+	}
+	if strings.TrimSpace(req.Subject) == "" || strings.TrimSpace(req.Message) == "" {
+		return fmt.Errorf("%w: subject and message are required", ErrInvalidTicket)
+	}
+	if req.Category == "lost_item" && strings.TrimSpace(req.ItemDescription) == "" {
+		return fmt.Errorf("%w: item_description is required for lost items", ErrInvalidTicket)
+	}
+	return nil
+}
+
+func validTicketStatus(status string) bool {
+	return status == TicketStatusOpen || status == TicketStatusPending || status == TicketStatusResolved
+}
+
+// statusAfterMessage returns the ticket status once a message has been added.
+// A reply from the opener puts a pending or resolved ticket back in the
+// support queue; replies from support leave the status to the admin.
+func statusAfterMessage(current string, fromAdmin bool) string {
+	if fromAdmin {
+		return current
+	}
+	return TicketStatusOpen
+}
+
+func (us *UserService) getTicketsCollection() *mongo.Collection {
+	return us.mongoClient.Database("mongo").Collection(ticketsCollection)
+}
+
+// OpenTicket creates a ticket whose first message is req.Message. The caller
+// is responsible for checking that req.UserId took part in req.TripId.
+func (us *UserService) OpenTicket(ctx context.Context, req TicketRequest) (SupportTicket, error) {
+	if err := validateTicketRequest(req); err != nil {
+		return SupportTicket{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	collection := us.getTicketsCollection()
+
+	// Get the next ticket_id
+	opts := options.FindOne().SetSort(bson.D{{Key: "ticket_id", Value: -1}})
+	var lastTicket SupportTicket
+	err := collection.FindOne(ctx, bson.M{}, opts).Decode(&lastTicket)
+	nextTicketId := 1
+	if err == nil {
+		nextTicketId = lastTicket.TicketId + 1
+	} else if err != mongo.ErrNoDocuments {
+		return SupportTicket{}, fmt.Errorf("failed to get last ticket: %w", err)
+	}
+
+	now := time.Now()
+	ticket := SupportTicket{
+		TicketId:        nextTicketId,
+		TripId:          req.TripId,
+		OpenedBy:        req.UserId,
+		OpenerRole:      req.Role,
+		Category:        req.Category,
+		Subject:         strings.TrimSpace(req.Subject),
+		ItemDescription: strings.TrimSpace(req.ItemDescription),
+		Status:          TicketStatusOpen,
+		Messages: []TicketMessage{{
+			AuthorId:   req.UserId,
+			AuthorRole: req.Role,
+			Body:       req.Message,
+			CreatedAt:  now,
+		}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	_, err = collection.InsertOne(ctx, ticket)
+	if err != nil {
+		return SupportTicket{}, fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return ticket, nil
+}
+
+func (us *UserService) findTicket(ctx context.Context, ticketId int) (SupportTicket, error) {
+	var ticket SupportTicket
+	err := us.getTicketsCollection().FindOne(ctx, bson.M{"ticket_id": ticketId}).Decode(&ticket)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return SupportTicket{}, fmt.Errorf("%w: %d", ErrTicketNotFound, ticketId)
+		}
+		return SupportTicket{}, fmt.Errorf("failed to get ticket: %w", err)
+	}
+	return ticket, nil
+}
+
+// GetTicket returns a ticket to its opener or to an admin.
+func (us *UserService) GetTicket(ctx context.Context, ticketId int, userId int, isAdmin bool) (SupportTicket, error) {
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	ticket, err := us.findTicket(ctx, ticketId)
+	if err != nil {
+		return SupportTicket{}, err
+	}
+	if !isAdmin && ticket.OpenedBy != userId {
+		return SupportTicket{}, ErrTicketForbidden
+	}
+
+	return ticket, nil
+}
+
+// ListTickets returns the tickets matching filter, most recently updated first.
+func (us *UserService) ListTickets(ctx context.Context, filter TicketFilter) ([]SupportTicket, error) {
+	if filter.Status != "" && !validTicketStatus(filter.Status) {
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidTicket, filter.Status)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	query := bson.M{}
+	if filter.OpenedBy != 0 {
+		query["opened_by"] = filter.OpenedBy
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.AssigneeId != 0 {
+		query["assignee_id"] = filter.AssigneeId
+	}
+	if filter.TripId != 0 {
+		query["trip_id"] = filter.TripId
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := us.getTicketsCollection().Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tickets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	tickets := []SupportTicket{}
+	if err := cursor.All(ctx, &tickets); err != nil {
+		return nil, fmt.Errorf("failed to decode tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// AddTicketMessage appends a message to the ticket thread. Only the opener
+// and admins may post.
+func (us *UserService) AddTicketMessage(ctx context.Context, ticketId int, userId int, isAdmin bool, body string) (SupportTicket, error) {
+	if strings.TrimSpace(body) == "" {
+		return SupportTicket{}, fmt.Errorf("%w: message body is required", ErrInvalidTicket)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	ticket, err := us.findTicket(ctx, ticketId)
+	if err != nil {
+		return SupportTicket{}, err
+	}
+	role := RoleAdmin
+	if !isAdmin {
+		if ticket.OpenedBy != userId {
+			return SupportTicket{}, ErrTicketForbidden
+		}
+		role = ticket.OpenerRole
+	}
+
+	now := time.Now()
+	message := TicketMessage{AuthorId: userId, AuthorRole: role, Body: body, CreatedAt: now}
+	set := bson.M{"updated_at": now, "status": statusAfterMessage(ticket.Status, isAdmin)}
+	if set["status"] != TicketStatusResolved {
+		set["resolved_at"] = time.Time{}
+	}
+	update := bson.M{
+		"$push": bson.M{"messages": message},
+		"$set":  set,
+	}
+
+	return us.updateTicket(ctx, ticketId, update)
+}
+
+// UpdateTicketStatus moves a ticket through open, pending and resolved. It is
+// meant for admins; openers change the status implicitly by replying.
+func (us *UserService) UpdateTicketStatus(ctx context.Context, ticketId int, status string) (SupportTicket, error) {
+	if !validTicketStatus(status) {
+		return SupportTicket{}, fmt.Errorf("%w: status must be %s, %s or %s", ErrInvalidTicket, TicketStatusOpen, TicketStatusPending, TicketStatusResolved)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	now := time.Now()
+	set := bson.M{"status": status, "updated_at": now, "resolved_at": time.Time{}}
+	if status == TicketStatusResolved {
+		set["resolved_at"] = now
+	}
+
+	return us.updateTicket(ctx, ticketId, bson.M{"$set": set})
+}
+
+// AssignTicket hands a ticket to an admin.
+func (us *UserService) AssignTicket(ctx context.Context, ticketId int, assigneeId int) (SupportTicket, error) {
+	if assigneeId <= 0 {
+		return SupportTicket{}, fmt.Errorf("%w: assignee is required", ErrInvalidTicket)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"assignee_id": assigneeId, "updated_at": time.Now()}}
+
+	return us.updateTicket(ctx, ticketId, update)
+}
+
+func (us *UserService) updateTicket(ctx context.Context, ticketId int, update bson.M) (SupportTicket, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var ticket SupportTicket
+	err := us.getTicketsCollection().FindOneAndUpdate(ctx, bson.M{"ticket_id": ticketId}, update, opts).Decode(&ticket)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return SupportTicket{}, fmt.Errorf("%w: %d", ErrTicketNotFound, ticketId)
+		}
+		return SupportTicket{}, fmt.Errorf("failed to update ticket: %w", err)
+	}
+	return ticket, nil
+}
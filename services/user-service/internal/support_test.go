@@ -0,0 +1,69 @@
+package user_service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateTicketRequest(t *testing.T) {
+	valid := TicketRequest{
+		TripId:   7,
+		UserId:   3,
+		Role:     RolePassenger,
+		Category: "overcharge",
+		Subject:  "Charged twice",
+		Message:  "The fare was taken from my card twice.",
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(r *TicketRequest)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(r *TicketRequest) {}},
+		{name: "driver", mutate: func(r *TicketRequest) { r.Role = RoleDriver }},
+		{name: "missing trip", mutate: func(r *TicketRequest) { r.TripId = 0 }, wantErr: true},
+		{name: "admin cannot open", mutate: func(r *TicketRequest) { r.Role = RoleAdmin }, wantErr: true},
+		{name: "unknown category", mutate: func(r *TicketRequest) { r.Category = "refund" }, wantErr: true},
+		{name: "blank subject", mutate: func(r *TicketRequest) { r.Subject = "  " }, wantErr: true},
+		{name: "blank message", mutate: func(r *TicketRequest) { r.Message = "" }, wantErr: true},
+		{name: "lost item without description", mutate: func(r *TicketRequest) { r.Category = "lost_item" }, wantErr: true},
+		{name: "lost item", mutate: func(r *TicketRequest) {
+			r.Category = "lost_item"
+			r.ItemDescription = "Black phone, back seat"
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.mutate(&req)
+			err := validateTicketRequest(req)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("validateTicketRequest() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTicket) {
+				t.Errorf("error %v does not wrap ErrInvalidTicket", err)
+			}
+		})
+	}
+}
+
+func TestStatusAfterMessage(t *testing.T) {
+	tests := []struct {
+		current   string
+		fromAdmin bool
+		want      string
+	}{
+		{TicketStatusOpen, false, TicketStatusOpen},
+		{TicketStatusPending, false, TicketStatusOpen},
+		{TicketStatusResolved, false, TicketStatusOpen},
+		{TicketStatusOpen, true, TicketStatusOpen},
+		{TicketStatusPending, true, TicketStatusPending},
+		{TicketStatusResolved, true, TicketStatusResolved},
+	}
+	for _, tt := range tests {
+		if got := statusAfterMessage(tt.current, tt.fromAdmin); got != tt.want {
+			t.Errorf("statusAfterMessage(%q, %v) = %q, want %q", tt.current, tt.fromAdmin, got, tt.want)
+		}
+	}
+}
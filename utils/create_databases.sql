@@ -0,0 +1,5 @@
+-- Runs once when the Postgres container is first initialised.
+-- Tables are created by the services themselves from their embedded migrations
+-- (on startup, or with "<binary> migrate up").
+CREATE DATABASE users WITH OWNER = postgres ENCODING = 'UTF8';
+CREATE DATABASE trips WITH OWNER = postgres ENCODING = 'UTF8';